@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/server"
+)
+
+var backfillIntakeContactsCmd = &cobra.Command{
+	Use:   "backfill-intake-contacts",
+	Short: "Add intake contacts from the free text names on intakes",
+	Long:  `Look up the business owner, product manager, ISSO and collaborator names on intakes in CEDAR LDAP, adding a contact for each name that matches exactly one person`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := viper.New()
+		config.AutomaticEnv()
+		added, err := server.BackfillIntakeContacts(config)
+		if err != nil {
+			fmt.Printf("Failed to backfill intake contacts: %v\n", err)
+			fmt.Printf("Added %d contacts\n", added)
+			os.Exit(1)
+		}
+		fmt.Printf("Added %d contacts\n", added)
+	},
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/server"
+)
+
+var repairCedarDrift bool
+
+var reconcileCedarCmd = &cobra.Command{
+	Use:   "reconcile-cedar",
+	Short: "Compare submitted intakes with CEDAR",
+	Long:  `Report the submitted intakes and business cases CEDAR is missing, and the intakes whose status or decision in CEDAR differs from EASi, and with --repair send EASi's version to CEDAR`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := viper.New()
+		config.AutomaticEnv()
+		drift, err := server.ReconcileCedar(config, repairCedarDrift)
+		for _, field := range drift {
+			fmt.Printf(
+				"intake %s (CEDAR %s): %s is %v in EASi and %v in CEDAR\n",
+				field.IntakeID,
+				field.AlfabetID,
+				field.Field,
+				field.EASiValue,
+				field.CEDARValue,
+			)
+		}
+		if err != nil {
+			fmt.Printf("Failed to reconcile intakes with CEDAR: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Found %d fields that differ from CEDAR\n", len(drift))
+	},
+}
+
+func init() {
+	reconcileCedarCmd.Flags().BoolVar(&repairCedarDrift, "repair", false, "submit what CEDAR is missing and send EASi's version of intakes that differ to CEDAR")
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cmsgov/easi-app/pkg/server"
+)
+
+var lcidExpirationsCmd = &cobra.Command{
+	Use:   "check-lcid-expirations",
+	Short: "Remind requesters of expiring LCIDs",
+	Long:  `Remind requesters and the GRT of LCIDs expiring in 90, 60 and 30 days, and expire the ones that have passed`,
+	Run: func(cmd *cobra.Command, args []string) {
+		config := viper.New()
+		config.AutomaticEnv()
+		if err := server.CheckLCIDExpirations(config); err != nil {
+			fmt.Printf("Failed to check LCID expirations: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
@@ -25,6 +25,9 @@ func execute() {
 func init() {
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(testCmd)
+	rootCmd.AddCommand(lcidExpirationsCmd)
+	rootCmd.AddCommand(reconcileCedarCmd)
+	rootCmd.AddCommand(backfillIntakeContactsCmd)
 }
 
 func main() {
@@ -0,0 +1,29 @@
+ALTER TABLE email_outbox ADD COLUMN to_addresses TEXT[];
+UPDATE email_outbox SET to_addresses = ARRAY[to_address];
+ALTER TABLE email_outbox ALTER COLUMN to_addresses SET NOT NULL;
+ALTER TABLE email_outbox DROP COLUMN to_address;
+ALTER TABLE email_outbox ADD COLUMN cc_addresses TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE email_outbox ADD COLUMN bcc_addresses TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE email_outbox ADD COLUMN reply_to TEXT;
+
+ALTER TABLE actions ADD COLUMN notify_requester BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE actions ADD COLUMN notify_grt BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE actions ADD COLUMN notify_contact_emails TEXT[] NOT NULL DEFAULT '{}';
+
+-- every decision and review email went to the requester before recipients could be chosen
+UPDATE actions SET notify_requester = TRUE WHERE action_type IN (
+    'NOT_IT_REQUEST',
+    'NEED_BIZ_CASE',
+    'READY_FOR_GRT',
+    'PROVIDE_FEEDBACK_NEED_BIZ_CASE',
+    'READY_FOR_GRB',
+    'BIZ_CASE_NEEDS_CHANGES',
+    'PROVIDE_GRT_FEEDBACK_BIZ_CASE_DRAFT',
+    'PROVIDE_GRT_FEEDBACK_BIZ_CASE_FINAL',
+    'NO_GOVERNANCE_NEEDED',
+    'SEND_EMAIL',
+    'GUIDE_RECEIVED_CLOSE',
+    'NOT_RESPONDING_CLOSE',
+    'ISSUE_LCID',
+    'REJECT'
+);
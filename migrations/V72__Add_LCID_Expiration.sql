@@ -0,0 +1,8 @@
+ALTER TYPE system_intake_status ADD VALUE 'LCID_EXPIRED';
+ALTER TYPE action_type ADD VALUE 'LCID_EXPIRATION_REMINDER';
+ALTER TYPE action_type ADD VALUE 'EXPIRE_LCID';
+
+-- the fewest days before the LCID expires that the requester has been reminded of
+ALTER TABLE system_intakes ADD COLUMN lcid_reminder_days INTEGER;
+
+CREATE INDEX system_intakes_lcid_expires_at_idx ON system_intakes (lcid_expires_at) WHERE status = 'LCID_ISSUED';
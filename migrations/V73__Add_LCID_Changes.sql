@@ -0,0 +1,16 @@
+ALTER TYPE system_intake_status ADD VALUE 'LCID_RETIRED';
+ALTER TYPE action_type ADD VALUE 'EXTEND_LCID';
+ALTER TYPE action_type ADD VALUE 'AMEND_LCID';
+ALTER TYPE action_type ADD VALUE 'RETIRE_LCID';
+
+ALTER TABLE system_intakes ADD COLUMN lcid_retired_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE system_intakes ADD COLUMN lcid_retirement_reason TEXT;
+
+-- the values an LCID extension, amendment or retirement changed
+ALTER TABLE actions ADD COLUMN lcid_previous_expires_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE actions ADD COLUMN lcid_new_expires_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE actions ADD COLUMN lcid_previous_scope TEXT;
+ALTER TABLE actions ADD COLUMN lcid_new_scope TEXT;
+ALTER TABLE actions ADD COLUMN lcid_previous_next_steps TEXT;
+ALTER TABLE actions ADD COLUMN lcid_new_next_steps TEXT;
+ALTER TABLE actions ADD COLUMN lcid_retirement_reason TEXT;
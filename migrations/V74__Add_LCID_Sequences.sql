@@ -0,0 +1,37 @@
+-- the last LCID sequence number handed out for each "YYddd" day
+CREATE TABLE lcid_sequences (
+    prefix TEXT PRIMARY KEY CHECK (prefix ~ '^[0-9]{5}$'),
+    last_sequence INTEGER NOT NULL CHECK (last_sequence >= 0)
+);
+
+-- start each day's counter after the LCIDs already generated that day
+INSERT INTO lcid_sequences (prefix, last_sequence)
+SELECT substring(lcid FROM 1 FOR 5), max(substring(lcid FROM 6 FOR 1)::INTEGER)
+FROM system_intakes
+WHERE lcid ~ '^[0-9]{6}$'
+GROUP BY substring(lcid FROM 1 FOR 5);
+
+-- LCIDs used to be generated without checking for one already issued, so the same LCID
+-- can be on more than one intake. Keep it on the intake that was created first, and set
+-- the others aside here so the GRT can reissue them.
+CREATE TABLE lcid_duplicates (
+    system_intake_id UUID PRIMARY KEY NOT NULL REFERENCES system_intakes(id),
+    lcid TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+INSERT INTO lcid_duplicates (system_intake_id, lcid)
+SELECT id, lcid
+FROM (
+    SELECT id, lcid, row_number() OVER (PARTITION BY lcid ORDER BY created_at, id) AS issued
+    FROM system_intakes
+    WHERE LENGTH(lcid) > 0
+) AS issued_lcids
+WHERE issued > 1;
+
+UPDATE system_intakes
+SET lcid = NULL
+WHERE id IN (SELECT system_intake_id FROM lcid_duplicates);
+
+DROP INDEX lcid_idx;
+CREATE UNIQUE INDEX lcid_unique_idx ON system_intakes (lcid) WHERE LENGTH(lcid) > 0;
@@ -0,0 +1,25 @@
+-- the text searched by /system_intakes/search, with project names ranked highest
+CREATE FUNCTION system_intake_search_document(
+    project_name TEXT,
+    project_acronym TEXT,
+    business_need TEXT,
+    solution TEXT,
+    requester TEXT,
+    business_owner TEXT
+) RETURNS TSVECTOR
+LANGUAGE SQL
+IMMUTABLE
+AS $$
+    SELECT
+        setweight(to_tsvector('english', coalesce(project_name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(project_acronym, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(requester, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(business_owner, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(business_need, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(solution, '')), 'C')
+$$;
+
+CREATE INDEX system_intakes_search_idx ON system_intakes USING GIN (
+    system_intake_search_document(project_name, project_acronym, business_need, solution, requester, business_owner)
+);
+CREATE INDEX system_intakes_submitted_at_idx ON system_intakes (submitted_at);
@@ -0,0 +1,27 @@
+CREATE TYPE audit_entity AS ENUM ('SYSTEM_INTAKE', 'BUSINESS_CASE');
+
+-- one row per update to a system intake or business case, holding the fields it changed
+CREATE TABLE audit_entries (
+    id UUID PRIMARY KEY NOT NULL,
+    entity audit_entity NOT NULL,
+    entity_id UUID NOT NULL,
+    eua_user_id TEXT NOT NULL,
+    trace_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    changes JSONB NOT NULL
+);
+
+CREATE INDEX audit_entries_entity_idx ON audit_entries (entity, entity_id, created_at);
+
+-- the audit log is append only
+CREATE FUNCTION reject_audit_entry_changes() RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    RAISE EXCEPTION 'audit entries cannot be changed or deleted';
+END;
+$$;
+
+CREATE TRIGGER audit_entries_append_only
+BEFORE UPDATE OR DELETE ON audit_entries
+FOR EACH ROW EXECUTE PROCEDURE reject_audit_entry_changes();
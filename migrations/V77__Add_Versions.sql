@@ -0,0 +1,3 @@
+-- incremented on every update, so a write based on a stale read can be refused
+ALTER TABLE system_intakes ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE business_cases ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
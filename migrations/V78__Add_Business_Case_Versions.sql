@@ -0,0 +1,24 @@
+-- a copy of a business case, with its lifecycle cost lines, as it was each time it was submitted
+CREATE TABLE business_case_versions (
+    id UUID PRIMARY KEY NOT NULL,
+    business_case_id UUID NOT NULL REFERENCES business_cases(id),
+    number INTEGER NOT NULL,
+    intake_status system_intake_status NOT NULL,
+    eua_user_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    business_case JSONB NOT NULL,
+    UNIQUE (business_case_id, number)
+);
+
+-- submitted versions are never changed
+CREATE FUNCTION reject_business_case_version_changes() RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    RAISE EXCEPTION 'business case versions cannot be changed or deleted';
+END;
+$$;
+
+CREATE TRIGGER business_case_versions_append_only
+BEFORE UPDATE OR DELETE ON business_case_versions
+FOR EACH ROW EXECUTE PROCEDURE reject_business_case_version_changes();
@@ -0,0 +1,2 @@
+-- the identifier CEDAR gave the business case when it was first submitted there
+ALTER TABLE business_cases ADD COLUMN cedar_id text;
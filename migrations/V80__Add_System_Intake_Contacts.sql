@@ -0,0 +1,23 @@
+CREATE TYPE system_intake_contact_role AS ENUM (
+    'BUSINESS_OWNER',
+    'PRODUCT_MANAGER',
+    'ISSO',
+    'TRB_COLLABORATOR',
+    'OIT_SECURITY_COLLABORATOR',
+    'EA_COLLABORATOR'
+);
+
+-- the people who hold a role on an intake, with their name and email as CEDAR LDAP had them
+CREATE TABLE system_intake_contacts (
+    id UUID PRIMARY KEY NOT NULL,
+    system_intake_id UUID NOT NULL REFERENCES system_intakes(id),
+    role system_intake_contact_role NOT NULL,
+    eua_user_id TEXT NOT NULL CHECK (eua_user_id ~ '^[A-Z0-9]{4}$'),
+    common_name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    component TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT system_intake_contacts_role_unique UNIQUE (system_intake_id, role, eua_user_id)
+);
+
+CREATE INDEX system_intake_contacts_eua_user_id_idx ON system_intake_contacts (eua_user_id);
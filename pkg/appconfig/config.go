@@ -165,6 +165,21 @@ const CEDARAPIURL = "CEDAR_API_URL"
 // CEDARAPIKey is the key for accessing CEDAR
 const CEDARAPIKey = "CEDAR_API_KEY"
 
+// CEDARTimeoutSecondsKey is the key for how long each attempt at a CEDAR call may take
+const CEDARTimeoutSecondsKey = "CEDAR_TIMEOUT_SECONDS"
+
+// CEDARMaxRetriesKey is the key for how many times a failed idempotent CEDAR call is retried
+const CEDARMaxRetriesKey = "CEDAR_MAX_RETRIES"
+
+// CEDARBreakerThresholdKey is the key for how many CEDAR failures in a row stop calls to CEDAR
+const CEDARBreakerThresholdKey = "CEDAR_BREAKER_THRESHOLD"
+
+// CEDARBreakerCooldownSecondsKey is the key for how long calls to CEDAR are stopped after it fails
+const CEDARBreakerCooldownSecondsKey = "CEDAR_BREAKER_COOLDOWN_SECONDS"
+
+// CEDARLDAPCacheTTLSecondsKey is the key for how long people looked up in CEDAR LDAP are kept
+const CEDARLDAPCacheTTLSecondsKey = "CEDAR_LDAP_CACHE_TTL_SECONDS"
+
 // LDKey is the key for accessing LaunchDarkly
 const LDKey = "LD_SDK_KEY"
 
@@ -180,6 +195,15 @@ const LambdaEndpoint = "LAMBDA_ENDPOINT"
 // LambdaFunctionPrince is the name of the prince lambda function
 const LambdaFunctionPrince = "LAMBDA_FUNCTION_PRINCE"
 
+// GraphQLComplexityLimitKey is the key for the most complex GraphQL operation the server will run
+const GraphQLComplexityLimitKey = "GRAPHQL_COMPLEXITY_LIMIT"
+
+// GraphQLDepthLimitKey is the key for the most deeply nested GraphQL operation the server will run
+const GraphQLDepthLimitKey = "GRAPHQL_DEPTH_LIMIT"
+
+// GraphQLPersistedQueriesPathKey is the key for the file of the only GraphQL queries the server will run, if set
+const GraphQLPersistedQueriesPathKey = "GRAPHQL_PERSISTED_QUERIES_PATH"
+
 // FlagSourceOption represents an environment
 type FlagSourceOption string
 
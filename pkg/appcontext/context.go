@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -17,6 +18,7 @@ const (
 	loggerKey contextKey = iota
 	traceKey
 	principalKey
+	commitHooksKey
 )
 
 // WithLogger returns a context with the given logger
@@ -67,3 +69,39 @@ func Principal(c context.Context) authn.Principal {
 	}
 	return authn.ANON
 }
+
+// CommitHooks collects work that should only happen once a transaction commits
+type CommitHooks struct {
+	mu    sync.Mutex
+	hooks []func(context.Context)
+}
+
+// Run runs the collected hooks in the order they were added
+func (h *CommitHooks) Run(ctx context.Context) {
+	h.mu.Lock()
+	hooks := h.hooks
+	h.hooks = nil
+	h.mu.Unlock()
+	for _, hook := range hooks {
+		hook(ctx)
+	}
+}
+
+// WithCommitHooks decorates the context with an empty set of commit hooks
+func WithCommitHooks(ctx context.Context) (context.Context, *CommitHooks) {
+	hooks := &CommitHooks{}
+	return context.WithValue(ctx, commitHooksKey, hooks), hooks
+}
+
+// AfterCommit defers f until the transaction on the context commits.
+// It returns false if there is no transaction, in which case f is not run.
+func AfterCommit(ctx context.Context, f func(context.Context)) bool {
+	hooks, ok := ctx.Value(commitHooksKey).(*CommitHooks)
+	if !ok {
+		return false
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.hooks = append(hooks.hooks, f)
+	return true
+}
@@ -128,3 +128,29 @@ func TestContextPrincipal(t *testing.T) {
 		})
 	}
 }
+
+func (s ContextTestSuite) TestAfterCommit() {
+	s.Run("returns false without commit hooks", func() {
+		ran := false
+
+		deferred := AfterCommit(context.Background(), func(context.Context) { ran = true })
+
+		s.False(deferred)
+		s.False(ran)
+	})
+
+	s.Run("runs deferred work in order once hooks are run", func() {
+		ctx, hooks := WithCommitHooks(context.Background())
+		var ran []int
+
+		s.True(AfterCommit(ctx, func(context.Context) { ran = append(ran, 1) }))
+		s.True(AfterCommit(ctx, func(context.Context) { ran = append(ran, 2) }))
+		s.Empty(ran)
+
+		hooks.Run(context.Background())
+		s.Equal([]int{1, 2}, ran)
+
+		hooks.Run(context.Background())
+		s.Equal([]int{1, 2}, ran)
+	})
+}
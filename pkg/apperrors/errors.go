@@ -32,6 +32,8 @@ const (
 	QueryFetch QueryOperation = "Fetch"
 	// QueryUpdate is for failures when updating a resource
 	QueryUpdate QueryOperation = "Update"
+	// QueryDelete is for failures when deleting a resource
+	QueryDelete QueryOperation = "Delete"
 )
 
 // QueryError is a typed error for query issues
@@ -220,6 +222,24 @@ func (e *BadRequestError) Unwrap() error {
 	return e.Err
 }
 
+// PreconditionRequiredError is a typed error for an update that doesn't say which version it expects to replace
+type PreconditionRequiredError struct {
+	Err error
+}
+
+// Error provides the error as a string
+func (e *PreconditionRequiredError) Error() string {
+	return fmt.Sprintf(
+		"Request needs a precondition: %v",
+		e.Err,
+	)
+}
+
+// Unwrap provides the underlying error
+func (e *PreconditionRequiredError) Unwrap() error {
+	return e.Err
+}
+
 // UnknownRouteError is an error for unknown routes
 type UnknownRouteError struct {
 	Path string
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 // Config is email configs used only for SES
@@ -36,38 +37,41 @@ func NewSender(config Config) Sender {
 }
 
 // Send sends an email
-func (s Sender) Send(
-	ctx context.Context,
-	toAddress string,
-	subject string,
-	body string,
-) error {
+func (s Sender) Send(ctx context.Context, email models.Email) error {
 	input := &ses.SendEmailInput{
 		Destination: &ses.Destination{
-			ToAddresses: []*string{
-				aws.String(toAddress),
-			},
+			ToAddresses:  aws.StringSlice(email.ToAddresses),
+			CcAddresses:  aws.StringSlice(email.CCAddresses),
+			BccAddresses: aws.StringSlice(email.BCCAddresses),
 		},
 		Message: &ses.Message{
 			Subject: &ses.Content{
 				Charset: aws.String("UTF-8"),
-				Data:    aws.String(subject),
+				Data:    aws.String(email.Subject),
 			},
 			Body: &ses.Body{
 				Html: &ses.Content{
 					Charset: aws.String("UTF-8"),
-					Data:    aws.String(body),
+					Data:    aws.String(email.Body),
+				},
+				Text: &ses.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(email.TextBody),
 				},
 			},
 		},
 		Source:    aws.String(s.config.Source),
 		SourceArn: aws.String(s.config.SourceARN),
 	}
+	if email.ReplyTo != "" {
+		input.ReplyToAddresses = aws.StringSlice([]string{email.ReplyTo})
+	}
 	_, err := s.client.SendEmail(input)
 	if err == nil {
 		appcontext.ZLogger(ctx).Info("Sending email with SES",
-			zap.String("To", toAddress),
-			zap.String("Subject", subject),
+			zap.Strings("To", email.ToAddresses),
+			zap.Strings("CC", email.CCAddresses),
+			zap.String("Subject", email.Subject),
 		)
 	}
 	return err
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
 
@@ -65,9 +66,27 @@ func (s SESTestSuite) TestSend() {
 	s.Run("Sends successfully", func() {
 		err := s.sender.Send(
 			context.Background(),
-			"success@simulator.amazonses.com",
-			"Test Subject",
-			"Test Body",
+			models.Email{
+				ToAddresses: []string{"success@simulator.amazonses.com"},
+				Subject:     "Test Subject",
+				Body:        "Test Body",
+			},
+		)
+
+		s.NoError(err)
+	})
+
+	s.Run("Sends successfully to multiple recipients", func() {
+		err := s.sender.Send(
+			context.Background(),
+			models.Email{
+				ToAddresses:  []string{"success@simulator.amazonses.com"},
+				CCAddresses:  []string{"success@simulator.amazonses.com"},
+				BCCAddresses: []string{"success@simulator.amazonses.com"},
+				ReplyTo:      "success@simulator.amazonses.com",
+				Subject:      "Test Subject",
+				Body:         "Test Body",
+			},
 		)
 
 		s.NoError(err)
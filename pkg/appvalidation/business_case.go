@@ -4,6 +4,8 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/guregu/null"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/validate"
@@ -136,7 +138,10 @@ func validateAllRequiredLifecycleCosts(businessCase *models.BusinessCase) map[st
 		}
 
 		if validate.RequireInt(cost.Cost) {
-			solutionYearPhase := string(cost.Solution) + string(cost.Year) + string(*cost.Phase)
+			solutionYearPhase := string(cost.Solution) + string(cost.Year)
+			if cost.Phase != nil {
+				solutionYearPhase += string(*cost.Phase)
+			}
 			validations[solutionYearPhase] = "requires a cost"
 			valid = false
 		}
@@ -178,8 +183,112 @@ func validateAllRequiredLifecycleCosts(businessCase *models.BusinessCase) map[st
 	return validations
 }
 
-// BusinessCaseForSubmit checks if it's a valid business case to update
-func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
+// solutionDetails are the security and hosting answers given for one solution
+type solutionDetails struct {
+	name                    string
+	securityIsApproved      null.Bool
+	securityIsBeingReviewed null.String
+	hostingType             null.String
+	hostingLocation         null.String
+	hostingCloudServiceType null.String
+}
+
+// validateSolutionDetails requires the follow up answers for how a solution is secured and hosted.
+// A solution that isn't approved by IT Security needs to say whether it's being reviewed,
+// and one hosted in the cloud or a data center needs to say where.
+func validateSolutionDetails(solution solutionDetails) map[string]string {
+	validations := map[string]string{}
+	if validate.RequireNullBool(solution.securityIsApproved) {
+		validations[solution.name+"SecurityIsApproved"] = "is required"
+	} else if !solution.securityIsApproved.Bool && validate.RequireNullString(solution.securityIsBeingReviewed) {
+		validations[solution.name+"SecurityIsBeingReviewed"] = "is required"
+	}
+
+	switch solution.hostingType.String {
+	case "cloud":
+		if validate.RequireNullString(solution.hostingLocation) {
+			validations[solution.name+"HostingLocation"] = "is required"
+		}
+		if validate.RequireNullString(solution.hostingCloudServiceType) {
+			validations[solution.name+"HostingCloudServiceType"] = "is required"
+		}
+	case "dataCenter":
+		if validate.RequireNullString(solution.hostingLocation) {
+			validations[solution.name+"HostingLocation"] = "is required"
+		}
+	}
+	return validations
+}
+
+func requiredSolutionDetails(businessCase *models.BusinessCase) []solutionDetails {
+	solutions := []solutionDetails{
+		{
+			name:                    "Preferred",
+			securityIsApproved:      businessCase.PreferredSecurityIsApproved,
+			securityIsBeingReviewed: businessCase.PreferredSecurityIsBeingReviewed,
+			hostingType:             businessCase.PreferredHostingType,
+			hostingLocation:         businessCase.PreferredHostingLocation,
+			hostingCloudServiceType: businessCase.PreferredHostingCloudServiceType,
+		},
+		{
+			name:                    "AlternativeA",
+			securityIsApproved:      businessCase.AlternativeASecurityIsApproved,
+			securityIsBeingReviewed: businessCase.AlternativeASecurityIsBeingReviewed,
+			hostingType:             businessCase.AlternativeAHostingType,
+			hostingLocation:         businessCase.AlternativeAHostingLocation,
+			hostingCloudServiceType: businessCase.AlternativeAHostingCloudServiceType,
+		},
+	}
+	if alternativeBRequired(businessCase) {
+		solutions = append(solutions, solutionDetails{
+			name:                    "AlternativeB",
+			securityIsApproved:      businessCase.AlternativeBSecurityIsApproved,
+			securityIsBeingReviewed: businessCase.AlternativeBSecurityIsBeingReviewed,
+			hostingType:             businessCase.AlternativeBHostingType,
+			hostingLocation:         businessCase.AlternativeBHostingLocation,
+			hostingCloudServiceType: businessCase.AlternativeBHostingCloudServiceType,
+		})
+	}
+	return solutions
+}
+
+// BusinessCaseForDraftSubmission checks if it's a valid business case to submit as a draft.
+// Drafts can be incomplete, but what has been entered must make sense.
+func BusinessCaseForDraftSubmission(businessCase *models.BusinessCase) error {
+	expectedErr := apperrors.NewValidationError(
+		errors.New("business case failed validations"),
+		businessCase,
+		businessCase.ID.String(),
+	)
+
+	if businessCase.Status != models.BusinessCaseStatusOPEN {
+		expectedErr.WithValidation("Status", "must be OPEN")
+	}
+	if validate.RequireUUID(businessCase.ID) {
+		expectedErr.WithValidation("ID", "is required")
+	}
+	if validate.RequireString(businessCase.EUAUserID) {
+		expectedErr.WithValidation("EUAUserID", "is required")
+	}
+	if validate.RequireUUID(businessCase.SystemIntakeID) {
+		expectedErr.WithValidation("SystemIntakeID", "is required")
+	}
+	if businessCase.RequesterPhoneNumber.String != "" && validate.PhoneNumberInvalid(businessCase.RequesterPhoneNumber.String) {
+		expectedErr.WithValidation("RequesterPhoneNumber", "must have at least 10 digits")
+	}
+	if k, v := checkUniqLifecycleCosts(businessCase.LifecycleCostLines); k != "" {
+		expectedErr.WithValidation(k, v)
+	}
+
+	if len(expectedErr.Validations) > 0 {
+		return &expectedErr
+	}
+	return nil
+}
+
+// BusinessCaseForFinalSubmission checks if it's a complete business case to submit as final.
+// Every problem is returned at once, so they can all be fixed together.
+func BusinessCaseForFinalSubmission(businessCase *models.BusinessCase) error {
 	// We return an empty id in this error because the business case hasn't been created
 	expectedErr := apperrors.NewValidationError(
 		errors.New("business case failed validations"),
@@ -208,6 +317,8 @@ func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
 	}
 	if validate.RequireNullString(businessCase.RequesterPhoneNumber) {
 		expectedErr.WithValidation("RequesterPhoneNumber", "is required")
+	} else if validate.PhoneNumberInvalid(businessCase.RequesterPhoneNumber.String) {
+		expectedErr.WithValidation("RequesterPhoneNumber", "must have at least 10 digits")
 	}
 	if validate.RequireNullString(businessCase.BusinessOwner) {
 		expectedErr.WithValidation("BusinessOwner", "is required")
@@ -319,6 +430,11 @@ func BusinessCaseForSubmit(businessCase *models.BusinessCase) error {
 			expectedErr.WithValidation("AlternativeBCostSavings", "is required")
 		}
 	}
+	for _, solution := range requiredSolutionDetails(businessCase) {
+		for k, v := range validateSolutionDetails(solution) {
+			expectedErr.WithValidation(k, v)
+		}
+	}
 	if k, v := checkUniqLifecycleCosts(businessCase.LifecycleCostLines); k != "" {
 		expectedErr.WithValidation(k, v)
 	}
@@ -240,14 +240,14 @@ func (s AppValidateTestSuite) TestValidateAllRequiredLifecycleCosts() {
 	})
 }
 
-func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
+func (s AppValidateTestSuite) TestBusinessCaseForFinalSubmission() {
 	s.Run("golden path", func() {
 		businessCase := testhelpers.NewBusinessCase()
 		businessCase.Status = models.BusinessCaseStatusOPEN
 		businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
 		submittedTime := time.Now()
 		businessCase.LastSubmittedAt = &submittedTime
-		err := BusinessCaseForSubmit(&businessCase)
+		err := BusinessCaseForFinalSubmission(&businessCase)
 		s.NoError(err)
 	})
 
@@ -270,6 +270,7 @@ func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
 			`"AlternativeAHasUI":"is required",` +
 			`"AlternativeAHostingType":"is required",` +
 			`"AlternativeAPros":"is required",` +
+			`"AlternativeASecurityIsApproved":"is required",` +
 			`"AlternativeASummary":"is required",` +
 			`"AlternativeATitle":"is required",` +
 			`"AsIsCons":"is required",` +
@@ -289,6 +290,7 @@ func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
 			`"PreferredHasUI":"is required",` +
 			`"PreferredHostingType":"is required",` +
 			`"PreferredPros":"is required",` +
+			`"PreferredSecurityIsApproved":"is required",` +
 			`"PreferredSummary":"is required",` +
 			`"PreferredTitle":"is required",` +
 			`"PriorityAlignment":"is required",` +
@@ -301,7 +303,7 @@ func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
 			`"alternativeASolution":"years 1, 2, 3, 4, 5 are required",` +
 			`"asIsSolution":"years 1, 2, 3, 4, 5 are required",` +
 			`"preferredSolution":"years 1, 2, 3, 4, 5 are required"}`
-		err := BusinessCaseForSubmit(&businessCase)
+		err := BusinessCaseForFinalSubmission(&businessCase)
 
 		s.IsType(err, &apperrors.ValidationError{})
 		s.Equal(expectedError, err.Error())
@@ -331,9 +333,92 @@ func (s AppValidateTestSuite) TestBusinessCaseForSubmit() {
 			`"AlternativeBPros":"is required",` +
 			`"AlternativeBSummary":"is required"}`
 
-		err := BusinessCaseForSubmit(&businessCase)
+		err := BusinessCaseForFinalSubmission(&businessCase)
 
 		s.Error(err)
 		s.Equal(expectedError, err.Error())
 	})
+	s.Run("returns validations for security and hosting follow up answers", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
+		businessCase.PreferredSecurityIsApproved = null.BoolFrom(false)
+		businessCase.PreferredHostingType = null.StringFrom("cloud")
+		businessCase.AlternativeAHostingType = null.StringFrom("dataCenter")
+		businessCase.AlternativeBSecurityIsApproved = null.NewBool(false, false)
+		expectedError := `Could not validate *models.BusinessCase ` +
+			fmt.Sprintf("%s: ", businessCase.ID) +
+			`{"AlternativeAHostingLocation":"is required",` +
+			`"AlternativeBSecurityIsApproved":"is required",` +
+			`"PreferredHostingCloudServiceType":"is required",` +
+			`"PreferredHostingLocation":"is required",` +
+			`"PreferredSecurityIsBeingReviewed":"is required"}`
+
+		err := BusinessCaseForFinalSubmission(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(expectedError, err.Error())
+	})
+
+	s.Run("returns validations for a phone number without enough digits", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
+		businessCase.RequesterPhoneNumber = null.StringFrom("555-1234")
+
+		err := BusinessCaseForFinalSubmission(&businessCase)
+
+		s.Error(err)
+		s.Equal(
+			fmt.Sprintf(`Could not validate *models.BusinessCase %s: {"RequesterPhoneNumber":"must have at least 10 digits"}`, businessCase.ID),
+			err.Error(),
+		)
+	})
+
+	s.Run("requires a cost for a line without a phase or cost", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.LifecycleCostLines = append(
+			testhelpers.NewValidLifecycleCosts(&businessCase.ID),
+			models.EstimatedLifecycleCost{Solution: models.LifecycleCostSolutionA, Year: models.LifecycleCostYear1},
+		)
+
+		err := BusinessCaseForFinalSubmission(&businessCase)
+
+		s.Error(err)
+		s.Contains(err.Error(), `"A1":"requires a cost"`)
+	})
+}
+
+func (s AppValidateTestSuite) TestBusinessCaseForDraftSubmission() {
+	s.Run("golden path with an incomplete business case", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.BusinessNeed = null.NewString("", false)
+		businessCase.PreferredSecurityIsApproved = null.NewBool(false, false)
+		businessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{}
+
+		err := BusinessCaseForDraftSubmission(&businessCase)
+
+		s.NoError(err)
+	})
+
+	s.Run("returns validations when submitted", func() {
+		businessCase := models.BusinessCase{
+			RequesterPhoneNumber: null.StringFrom("555"),
+			LifecycleCostLines: models.EstimatedLifecycleCosts{
+				testhelpers.NewEstimatedLifecycleCost(testhelpers.EstimatedLifecycleCostOptions{}),
+				testhelpers.NewEstimatedLifecycleCost(testhelpers.EstimatedLifecycleCostOptions{}),
+			},
+		}
+		expectedError := `Could not validate *models.BusinessCase ` +
+			`00000000-0000-0000-0000-000000000000: ` +
+			`{"EUAUserID":"is required",` +
+			`"ID":"is required",` +
+			`"LifecycleCostPhase":"cannot have multiple costs for the same phase, solution, and year",` +
+			`"RequesterPhoneNumber":"must have at least 10 digits",` +
+			`"Status":"must be OPEN",` +
+			`"SystemIntakeID":"is required"}`
+
+		err := BusinessCaseForDraftSubmission(&businessCase)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(expectedError, err.Error())
+	})
 }
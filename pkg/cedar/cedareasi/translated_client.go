@@ -2,11 +2,19 @@ package cedareasi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
 
+	"github.com/facebookgo/clock"
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"go.uber.org/zap"
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
@@ -15,6 +23,7 @@ import (
 	apiclient "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client"
 	apioperations "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client/operations"
 	apimodels "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/models"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedartransport"
 	"github.com/cmsgov/easi-app/pkg/flags"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/validate"
@@ -38,19 +47,16 @@ type TranslatedClient struct {
 type Client interface {
 	CheckConnection(context.Context) error
 	ValidateAndSubmitSystemIntake(context.Context, *models.SystemIntake) (string, error)
+	ValidateAndSubmitBusinessCase(context.Context, *models.BusinessCase) (string, error)
+	UpdateSystemIntake(context.Context, *models.SystemIntake) error
+	FetchSystemIntakeDrift(context.Context, *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error)
 }
 
 // NewTranslatedClient returns an API client for CEDAR EASi using EASi language
-func NewTranslatedClient(cedarHost string, cedarAPIKey string, ldClient *ld.LDClient) TranslatedClient {
+func NewTranslatedClient(cedarHost string, cedarAPIKey string, transportConfig cedartransport.Config, ldClient *ld.LDClient) TranslatedClient {
 	// create the transport
 	transport := httptransport.New(cedarHost, apiclient.DefaultBasePath, []string{"https"})
 
-	// create the API client, with the transport
-	client := apiclient.New(transport, strfmt.Default)
-
-	// Set auth header
-	apiKeyHeaderAuth := httptransport.APIKeyAuth("x-Gateway-APIKey", "header", cedarAPIKey)
-
 	fnEmit := func(ctx context.Context) bool {
 		// this is the conditional way of stopping us from submitting to CEDAR; see EASI-1025
 		lduser := flags.Principal(ctx)
@@ -67,7 +73,25 @@ func NewTranslatedClient(cedarHost string, cedarAPIKey string, ldClient *ld.LDCl
 		return result
 	}
 
-	return TranslatedClient{client, apiKeyHeaderAuth, fnEmit}
+	return newTranslatedClient(transport, cedarAPIKey, transportConfig, fnEmit)
+}
+
+func newTranslatedClient(
+	transport *httptransport.Runtime,
+	cedarAPIKey string,
+	transportConfig cedartransport.Config,
+	emitToCedar func(context.Context) bool,
+) TranslatedClient {
+	// time out, retry and stop calling CEDAR while it's failing
+	transport.Transport = cedartransport.New("CEDAR EASi", transport.Transport, transportConfig, clock.New())
+
+	// create the API client, with the transport
+	client := apiclient.New(transport, strfmt.Default)
+
+	// Set auth header
+	apiKeyHeaderAuth := httptransport.APIKeyAuth("x-Gateway-APIKey", "header", cedarAPIKey)
+
+	return TranslatedClient{client, apiKeyHeaderAuth, emitToCedar}
 }
 
 // CheckConnection tries to verify if we are able to communicate with the CEDAR API
@@ -75,7 +99,14 @@ func (c TranslatedClient) CheckConnection(ctx context.Context) error {
 	_, err := c.client.Operations.HealthCheckGET1(
 		apioperations.NewHealthCheckGET1ParamsWithContext(ctx),
 		c.apiAuthHeader)
-	return err
+	if err != nil {
+		return &apperrors.ExternalAPIError{
+			Err:       err,
+			Operation: apperrors.Fetch,
+			Source:    "CEDAR",
+		}
+	}
+	return nil
 }
 
 // ValidateSystemIntakeForCedar validates all required fields to ensure we won't get errors for contents of the request
@@ -160,6 +191,7 @@ func ValidateSystemIntakeForCedar(ctx context.Context, intake *models.SystemInta
 
 func systemIntakeToGovernanceIntake(si *models.SystemIntake) *apimodels.GovernanceIntake {
 	id := si.ID.String()
+	status := string(si.Status)
 	gi := &apimodels.GovernanceIntake{
 		BusinessNeeds:           si.BusinessNeed.ValueOrZero(),
 		BusinessOwner:           si.BusinessOwner.ValueOrZero(),
@@ -179,6 +211,7 @@ func systemIntakeToGovernanceIntake(si *models.SystemIntake) *apimodels.Governan
 		Requester:               si.Requester,
 		RequesterComponent:      si.Component.ValueOrZero(),
 		Solution:                si.Solution.ValueOrZero(),
+		Status:                  &status,
 		SystemName:              si.ProjectName.ValueOrZero(),
 		TrbCollaborator:         si.TRBCollaborator.ValueOrZero(),
 	}
@@ -250,3 +283,320 @@ func (c TranslatedClient) ValidateAndSubmitSystemIntake(ctx context.Context, int
 	}
 	return alfabetID, nil
 }
+
+// ValidateBusinessCaseForCedar validates all required fields of a business case and the costs of its filled in cost lines
+// to ensure we won't get errors for contents of the request
+func ValidateBusinessCaseForCedar(ctx context.Context, businessCase *models.BusinessCase) error {
+	expectedError := apperrors.ValidationError{
+		Err:         errors.New("validation failed"),
+		Validations: apperrors.Validations{},
+		ModelID:     businessCase.ID.String(),
+		Model:       businessCase,
+	}
+	const validationMessage = "is required"
+	if validate.RequireUUID(businessCase.ID) {
+		expectedError.WithValidation("ID", validationMessage)
+	}
+	if validate.RequireString(businessCase.EUAUserID) {
+		expectedError.WithValidation("EUAUserID", validationMessage)
+	}
+	if validate.RequireUUID(businessCase.SystemIntakeID) {
+		expectedError.WithValidation("SystemIntakeID", validationMessage)
+	}
+	if validate.RequireString(string(businessCase.Status)) {
+		expectedError.WithValidation("Status", validationMessage)
+	}
+	for _, line := range cedarLifecycleCosts(businessCase.LifecycleCostLines) {
+		if *line.Cost < 0 || *line.Cost > math.MaxInt32 {
+			key := string(line.Solution) + string(line.Year) + string(*line.Phase)
+			expectedError.WithValidation(key, fmt.Sprintf("must be between 0 and %d", math.MaxInt32))
+		}
+	}
+	if len(expectedError.Validations) > 0 {
+		return &expectedError
+	}
+	return nil
+}
+
+// cedarLifecycleCosts drops the empty line a business case without costs is fetched with,
+// and the rows a requester left without a phase or a cost
+func cedarLifecycleCosts(lines models.EstimatedLifecycleCosts) models.EstimatedLifecycleCosts {
+	costs := models.EstimatedLifecycleCosts{}
+	for _, line := range lines {
+		if line.ID == uuid.Nil || validate.RequireCostPhase(line.Phase) || validate.RequireInt(line.Cost) {
+			continue
+		}
+		costs = append(costs, line)
+	}
+	return costs
+}
+
+func businessCaseSolution(
+	businessCase *models.BusinessCase,
+	solution models.LifecycleCostSolution,
+	title null.String,
+	summary null.String,
+	pros null.String,
+	cons null.String,
+	costSavings null.String,
+) *apimodels.BusinessCaseSolution {
+	// CEDAR needs an ID for each solution, but we don't keep one,
+	// so derive one that stays the same each time the business case is submitted
+	id := uuid.NewSHA1(businessCase.ID, []byte(solution)).String()
+	lines := []*apimodels.LifecycleCostLine{}
+	for _, line := range cedarLifecycleCosts(businessCase.LifecycleCostLines) {
+		if line.Solution != solution {
+			continue
+		}
+		lineID := line.ID.String()
+		costLine := &apimodels.LifecycleCostLine{
+			ID:   &lineID,
+			Year: string(line.Year),
+		}
+		if line.Phase != nil {
+			costLine.Phase = string(*line.Phase)
+		}
+		if line.Cost != nil {
+			costLine.Cost = int32(*line.Cost)
+		}
+		lines = append(lines, costLine)
+	}
+	return &apimodels.BusinessCaseSolution{
+		ID:                 &id,
+		Type:               string(solution),
+		Title:              title.ValueOrZero(),
+		Summary:            summary.ValueOrZero(),
+		Pros:               pros.ValueOrZero(),
+		Cons:               cons.ValueOrZero(),
+		CostSavings:        costSavings.ValueOrZero(),
+		LifecycleCostLines: lines,
+	}
+}
+
+func businessCaseToCedarBusinessCase(bc *models.BusinessCase) *apimodels.BusinessCase {
+	id := bc.ID.String()
+	governanceID := bc.SystemIntakeID.String()
+	status := string(bc.Status)
+	solutions := []*apimodels.BusinessCaseSolution{
+		businessCaseSolution(bc, models.LifecycleCostSolutionASIS, bc.AsIsTitle, bc.AsIsSummary, bc.AsIsPros, bc.AsIsCons, bc.AsIsCostSavings),
+		businessCaseSolution(bc, models.LifecycleCostSolutionPREFERRED, bc.PreferredTitle, bc.PreferredSummary, bc.PreferredPros, bc.PreferredCons, bc.PreferredCostSavings),
+		businessCaseSolution(bc, models.LifecycleCostSolutionA, bc.AlternativeATitle, bc.AlternativeASummary, bc.AlternativeAPros, bc.AlternativeACons, bc.AlternativeACostSavings),
+	}
+	// alternative B is optional
+	alternativeB := businessCaseSolution(bc, models.LifecycleCostSolutionB, bc.AlternativeBTitle, bc.AlternativeBSummary, bc.AlternativeBPros, bc.AlternativeBCons, bc.AlternativeBCostSavings)
+	if bc.AlternativeBTitle.Valid || len(alternativeB.LifecycleCostLines) > 0 {
+		solutions = append(solutions, alternativeB)
+	}
+
+	cbc := &apimodels.BusinessCase{
+		BusinessNeed:         bc.BusinessNeed.ValueOrZero(),
+		BusinessOwner:        bc.BusinessOwner.ValueOrZero(),
+		CmsBenefit:           bc.CMSBenefit.ValueOrZero(),
+		EuaUserID:            &bc.EUAUserID,
+		GovernanceID:         &governanceID,
+		HostingNeeds:         bc.PreferredHostingType.ValueOrZero(),
+		ID:                   &id,
+		PriorityAlignment:    bc.PriorityAlignment.ValueOrZero(),
+		ProjectName:          bc.ProjectName.ValueOrZero(),
+		Requester:            bc.Requester.ValueOrZero(),
+		RequesterPhoneNumber: bc.RequesterPhoneNumber.ValueOrZero(),
+		Solutions:            solutions,
+		Status:               &status,
+		SuccessIndicators:    bc.SuccessIndicators.ValueOrZero(),
+		UserInterface:        bc.PreferredHasUI.ValueOrZero(),
+	}
+	if bc.InitialSubmittedAt != nil {
+		cbc.InitialSubmittedAt = bc.InitialSubmittedAt.Format(dateTimeLayout)
+	}
+	if bc.LastSubmittedAt != nil {
+		cbc.LastSubmittedAt = bc.LastSubmittedAt.Format(dateTimeLayout)
+	}
+	if bc.ArchivedAt != nil {
+		cbc.WithdrawnAt = bc.ArchivedAt.Format(dateTimeLayout)
+	}
+	return cbc
+}
+
+// submitBusinessCase creates the business case in CEDAR the first time it's submitted,
+// and updates it after that
+func submitBusinessCase(ctx context.Context, validatedBusinessCase *models.BusinessCase, c TranslatedClient) (string, error) {
+	submitErr := func(err error) error {
+		return &apperrors.ExternalAPIError{
+			Err:       err,
+			Model:     validatedBusinessCase,
+			ModelID:   validatedBusinessCase.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+
+	body := businessCaseToCedarBusinessCase(validatedBusinessCase)
+	var response *apimodels.Response1
+	if validatedBusinessCase.CedarID.Valid {
+		params := apioperations.NewIntakebusinessCaseidPUT8ParamsWithContext(ctx)
+		params.ID = validatedBusinessCase.CedarID.String
+		params.Body = &apimodels.Intake3{BusinessCase: body}
+		resp, err := c.client.Operations.IntakebusinessCaseidPUT8(params, c.apiAuthHeader)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to update business case for CEDAR", zap.Error(err))
+			return "", submitErr(err)
+		}
+		response = resp.Payload.Response
+	} else {
+		params := apioperations.NewIntakebusinessCasePOST7ParamsWithContext(ctx)
+		params.Body = &apimodels.Intake2{BusinessCase: body}
+		resp, err := c.client.Operations.IntakebusinessCasePOST7(params, c.apiAuthHeader)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to submit business case for CEDAR", zap.Error(err))
+			return "", submitErr(err)
+		}
+		response = resp.Payload.Response
+	}
+
+	if response == nil || response.Result == nil {
+		return "", submitErr(errors.New("CEDAR returned no result"))
+	}
+	if *response.Result != "success" {
+		return "", submitErr(errors.New("CEDAR return result: " + *response.Result))
+	}
+	if len(response.Message) == 0 {
+		return validatedBusinessCase.CedarID.String, nil
+	}
+	return response.Message[0], nil
+}
+
+// ValidateAndSubmitBusinessCase submits a business case to CEDAR,
+// returning the identifier CEDAR knows it by
+func (c TranslatedClient) ValidateAndSubmitBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+	// we may not be sending business cases to CEDAR currently,
+	// in which case what CEDAR needs doesn't hold up submitting them
+	if !c.emitToCedar(ctx) {
+		return "", nil
+	}
+	err := ValidateBusinessCaseForCedar(ctx, businessCase)
+	if err != nil {
+		return "", err
+	}
+	cedarID, err := submitBusinessCase(ctx, businessCase, c)
+	if err != nil {
+		return "", err
+	}
+	// if we are submitting to CEDAR, we expect a non-empty value back
+	if cedarID == "" {
+		return "", &apperrors.ExternalAPIError{
+			Err:       errors.New("submission was not successful"),
+			Model:     businessCase,
+			ModelID:   businessCase.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR EASi",
+		}
+	}
+	return cedarID, nil
+}
+
+// UpdateSystemIntake sends the current status and decision of an intake that's been submitted to CEDAR
+func (c TranslatedClient) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) error {
+	// only intakes CEDAR already knows about can be updated
+	if intake.AlfabetID.ValueOrZero() == "" || !c.emitToCedar(ctx) {
+		return nil
+	}
+	updateErr := func(err error) error {
+		return &apperrors.ExternalAPIError{
+			Err:       err,
+			Model:     intake,
+			ModelID:   intake.ID.String(),
+			Operation: apperrors.Submit,
+			Source:    "CEDAR",
+		}
+	}
+
+	params := apioperations.NewIntakegovernanceidPUT6ParamsWithContext(ctx)
+	params.ID = intake.AlfabetID.String
+	params.Body = &apimodels.IntakeUpdate{
+		Governance: systemIntakeToGovernanceIntake(intake),
+	}
+	resp, err := c.client.Operations.IntakegovernanceidPUT6(params, c.apiAuthHeader)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to update intake for CEDAR", zap.Error(err))
+		return updateErr(err)
+	}
+	if resp.Payload.Response == nil || resp.Payload.Response.Result == nil {
+		return updateErr(errors.New("CEDAR returned no result"))
+	}
+	if *resp.Payload.Response.Result != "success" {
+		return updateErr(errors.New("CEDAR return result: " + *resp.Payload.Response.Result))
+	}
+	return nil
+}
+
+// FetchSystemIntakeDrift compares an intake with what CEDAR has for it,
+// returning the fields that differ
+func (c TranslatedClient) FetchSystemIntakeDrift(ctx context.Context, intake *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error) {
+	params := apioperations.NewIntakegovernanceidGET6ParamsWithContext(ctx)
+	params.ID = intake.AlfabetID.String
+	resp, err := c.client.Operations.IntakegovernanceidGET6(params, c.apiAuthHeader)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch intake from CEDAR", zap.Error(err))
+		return nil, &apperrors.ExternalAPIError{
+			Err:       err,
+			Model:     intake,
+			ModelID:   intake.ID.String(),
+			Operation: apperrors.Fetch,
+			Source:    "CEDAR",
+		}
+	}
+	cedarIntake := &apimodels.GovernanceIntake{}
+	if resp.Payload.Intake != nil && resp.Payload.Intake.Governance != nil {
+		cedarIntake = resp.Payload.Intake.Governance
+	}
+	return governanceIntakeDrift(intake, cedarIntake)
+}
+
+// governanceIntakeDrift compares every field we send CEDAR with what it has,
+// by the names CEDAR knows them by
+func governanceIntakeDrift(intake *models.SystemIntake, cedarIntake *apimodels.GovernanceIntake) ([]models.SystemIntakeCedarDrift, error) {
+	easiFields, err := governanceIntakeFields(systemIntakeToGovernanceIntake(intake))
+	if err != nil {
+		return nil, err
+	}
+	cedarFields, err := governanceIntakeFields(cedarIntake)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range easiFields {
+		names = append(names, name)
+	}
+	for name := range cedarFields {
+		if _, ok := easiFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	drift := []models.SystemIntakeCedarDrift{}
+	for _, name := range names {
+		if reflect.DeepEqual(easiFields[name], cedarFields[name]) {
+			continue
+		}
+		drift = append(drift, models.SystemIntakeCedarDrift{
+			IntakeID:   intake.ID,
+			AlfabetID:  intake.AlfabetID.String,
+			Field:      name,
+			EASiValue:  easiFields[name],
+			CEDARValue: cedarFields[name],
+		})
+	}
+	return drift, nil
+}
+
+func governanceIntakeFields(gi *apimodels.GovernanceIntake) (map[string]interface{}, error) {
+	data, err := json.Marshal(gi)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
@@ -2,15 +2,25 @@ package cedareasi
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/facebookgo/clock"
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	apiclient "github.com/cmsgov/easi-app/pkg/cedar/cedareasi/gen/client"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedartransport"
 	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
 
 func (s CedarEasiTestSuite) TestValidateSystemIntakeForCedar() {
@@ -167,3 +177,198 @@ func (s CedarEasiTestSuite) TestValidateSystemIntakeForCedar() {
 		intake.SubmittedAt = &clockTime
 	})
 }
+
+func newCedarBusinessCase() models.BusinessCase {
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.LifecycleCostLines = testhelpers.NewValidLifecycleCosts(&businessCase.ID)
+	for i := range businessCase.LifecycleCostLines {
+		businessCase.LifecycleCostLines[i].ID = uuid.New()
+	}
+	return businessCase
+}
+
+func (s CedarEasiTestSuite) TestValidateBusinessCaseForCedar() {
+	ctx := context.Background()
+
+	s.Run("golden path", func() {
+		businessCase := newCedarBusinessCase()
+		s.NoError(ValidateBusinessCaseForCedar(ctx, &businessCase))
+	})
+
+	s.Run("ignores the empty cost line of a business case without costs", func() {
+		businessCase := newCedarBusinessCase()
+		businessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{{}}
+		s.NoError(ValidateBusinessCaseForCedar(ctx, &businessCase))
+	})
+
+	s.Run("a business case without required fields fails", func() {
+		businessCase := models.BusinessCase{}
+		err := ValidateBusinessCaseForCedar(ctx, &businessCase)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrString := fmt.Sprintf(
+			`Could not validate *models.BusinessCase %s: {"EUAUserID":"is required","ID":"is required","Status":"is required","SystemIntakeID":"is required"}`,
+			uuid.Nil.String(),
+		)
+		s.EqualError(err, expectedErrString)
+	})
+
+	s.Run("cost lines need a cost CEDAR can store", func() {
+		businessCase := newCedarBusinessCase()
+		tooMuch := math.MaxInt32 + 1
+		businessCase.LifecycleCostLines[0].Cost = &tooMuch
+		err := ValidateBusinessCaseForCedar(ctx, &businessCase)
+		s.IsType(&apperrors.ValidationError{}, err)
+		expectedErrString := fmt.Sprintf(
+			`Could not validate *models.BusinessCase %s: {"As Is1Development":"must be between 0 and 2147483647"}`,
+			businessCase.ID.String(),
+		)
+		s.EqualError(err, expectedErrString)
+	})
+
+	s.Run("ignores cost lines left without a phase or a cost", func() {
+		businessCase := newCedarBusinessCase()
+		businessCase.LifecycleCostLines[1].Cost = nil
+		businessCase.LifecycleCostLines[2].Phase = nil
+		s.NoError(ValidateBusinessCaseForCedar(ctx, &businessCase))
+		s.Len(cedarLifecycleCosts(businessCase.LifecycleCostLines), len(businessCase.LifecycleCostLines)-2)
+	})
+}
+
+func (s CedarEasiTestSuite) TestBusinessCaseToCedarBusinessCase() {
+	businessCase := newCedarBusinessCase()
+	submittedAt := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	businessCase.LastSubmittedAt = &submittedAt
+
+	s.Run("maps the business case and its solutions", func() {
+		cbc := businessCaseToCedarBusinessCase(&businessCase)
+
+		s.Equal(businessCase.ID.String(), *cbc.ID)
+		s.Equal(businessCase.SystemIntakeID.String(), *cbc.GovernanceID)
+		s.Equal(businessCase.EUAUserID, *cbc.EuaUserID)
+		s.Equal("OPEN", *cbc.Status)
+		s.Equal("2021-03-04 05:06:07", cbc.LastSubmittedAt)
+		s.Len(cbc.Solutions, 4)
+
+		lines := 0
+		for ix, solution := range []models.LifecycleCostSolution{
+			models.LifecycleCostSolutionASIS,
+			models.LifecycleCostSolutionPREFERRED,
+			models.LifecycleCostSolutionA,
+			models.LifecycleCostSolutionB,
+		} {
+			s.Equal(string(solution), cbc.Solutions[ix].Type)
+			lines += len(cbc.Solutions[ix].LifecycleCostLines)
+		}
+		s.Equal(len(businessCase.LifecycleCostLines), lines)
+		s.Equal(businessCase.PreferredTitle.String, cbc.Solutions[1].Title)
+	})
+
+	s.Run("solution IDs are the same every time", func() {
+		first := businessCaseToCedarBusinessCase(&businessCase)
+		second := businessCaseToCedarBusinessCase(&businessCase)
+		s.Equal(*first.Solutions[0].ID, *second.Solutions[0].ID)
+		s.NotEqual(*first.Solutions[0].ID, *first.Solutions[1].ID)
+	})
+
+	s.Run("leaves out an alternative B that wasn't entered", func() {
+		withoutB := newCedarBusinessCase()
+		withoutB.AlternativeBTitle = null.NewString("", false)
+		lines := models.EstimatedLifecycleCosts{}
+		for _, line := range withoutB.LifecycleCostLines {
+			if line.Solution != models.LifecycleCostSolutionB {
+				lines = append(lines, line)
+			}
+		}
+		withoutB.LifecycleCostLines = lines
+
+		cbc := businessCaseToCedarBusinessCase(&withoutB)
+		s.Len(cbc.Solutions, 3)
+	})
+}
+
+func (s CedarEasiTestSuite) TestValidateAndSubmitBusinessCase() {
+	ctx := context.Background()
+	client := TranslatedClient{emitToCedar: func(context.Context) bool { return false }}
+
+	s.Run("validates without submitting when not emitting to CEDAR", func() {
+		businessCase := newCedarBusinessCase()
+		cedarID, err := client.ValidateAndSubmitBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		s.Equal("", cedarID)
+	})
+
+	s.Run("doesn't hold up a business case CEDAR couldn't take when not emitting to CEDAR", func() {
+		businessCase := models.BusinessCase{}
+		cedarID, err := client.ValidateAndSubmitBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		s.Equal("", cedarID)
+	})
+
+	s.Run("returns validation errors before submitting", func() {
+		emitting := TranslatedClient{emitToCedar: func(context.Context) bool { return true }}
+		businessCase := models.BusinessCase{}
+		_, err := emitting.ValidateAndSubmitBusinessCase(ctx, &businessCase)
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+}
+
+func (s CedarEasiTestSuite) TestGovernanceIntakeDrift() {
+	intake := testhelpers.NewSystemIntake()
+	intake.AlfabetID = null.StringFrom("000-000-0")
+
+	s.Run("an intake CEDAR has as we sent it has no drift", func() {
+		drift, err := governanceIntakeDrift(&intake, systemIntakeToGovernanceIntake(&intake))
+
+		s.NoError(err)
+		s.Empty(drift)
+	})
+
+	s.Run("reports the fields that differ by their CEDAR names", func() {
+		cedarIntake := systemIntakeToGovernanceIntake(&intake)
+		submitted := string(models.SystemIntakeStatusINTAKESUBMITTED)
+		cedarIntake.Status = &submitted
+		withdrawn := intake
+		withdrawn.Status = models.SystemIntakeStatusWITHDRAWN
+
+		drift, err := governanceIntakeDrift(&withdrawn, cedarIntake)
+
+		s.NoError(err)
+		s.Len(drift, 1)
+		s.Equal("status", drift[0].Field)
+		s.Equal("WITHDRAWN", drift[0].EASiValue)
+		s.Equal("INTAKE_SUBMITTED", drift[0].CEDARValue)
+		s.Equal("000-000-0", drift[0].AlfabetID)
+	})
+}
+
+func (s CedarEasiTestSuite) TestCedarUnavailable() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	s.NoError(err)
+	config := cedartransport.Config{
+		Timeout:          time.Second,
+		BreakerThreshold: 1,
+		BreakerCooldown:  time.Minute,
+	}
+	client := newTranslatedClient(
+		httptransport.New(serverURL.Host, apiclient.DefaultBasePath, []string{"http"}),
+		"fake-key",
+		config,
+		func(context.Context) bool { return true },
+	)
+	ctx := context.Background()
+
+	err = client.CheckConnection(ctx)
+	s.IsType(&apperrors.ExternalAPIError{}, err)
+
+	// CEDAR is down, so the next call fails without waiting for it
+	err = client.CheckConnection(ctx)
+	s.IsType(&apperrors.ExternalAPIError{}, err)
+	s.True(errors.Is(err, cedartransport.ErrCircuitOpen))
+	s.Equal(int32(1), atomic.LoadInt32(&calls))
+}
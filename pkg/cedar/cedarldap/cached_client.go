@@ -0,0 +1,120 @@
+package cedarldap
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// DefaultCacheTTL is how long looked up people are kept if it isn't configured
+const DefaultCacheTTL = 5 * time.Minute
+
+type cachedUserInfo struct {
+	userInfo  *models.UserInfo
+	expiresAt time.Time
+}
+
+type cachedSearch struct {
+	people    []*models.UserInfo
+	expiresAt time.Time
+}
+
+// CachedClient keeps the people a Client looks up for a while, so that repeated lookups
+// of the same people don't each call CEDAR LDAP.
+// Failed lookups aren't kept.
+type CachedClient struct {
+	client Client
+	ttl    time.Duration
+	clock  clock.Clock
+
+	mu       sync.Mutex
+	users    map[string]cachedUserInfo
+	searches map[string]cachedSearch
+}
+
+// NewCachedClient returns a Client that keeps what client looks up for ttl
+func NewCachedClient(client Client, ttl time.Duration, clock clock.Clock) *CachedClient {
+	return &CachedClient{
+		client:   client,
+		ttl:      ttl,
+		clock:    clock,
+		users:    map[string]cachedUserInfo{},
+		searches: map[string]cachedSearch{},
+	}
+}
+
+// FetchUserInfo fetches a user's personal details
+func (c *CachedClient) FetchUserInfo(ctx context.Context, euaID string) (*models.UserInfo, error) {
+	c.mu.Lock()
+	cached, ok := c.users[euaID]
+	c.mu.Unlock()
+	if ok && c.clock.Now().Before(cached.expiresAt) {
+		userInfo := *cached.userInfo
+		return &userInfo, nil
+	}
+
+	userInfo, err := c.client.FetchUserInfo(ctx, euaID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired()
+	stored := *userInfo
+	c.users[euaID] = cachedUserInfo{userInfo: &stored, expiresAt: c.clock.Now().Add(c.ttl)}
+	return userInfo, nil
+}
+
+// SearchCommonNameContains searches for people whose name contains the query
+func (c *CachedClient) SearchCommonNameContains(ctx context.Context, commonName string) ([]*models.UserInfo, error) {
+	key := strings.ToLower(strings.Join(strings.Fields(commonName), " "))
+	c.mu.Lock()
+	cached, ok := c.searches[key]
+	c.mu.Unlock()
+	if ok && c.clock.Now().Before(cached.expiresAt) {
+		return copyUserInfos(cached.people), nil
+	}
+
+	people, err := c.client.SearchCommonNameContains(ctx, commonName)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeExpired()
+	c.searches[key] = cachedSearch{people: copyUserInfos(people), expiresAt: c.clock.Now().Add(c.ttl)}
+	return people, nil
+}
+
+// removeExpired forgets lookups that are too old to use, so the cache doesn't grow forever.
+// The caller must hold the lock.
+func (c *CachedClient) removeExpired() {
+	now := c.clock.Now()
+	for euaID, cached := range c.users {
+		if !now.Before(cached.expiresAt) {
+			delete(c.users, euaID)
+		}
+	}
+	for key, cached := range c.searches {
+		if !now.Before(cached.expiresAt) {
+			delete(c.searches, key)
+		}
+	}
+}
+
+// copyUserInfos copies people, so callers can't change what's cached
+func copyUserInfos(people []*models.UserInfo) []*models.UserInfo {
+	copies := make([]*models.UserInfo, len(people))
+	for i, person := range people {
+		userInfo := *person
+		copies[i] = &userInfo
+	}
+	return copies
+}
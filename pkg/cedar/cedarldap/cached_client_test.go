@@ -0,0 +1,140 @@
+package cedarldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type CachedClientTestSuite struct {
+	suite.Suite
+}
+
+func TestCachedClientTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedClientTestSuite))
+}
+
+// countingClient stands in for CEDAR LDAP, counting the lookups it gets
+type countingClient struct {
+	fetches  int
+	searches int
+	err      error
+}
+
+func (c *countingClient) FetchUserInfo(_ context.Context, euaID string) (*models.UserInfo, error) {
+	c.fetches++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &models.UserInfo{CommonName: "Fake Person", Email: "fake@cms.gov", EuaUserID: euaID}, nil
+}
+
+func (c *countingClient) SearchCommonNameContains(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+	c.searches++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return []*models.UserInfo{{CommonName: commonName, Email: "fake@cms.gov", EuaUserID: "FAKE"}}, nil
+}
+
+func (s CachedClientTestSuite) TestFetchUserInfo() {
+	ctx := context.Background()
+
+	s.Run("looks a person up once until the lookup expires", func() {
+		ldap := &countingClient{}
+		mockClock := clock.NewMock()
+		client := NewCachedClient(ldap, time.Minute, mockClock)
+
+		for i := 0; i < 3; i++ {
+			userInfo, err := client.FetchUserInfo(ctx, "ABCD")
+			s.NoError(err)
+			s.Equal("ABCD", userInfo.EuaUserID)
+		}
+		s.Equal(1, ldap.fetches)
+
+		mockClock.Add(time.Minute)
+		_, err := client.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		s.Equal(2, ldap.fetches)
+	})
+
+	s.Run("keeps each person separately", func() {
+		ldap := &countingClient{}
+		client := NewCachedClient(ldap, time.Minute, clock.NewMock())
+
+		_, err := client.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		userInfo, err := client.FetchUserInfo(ctx, "EFGH")
+		s.NoError(err)
+
+		s.Equal("EFGH", userInfo.EuaUserID)
+		s.Equal(2, ldap.fetches)
+	})
+
+	s.Run("doesn't keep a failed lookup", func() {
+		ldap := &countingClient{err: errors.New("CEDAR is down")}
+		client := NewCachedClient(ldap, time.Minute, clock.NewMock())
+
+		_, err := client.FetchUserInfo(ctx, "ABCD")
+		s.Error(err)
+		ldap.err = nil
+		_, err = client.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+
+		s.Equal(2, ldap.fetches)
+	})
+
+	s.Run("callers can't change what's kept", func() {
+		ldap := &countingClient{}
+		client := NewCachedClient(ldap, time.Minute, clock.NewMock())
+
+		userInfo, err := client.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+		userInfo.CommonName = "Changed"
+		userInfo, err = client.FetchUserInfo(ctx, "ABCD")
+		s.NoError(err)
+
+		s.Equal("Fake Person", userInfo.CommonName)
+	})
+}
+
+func (s CachedClientTestSuite) TestSearchCommonNameContains() {
+	ctx := context.Background()
+
+	s.Run("searches once for the same words until the search expires", func() {
+		ldap := &countingClient{}
+		mockClock := clock.NewMock()
+		client := NewCachedClient(ldap, time.Minute, mockClock)
+
+		_, err := client.SearchCommonNameContains(ctx, "Adeline Aarons")
+		s.NoError(err)
+		people, err := client.SearchCommonNameContains(ctx, " adeline  aarons")
+		s.NoError(err)
+		s.Len(people, 1)
+		s.Equal(1, ldap.searches)
+
+		mockClock.Add(time.Minute)
+		_, err = client.SearchCommonNameContains(ctx, "Adeline Aarons")
+		s.NoError(err)
+		s.Equal(2, ldap.searches)
+	})
+
+	s.Run("doesn't keep a failed search", func() {
+		ldap := &countingClient{err: errors.New("CEDAR is down")}
+		client := NewCachedClient(ldap, time.Minute, clock.NewMock())
+
+		_, err := client.SearchCommonNameContains(ctx, "Adeline")
+		s.Error(err)
+		ldap.err = nil
+		_, err = client.SearchCommonNameContains(ctx, "Adeline")
+		s.NoError(err)
+
+		s.Equal(2, ldap.searches)
+	})
+}
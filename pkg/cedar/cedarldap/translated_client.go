@@ -4,17 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/cmsgov/easi-app/pkg/appcontext"
-
+	"github.com/facebookgo/clock"
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	apiclient "github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/client"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/client/operations"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap/gen/models"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedartransport"
 	models2 "github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -27,13 +31,17 @@ type TranslatedClient struct {
 // Client is an interface for helping test dependencies
 type Client interface {
 	FetchUserInfo(context.Context, string) (*models2.UserInfo, error)
+	SearchCommonNameContains(context.Context, string) ([]*models2.UserInfo, error)
 }
 
 // NewTranslatedClient returns an API client for CEDAR LDAP using EASi language
-func NewTranslatedClient(cedarHost string, cedarAPIKey string) TranslatedClient {
+func NewTranslatedClient(cedarHost string, cedarAPIKey string, transportConfig cedartransport.Config) TranslatedClient {
 	// create the transport
 	transport := httptransport.New(cedarHost, apiclient.DefaultBasePath, []string{"https"})
 
+	// time out, retry and stop calling CEDAR while it's failing
+	transport.Transport = cedartransport.New("CEDAR LDAP", transport.Transport, transportConfig, clock.New())
+
 	// create the API client, with the transport
 	client := apiclient.New(transport, strfmt.Default)
 
@@ -45,7 +53,7 @@ func NewTranslatedClient(cedarHost string, cedarAPIKey string) TranslatedClient
 
 // FetchUserInfo fetches a user's personal details
 func (c TranslatedClient) FetchUserInfo(ctx context.Context, euaID string) (*models2.UserInfo, error) {
-	params := operations.NewPersonIDParams()
+	params := operations.NewPersonIDParamsWithContext(ctx)
 	params.ID = euaID
 	resp, err := c.client.Operations.PersonID(params, c.apiAuthHeader)
 	if err != nil {
@@ -74,3 +82,81 @@ func (c TranslatedClient) FetchUserInfo(ctx context.Context, euaID string) (*mod
 		EuaUserID:  resp.Payload.UserName,
 	}, nil
 }
+
+// SearchCountLimit is the most people a search asks CEDAR LDAP for
+const SearchCountLimit = 20
+
+// searchFilterChars are special in the LDAP filter a search becomes
+const searchFilterChars = "*()\\\x00"
+
+// SearchCommonNameContains searches for people whose name contains the query.
+// One word is looked for in first and last names; with more, the first word is
+// looked for in first names and the last in last names.
+// A name with LDAP filter characters in it is rejected with a validation error.
+func (c TranslatedClient) SearchCommonNameContains(ctx context.Context, commonName string) ([]*models2.UserInfo, error) {
+	// the name is wrapped in wildcards for CEDAR LDAP, so these would widen or break the search
+	if strings.ContainsAny(commonName, searchFilterChars) {
+		valErr := apperrors.NewValidationError(
+			errors.New("person search failed validation"),
+			models.PersonList{},
+			commonName,
+		)
+		valErr.WithValidation("commonName", "must not contain *, (, ) or \\")
+		return nil, &valErr
+	}
+
+	words := strings.Fields(commonName)
+	if len(words) == 0 {
+		return []*models2.UserInfo{}, nil
+	}
+
+	countLimit := strconv.Itoa(SearchCountLimit)
+	newParams := func() *operations.PersonParams {
+		return operations.NewPersonParamsWithContext(ctx).WithCountLimit(&countLimit)
+	}
+	first, last := "*"+words[0]+"*", "*"+words[len(words)-1]+"*"
+	searches := []*operations.PersonParams{newParams().WithFirstName(&first).WithLastName(&last)}
+	if len(words) == 1 {
+		searches = []*operations.PersonParams{newParams().WithFirstName(&first), newParams().WithLastName(&last)}
+	}
+
+	found := map[string]*models2.UserInfo{}
+	for _, params := range searches {
+		resp, err := c.client.Operations.Person(params, c.apiAuthHeader)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to search people in CEDAR LDAP with error: %v", err))
+			return nil, &apperrors.ExternalAPIError{
+				Err:       err,
+				Model:     models.PersonList{},
+				ModelID:   commonName,
+				Operation: apperrors.Fetch,
+				Source:    "CEDAR LDAP",
+			}
+		}
+		if resp.Payload == nil {
+			continue
+		}
+		for _, person := range resp.Payload.PersonList {
+			if person == nil || person.UserName == "" {
+				continue
+			}
+			found[person.UserName] = &models2.UserInfo{
+				CommonName: person.CommonName,
+				Email:      person.Email,
+				EuaUserID:  person.UserName,
+			}
+		}
+	}
+
+	people := []*models2.UserInfo{}
+	for _, person := range found {
+		people = append(people, person)
+	}
+	sort.Slice(people, func(i, j int) bool {
+		if people[i].CommonName != people[j].CommonName {
+			return people[i].CommonName < people[j].CommonName
+		}
+		return people[i].EuaUserID < people[j].EuaUserID
+	})
+	return people, nil
+}
@@ -0,0 +1,33 @@
+package cedarldap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+type TranslatedClientTestSuite struct {
+	suite.Suite
+}
+
+func TestTranslatedClientTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslatedClientTestSuite))
+}
+
+func (s TranslatedClientTestSuite) TestSearchCommonNameContains() {
+	// the names are rejected before CEDAR LDAP is called, so the client needs no connection
+	client := TranslatedClient{}
+
+	for _, name := range []string{"Jane Doe (CTR)", "Pat*", "back\\slash", "nul\x00"} {
+		s.Run(fmt.Sprintf("rejects %q", name), func() {
+			people, err := client.SearchCommonNameContains(context.Background(), name)
+
+			s.Nil(people)
+			s.IsType(&apperrors.ValidationError{}, err)
+		})
+	}
+}
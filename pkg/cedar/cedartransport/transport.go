@@ -0,0 +1,225 @@
+package cedartransport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+)
+
+// ErrCircuitOpen is returned without calling CEDAR while it's failing
+var ErrCircuitOpen = errors.New("circuit open: CEDAR has been failing, not calling it")
+
+// Config is how long a Transport waits for CEDAR, and how it copes when CEDAR fails
+type Config struct {
+	// Timeout is how long each attempt at a call may take
+	Timeout time.Duration
+	// MaxRetries is how many more times an idempotent call is tried after it fails
+	MaxRetries int
+	// RetryBackoff is roughly how long to wait before the first retry, doubling for each one after
+	RetryBackoff time.Duration
+	// BreakerThreshold is how many failures in a row stop calls to CEDAR. Zero never stops them.
+	BreakerThreshold int
+	// BreakerCooldown is how long calls are stopped before one is let through to try CEDAR again
+	BreakerCooldown time.Duration
+}
+
+// DefaultConfig is used for settings that aren't configured
+var DefaultConfig = Config{
+	Timeout:          10 * time.Second,
+	MaxRetries:       2,
+	RetryBackoff:     200 * time.Millisecond,
+	BreakerThreshold: 5,
+	BreakerCooldown:  30 * time.Second,
+}
+
+// Transport is an http.RoundTripper for calling CEDAR.
+// It times out each attempt, retries idempotent calls, stops calling CEDAR while it's failing,
+// and logs how each call went.
+type Transport struct {
+	source  string
+	base    http.RoundTripper
+	config  Config
+	breaker *breaker
+}
+
+// New returns a Transport that calls the named CEDAR API with base
+func New(source string, base http.RoundTripper, config Config, clock clock.Clock) *Transport {
+	return &Transport{
+		source: source,
+		base:   base,
+		config: config,
+		breaker: &breaker{
+			clock:     clock,
+			threshold: config.BreakerThreshold,
+			cooldown:  config.BreakerCooldown,
+		},
+	}
+}
+
+// idempotentMethods are safe to retry; a retried POST could submit twice
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	logger := appcontext.ZLogger(ctx).With(
+		zap.String("source", t.source),
+		zap.String("operation", req.Method+" "+req.URL.Path),
+	)
+
+	retries := 0
+	if idempotentMethods[req.Method] && (req.Body == nil || req.GetBody != nil) {
+		retries = t.config.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			logger.Warn("Not calling CEDAR while it's failing", zap.Int("attempt", attempt+1))
+			return nil, ErrCircuitOpen
+		}
+
+		resp, err := t.attempt(req, attempt, logger)
+		failed := err != nil || failedStatus(resp.StatusCode)
+		t.breaker.record(!failed)
+		if !failed || attempt >= retries || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if resp != nil {
+			// the response is dropped for the retry, so free its connection
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(t.backoff(attempt)):
+		}
+	}
+}
+
+// attempt makes one call to CEDAR, bounded by the configured timeout
+func (t *Transport) attempt(req *http.Request, attempt int, logger *zap.Logger) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.config.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.config.Timeout)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	fields := []zap.Field{
+		zap.Int("attempt", attempt+1),
+		zap.Duration("latency", time.Since(start)),
+	}
+	if err != nil {
+		cancel()
+		logger.Error("CEDAR call failed", append(fields, zap.Error(err))...)
+		return nil, err
+	}
+
+	fields = append(fields, zap.Int("status", resp.StatusCode))
+	if failedStatus(resp.StatusCode) {
+		logger.Error("CEDAR call failed", fields...)
+	} else {
+		logger.Info("CEDAR call", fields...)
+	}
+	// the timeout has to last until the caller has read the body
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff waits a random time between half and all of the doubled backoff, so retries spread out
+func (t *Transport) backoff(attempt int) time.Duration {
+	d := t.config.RetryBackoff << uint(attempt)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// failedStatus is a response that says CEDAR is struggling, rather than that the request was wrong
+func failedStatus(status int) bool {
+	return status >= http.StatusInternalServerError || status == http.StatusTooManyRequests
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// breaker counts failures in a row, and stops calls once there are too many.
+// After the cooldown it lets one call through, and calls resume if that succeeds.
+type breaker struct {
+	mu        sync.Mutex
+	clock     clock.Clock
+	threshold int
+	cooldown  time.Duration
+
+	failures int
+	openedAt time.Time
+	open     bool
+	probing  bool
+}
+
+func (b *breaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true
+	}
+	if b.probing || b.clock.Now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) record(success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if success {
+		b.failures = 0
+		b.open = false
+		return
+	}
+	b.failures++
+	if b.open || b.failures >= b.threshold {
+		b.open = true
+		b.openedAt = b.clock.Now()
+	}
+}
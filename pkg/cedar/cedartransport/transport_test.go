@@ -0,0 +1,214 @@
+package cedartransport
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransportTestSuite struct {
+	suite.Suite
+}
+
+func TestTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(TransportTestSuite))
+}
+
+var testConfig = Config{
+	Timeout:          time.Second,
+	MaxRetries:       2,
+	RetryBackoff:     time.Millisecond,
+	BreakerThreshold: 0,
+	BreakerCooldown:  time.Minute,
+}
+
+// newCEDAR stands in for CEDAR, answering each call with the next status and then the last one.
+// It counts the calls it gets.
+func newCEDAR(statuses ...int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if call > len(statuses) {
+			call = len(statuses)
+		}
+		w.WriteHeader(statuses[call-1])
+	}))
+	return server, &calls
+}
+
+func (s TransportTestSuite) TestRetries() {
+	s.Run("retries an idempotent call until it succeeds", func() {
+		server, calls := newCEDAR(http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, testConfig, clock.New())}
+
+		resp, err := client.Get(server.URL)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Equal(int32(3), atomic.LoadInt32(calls))
+	})
+
+	s.Run("gives up after the configured retries", func() {
+		server, calls := newCEDAR(http.StatusInternalServerError)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, testConfig, clock.New())}
+
+		resp, err := client.Get(server.URL)
+
+		s.NoError(err)
+		s.Equal(http.StatusInternalServerError, resp.StatusCode)
+		s.Equal(int32(3), atomic.LoadInt32(calls))
+	})
+
+	s.Run("doesn't retry a POST", func() {
+		server, calls := newCEDAR(http.StatusServiceUnavailable, http.StatusOK)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, testConfig, clock.New())}
+
+		resp, err := client.Post(server.URL, "application/json", bytes.NewBufferString("{}"))
+
+		s.NoError(err)
+		s.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		s.Equal(int32(1), atomic.LoadInt32(calls))
+	})
+
+	s.Run("doesn't retry a request CEDAR rejected", func() {
+		server, calls := newCEDAR(http.StatusBadRequest, http.StatusOK)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, testConfig, clock.New())}
+
+		resp, err := client.Get(server.URL)
+
+		s.NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode)
+		s.Equal(int32(1), atomic.LoadInt32(calls))
+	})
+
+	s.Run("sends the body again with a retried PUT", func() {
+		bodies := []string{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, testConfig, clock.New())}
+		req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewBufferString(`{"status":"WITHDRAWN"}`))
+		s.NoError(err)
+
+		resp, err := client.Do(req)
+
+		s.NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Equal([]string{`{"status":"WITHDRAWN"}`, `{"status":"WITHDRAWN"}`}, bodies)
+	})
+}
+
+func (s TransportTestSuite) TestTimeout() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(500 * time.Millisecond):
+		}
+	}))
+	defer server.Close()
+	config := testConfig
+	config.Timeout = 10 * time.Millisecond
+	config.MaxRetries = 1
+	client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, config, clock.New())}
+
+	start := time.Now()
+	_, err := client.Get(server.URL)
+
+	s.Error(err)
+	s.Less(int64(time.Since(start)), int64(500*time.Millisecond))
+	s.Equal(int32(2), atomic.LoadInt32(&calls))
+}
+
+func (s TransportTestSuite) TestBreaker() {
+	config := testConfig
+	config.MaxRetries = 0
+	config.BreakerThreshold = 2
+
+	s.Run("stops calling CEDAR after failures in a row", func() {
+		server, calls := newCEDAR(http.StatusServiceUnavailable)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, config, clock.NewMock())}
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Get(server.URL)
+			s.NoError(err)
+		}
+		_, err := client.Get(server.URL)
+
+		s.True(errors.Is(err, ErrCircuitOpen))
+		s.Equal(int32(2), atomic.LoadInt32(calls))
+	})
+
+	s.Run("tries CEDAR again after the cooldown", func() {
+		server, calls := newCEDAR(http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK)
+		defer server.Close()
+		mockClock := clock.NewMock()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, config, mockClock)}
+		for i := 0; i < 2; i++ {
+			_, err := client.Get(server.URL)
+			s.NoError(err)
+		}
+
+		mockClock.Add(config.BreakerCooldown)
+		resp, err := client.Get(server.URL)
+		s.NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode)
+
+		// CEDAR recovered, so calls resume
+		resp, err = client.Get(server.URL)
+		s.NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Equal(int32(4), atomic.LoadInt32(calls))
+	})
+
+	s.Run("stops calls again if CEDAR is still failing after the cooldown", func() {
+		server, calls := newCEDAR(http.StatusServiceUnavailable)
+		defer server.Close()
+		mockClock := clock.NewMock()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, config, mockClock)}
+		for i := 0; i < 2; i++ {
+			_, err := client.Get(server.URL)
+			s.NoError(err)
+		}
+
+		mockClock.Add(config.BreakerCooldown)
+		_, err := client.Get(server.URL)
+		s.NoError(err)
+		_, err = client.Get(server.URL)
+
+		s.True(errors.Is(err, ErrCircuitOpen))
+		s.Equal(int32(3), atomic.LoadInt32(calls))
+	})
+
+	s.Run("a rejected request doesn't count as CEDAR failing", func() {
+		server, calls := newCEDAR(http.StatusBadRequest)
+		defer server.Close()
+		client := &http.Client{Transport: New("CEDAR", http.DefaultTransport, config, clock.NewMock())}
+
+		for i := 0; i < 3; i++ {
+			_, err := client.Get(server.URL)
+			s.NoError(err)
+		}
+
+		s.Equal(int32(3), atomic.LoadInt32(calls))
+	})
+}
@@ -0,0 +1,246 @@
+package dataloaders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type contextKey int
+
+const loadersKey contextKey = iota
+
+// Fetchers hold the queries loaders batch lookups into.
+// Each fetches the records for many IDs at once.
+type Fetchers struct {
+	FetchSystemsByIntakeIDs             func(context.Context, []uuid.UUID) ([]*models.System, error)
+	FetchFilesByAccessibilityRequestIDs func(context.Context, []uuid.UUID) ([]models.UploadedFile, error)
+	FetchBusinessCasesByIDs             func(context.Context, []uuid.UUID) ([]*models.BusinessCase, error)
+	FetchNotesBySystemIntakeIDs         func(context.Context, []uuid.UUID) ([]*models.Note, error)
+	FetchActionsBySystemIntakeIDs       func(context.Context, []uuid.UUID) ([]models.Action, error)
+}
+
+// Loaders batch and cache the lookups made while resolving one GraphQL request,
+// so resolving a relation for every node in a list costs one query instead of one per node
+type Loaders struct {
+	systemsByIntakeID           *loader
+	filesByAccessibilityRequest *loader
+	businessCasesByID           *loader
+	notesBySystemIntakeID       *loader
+	actionsBySystemIntakeID     *loader
+}
+
+// NewLoaders returns a fresh set of loaders; they cache results, so they should live for only one request
+func NewLoaders(fetchers Fetchers) *Loaders {
+	return &Loaders{
+		systemsByIntakeID: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			systems, err := fetchers.FetchSystemsByIntakeIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			values := map[uuid.UUID]interface{}{}
+			for _, system := range systems {
+				values[system.ID] = system
+			}
+			return values, nil
+		}),
+		filesByAccessibilityRequest: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			files, err := fetchers.FetchFilesByAccessibilityRequestIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			grouped := map[uuid.UUID][]models.UploadedFile{}
+			for _, file := range files {
+				grouped[file.RequestID] = append(grouped[file.RequestID], file)
+			}
+			values := map[uuid.UUID]interface{}{}
+			for id, files := range grouped {
+				values[id] = files
+			}
+			return values, nil
+		}),
+		businessCasesByID: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			businessCases, err := fetchers.FetchBusinessCasesByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			values := map[uuid.UUID]interface{}{}
+			for _, businessCase := range businessCases {
+				values[businessCase.ID] = businessCase
+			}
+			return values, nil
+		}),
+		notesBySystemIntakeID: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			notes, err := fetchers.FetchNotesBySystemIntakeIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			grouped := map[uuid.UUID][]*models.Note{}
+			for _, note := range notes {
+				grouped[note.SystemIntakeID] = append(grouped[note.SystemIntakeID], note)
+			}
+			values := map[uuid.UUID]interface{}{}
+			for id, notes := range grouped {
+				values[id] = notes
+			}
+			return values, nil
+		}),
+		actionsBySystemIntakeID: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]interface{}, error) {
+			actions, err := fetchers.FetchActionsBySystemIntakeIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			grouped := map[uuid.UUID][]models.Action{}
+			for _, action := range actions {
+				if action.IntakeID == nil {
+					continue
+				}
+				grouped[*action.IntakeID] = append(grouped[*action.IntakeID], action)
+			}
+			values := map[uuid.UUID]interface{}{}
+			for id, actions := range grouped {
+				values[id] = actions
+			}
+			return values, nil
+		}),
+	}
+}
+
+// WithLoaders returns a context with the given loaders
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey, loaders)
+}
+
+// FromContext returns the context's loaders
+func FromContext(ctx context.Context) (*Loaders, bool) {
+	loaders, ok := ctx.Value(loadersKey).(*Loaders)
+	return loaders, ok
+}
+
+// NewMiddleware returns a handler func that gives every request its own loaders
+func NewMiddleware(fetchers Fetchers) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := WithLoaders(r.Context(), NewLoaders(fetchers))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Clear forgets everything loaded so far
+func (l *Loaders) Clear() {
+	l.systemsByIntakeID.clear()
+	l.filesByAccessibilityRequest.clear()
+	l.businessCasesByID.clear()
+	l.notesBySystemIntakeID.clear()
+	l.actionsBySystemIntakeID.clear()
+}
+
+// SubscriptionExtension clears the loaders before each event a subscription sends.
+// A subscription resolves every event with the loaders of the request that started it,
+// which would otherwise serve what they cached for an earlier event.
+type SubscriptionExtension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = SubscriptionExtension{}
+
+// ExtensionName names the extension
+func (SubscriptionExtension) ExtensionName() string {
+	return "DataloadersSubscription"
+}
+
+// Validate accepts any schema
+func (SubscriptionExtension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse clears the loaders before a subscription resolves its next event
+func (SubscriptionExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	// requests rejected before they're parsed have no operation
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+		if l, ok := FromContext(ctx); ok {
+			l.Clear()
+		}
+	}
+	return next(ctx)
+}
+
+// SystemByIntakeID loads the system an intake became
+func (l *Loaders) SystemByIntakeID(ctx context.Context, intakeID uuid.UUID) (*models.System, error) {
+	value, err := l.systemsByIntakeID.load(ctx, intakeID)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, &apperrors.ResourceNotFoundError{
+			Err:      errors.New("intake is not a system"),
+			Resource: models.System{},
+		}
+	}
+	// copy the system, so callers filling in fields don't share it
+	system := *value.(*models.System)
+	return &system, nil
+}
+
+// FilesByAccessibilityRequestID loads the files uploaded for an accessibility request
+func (l *Loaders) FilesByAccessibilityRequestID(ctx context.Context, requestID uuid.UUID) ([]models.UploadedFile, error) {
+	value, err := l.filesByAccessibilityRequest.load(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return []models.UploadedFile{}, nil
+	}
+	return value.([]models.UploadedFile), nil
+}
+
+// BusinessCaseByID loads a business case
+func (l *Loaders) BusinessCaseByID(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+	value, err := l.businessCasesByID.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, &apperrors.ResourceNotFoundError{
+			Err:      errors.New("business case not found"),
+			Resource: models.BusinessCase{},
+		}
+	}
+	return value.(*models.BusinessCase), nil
+}
+
+// NotesBySystemIntakeID loads the notes on an intake
+func (l *Loaders) NotesBySystemIntakeID(ctx context.Context, intakeID uuid.UUID) ([]*models.Note, error) {
+	value, err := l.notesBySystemIntakeID.load(ctx, intakeID)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return []*models.Note{}, nil
+	}
+	return value.([]*models.Note), nil
+}
+
+// ActionsBySystemIntakeID loads the actions taken on an intake
+func (l *Loaders) ActionsBySystemIntakeID(ctx context.Context, intakeID uuid.UUID) ([]models.Action, error) {
+	value, err := l.actionsBySystemIntakeID.load(ctx, intakeID)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return []models.Action{}, nil
+	}
+	return value.([]models.Action), nil
+}
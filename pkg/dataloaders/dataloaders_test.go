@@ -0,0 +1,180 @@
+package dataloaders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type DataloadersTestSuite struct {
+	suite.Suite
+}
+
+func TestDataloadersTestSuite(t *testing.T) {
+	suite.Run(t, &DataloadersTestSuite{Suite: suite.Suite{}})
+}
+
+// countingFetchers return the records for the IDs they are given, counting the batches they fetch
+type countingFetchers struct {
+	mu      sync.Mutex
+	batches map[string][][]uuid.UUID
+}
+
+func (c *countingFetchers) record(name string, ids []uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.batches[name] = append(c.batches[name], ids)
+}
+
+func (c *countingFetchers) fetchers() Fetchers {
+	c.batches = map[string][][]uuid.UUID{}
+	return Fetchers{
+		FetchSystemsByIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.System, error) {
+			c.record("systems", ids)
+			systems := []*models.System{}
+			for _, id := range ids {
+				systems = append(systems, &models.System{ID: id, Name: id.String()})
+			}
+			return systems, nil
+		},
+		FetchFilesByAccessibilityRequestIDs: func(_ context.Context, ids []uuid.UUID) ([]models.UploadedFile, error) {
+			c.record("files", ids)
+			files := []models.UploadedFile{}
+			for _, id := range ids {
+				files = append(files, models.UploadedFile{ID: uuid.New(), RequestID: id}, models.UploadedFile{ID: uuid.New(), RequestID: id})
+			}
+			return files, nil
+		},
+		FetchBusinessCasesByIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+			c.record("businessCases", ids)
+			return []*models.BusinessCase{}, nil
+		},
+		FetchNotesBySystemIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+			c.record("notes", ids)
+			return nil, errors.New("forced error")
+		},
+		FetchActionsBySystemIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]models.Action, error) {
+			c.record("actions", ids)
+			return []models.Action{}, nil
+		},
+	}
+}
+
+// loadConcurrently loads every ID at once, the way gqlgen resolves the nodes of a list
+func loadConcurrently(ids []uuid.UUID, load func(uuid.UUID)) {
+	wg := sync.WaitGroup{}
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id uuid.UUID) {
+			defer wg.Done()
+			load(id)
+		}(id)
+	}
+	wg.Wait()
+}
+
+func (s DataloadersTestSuite) TestBatching() {
+	ctx := context.Background()
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	s.Run("loads a list's relations in one query each", func() {
+		counter := &countingFetchers{}
+		loaders := NewLoaders(counter.fetchers())
+
+		loadConcurrently(ids, func(id uuid.UUID) {
+			system, err := loaders.SystemByIntakeID(ctx, id)
+			s.NoError(err)
+			s.Equal(id, system.ID)
+
+			files, err := loaders.FilesByAccessibilityRequestID(ctx, id)
+			s.NoError(err)
+			s.Len(files, 2)
+		})
+
+		s.Len(counter.batches["systems"], 1)
+		s.ElementsMatch(ids, counter.batches["systems"][0])
+		s.Len(counter.batches["files"], 1)
+	})
+
+	s.Run("caches what it loaded", func() {
+		counter := &countingFetchers{}
+		loaders := NewLoaders(counter.fetchers())
+
+		for i := 0; i < 2; i++ {
+			_, err := loaders.SystemByIntakeID(ctx, ids[0])
+			s.NoError(err)
+		}
+
+		s.Len(counter.batches["systems"], 1)
+	})
+
+	s.Run("splits batches bigger than the max", func() {
+		counter := &countingFetchers{}
+		loaders := NewLoaders(counter.fetchers())
+		loaders.systemsByIntakeID.maxBatch = 2
+
+		loadConcurrently(ids, func(id uuid.UUID) {
+			_, err := loaders.SystemByIntakeID(ctx, id)
+			s.NoError(err)
+		})
+
+		fetched := []uuid.UUID{}
+		for _, batch := range counter.batches["systems"] {
+			s.LessOrEqual(len(batch), 2)
+			fetched = append(fetched, batch...)
+		}
+		s.ElementsMatch(ids, fetched)
+	})
+}
+
+func (s DataloadersTestSuite) TestMissingRecords() {
+	ctx := context.Background()
+	counter := &countingFetchers{}
+	loaders := NewLoaders(counter.fetchers())
+
+	s.Run("a missing business case is not found", func() {
+		_, err := loaders.BusinessCaseByID(ctx, uuid.New())
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("an intake without actions has an empty list", func() {
+		actions, err := loaders.ActionsBySystemIntakeID(ctx, uuid.New())
+		s.NoError(err)
+		s.NotNil(actions)
+		s.Empty(actions)
+	})
+
+	s.Run("a failed query fails every load in the batch", func() {
+		loadConcurrently([]uuid.UUID{uuid.New(), uuid.New()}, func(id uuid.UUID) {
+			_, err := loaders.NotesBySystemIntakeID(ctx, id)
+			s.Error(err)
+		})
+		s.Len(counter.batches["notes"], 1)
+	})
+}
+
+func (s DataloadersTestSuite) TestMiddleware() {
+	counter := &countingFetchers{}
+	seen := []*Loaders{}
+	handler := NewMiddleware(counter.fetchers())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		loaders, ok := FromContext(r.Context())
+		s.True(ok)
+		seen = append(seen, loaders)
+	}))
+
+	for i := 0; i < 2; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/query", nil))
+	}
+
+	s.Len(seen, 2)
+	s.NotSame(seen[0], seen[1], "every request gets its own loaders")
+}
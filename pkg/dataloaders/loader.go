@@ -0,0 +1,102 @@
+package dataloaders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultWait is how long a loader waits for more keys before fetching a batch
+	defaultWait = 2 * time.Millisecond
+	// defaultMaxBatch is the most keys a loader fetches at once
+	defaultMaxBatch = 100
+)
+
+// batchFunc fetches the values for many keys at once.
+// Keys without a value are left out of the map.
+type batchFunc func(context.Context, []uuid.UUID) (map[uuid.UUID]interface{}, error)
+
+// loader batches the lookups made within a short wait into one fetch,
+// and caches every result for the life of the loader
+type loader struct {
+	fetch    batchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mu sync.Mutex
+	// cache maps each key to the batch that fetches it
+	cache map[uuid.UUID]*batch
+	// current is the batch still collecting keys, if any
+	current *batch
+}
+
+// batch is one fetch for a set of keys
+type batch struct {
+	ctx    context.Context
+	keys   []uuid.UUID
+	once   sync.Once
+	done   chan struct{}
+	values map[uuid.UUID]interface{}
+	err    error
+}
+
+func newLoader(fetch batchFunc) *loader {
+	return &loader{
+		fetch:    fetch,
+		wait:     defaultWait,
+		maxBatch: defaultMaxBatch,
+		cache:    map[uuid.UUID]*batch{},
+	}
+}
+
+// load returns the value for a key, or nil if it has none.
+// It blocks until the batch holding the key has been fetched.
+func (l *loader) load(ctx context.Context, key uuid.UUID) (interface{}, error) {
+	l.mu.Lock()
+	b, ok := l.cache[key]
+	if !ok {
+		b = l.current
+		if b == nil {
+			b = &batch{ctx: ctx, done: make(chan struct{})}
+			l.current = b
+			go func() {
+				time.Sleep(l.wait)
+				l.dispatch(b)
+			}()
+		}
+		b.keys = append(b.keys, key)
+		l.cache[key] = b
+		if len(b.keys) >= l.maxBatch {
+			l.current = nil
+			go l.dispatch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	<-b.done
+	return b.values[key], b.err
+}
+
+// dispatch fetches a batch, once, and wakes everything waiting on it
+func (l *loader) dispatch(b *batch) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.current == b {
+			l.current = nil
+		}
+		l.mu.Unlock()
+
+		b.values, b.err = l.fetch(b.ctx, b.keys)
+		close(b.done)
+	})
+}
+
+// clear forgets every cached result, so later loads fetch again
+func (l *loader) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cache = map[uuid.UUID]*batch{}
+}
@@ -1,50 +1,48 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
 	"path"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type businessCaseSubmission struct {
+	Requester        string
 	BusinessCaseLink string
 }
 
-func (c Client) businessCaseSubmissionBody(systemIntakeID uuid.UUID) (string, error) {
+func (c Client) businessCaseSubmissionBody(requester string, systemIntakeID uuid.UUID) (renderedEmail, error) {
 	businessCasePath := path.Join("governance-review-team", systemIntakeID.String(), "business-case")
 	data := businessCaseSubmission{
+		Requester:        requester,
 		BusinessCaseLink: c.urlFromPath(businessCasePath),
 	}
-	var b bytes.Buffer
-	if c.templates.businessCaseSubmissionTemplate == nil {
-		return "", errors.New("business case submission template is nil")
+	if c.templates.businessCaseSubmissionTemplate.isNil() {
+		return renderedEmail{}, errors.New("business case submission template is nil")
 	}
-	err := c.templates.businessCaseSubmissionTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
-	}
-	return b.String(), nil
+	return c.templates.businessCaseSubmissionTemplate.render(data)
 }
 
 // SendBusinessCaseSubmissionEmail sends an email for a submitted business case
 func (c Client) SendBusinessCaseSubmissionEmail(ctx context.Context, requester string, systemIntakeID uuid.UUID) error {
-	subject := fmt.Sprintf("New Business Case: %s", requester)
-	body, err := c.businessCaseSubmissionBody(systemIntakeID)
+	rendered, err := c.businessCaseSubmissionBody(requester, systemIntakeID)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
-	err = c.sender.Send(
-		ctx,
-		c.config.GRTEmail,
-		subject,
-		body,
-	)
+	err = c.send(ctx, models.OutboxEmail{
+		ToAddresses:  pq.StringArray{c.config.GRTEmail},
+		Subject:      rendered.subject,
+		Body:         rendered.html,
+		TextBody:     rendered.text,
+		TemplateName: businessCaseSubmissionTemplateName,
+		IntakeID:     &systemIntakeID,
+	})
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
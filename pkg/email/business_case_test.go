@@ -54,7 +54,7 @@ func (s *EmailTestSuite) TestSendBusinessCaseSubmissionEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.businessCaseSubmissionTemplate = mockFailedTemplateCaller{}
+		client.templates.businessCaseSubmissionTemplate.html = mockFailedTemplateCaller{}
 
 		err = client.SendBusinessCaseSubmissionEmail(ctx, tester, businessCaseID)
 
@@ -1,12 +1,23 @@
 package email
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"html/template"
+	htmltemplate "html/template"
 	"io"
 	"net/url"
 	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 // Config holds EASi application specific configs for SES
@@ -17,33 +28,96 @@ type Config struct {
 	TemplateDirectory string
 }
 
+// template names, also recorded on outbox emails.
+// Each template has an HTML body in <name>.gohtml,
+// a plain-text body in <name>.gotext and a subject in <name>_subject.gotext
+const (
+	systemIntakeSubmissionTemplateName = "system_intake_submission"
+	businessCaseSubmissionTemplateName = "business_case_submission"
+	intakeReviewTemplateName           = "system_intake_review"
+	namedRequestWithdrawTemplateName   = "named_request_withdrawal"
+	unnamedRequestWithdrawTemplateName = "unnamed_request_withdrawal"
+	issueLCIDTemplateName              = "issue_lcid"
+	rejectRequestTemplateName          = "reject_request"
+	lcidExpirationTemplateName         = "lcid_expiration_reminder"
+	extendLCIDTemplateName             = "extend_lcid"
+	amendLCIDTemplateName              = "amend_lcid"
+	retireLCIDTemplateName             = "retire_lcid"
+)
+
 // templateCaller is an interface to helping with testing template dependencies
 type templateCaller interface {
 	Execute(wr io.Writer, data interface{}) error
 }
 
+// emailTemplate holds the parts rendered for a single email
+type emailTemplate struct {
+	subject templateCaller
+	html    templateCaller
+	text    templateCaller
+}
+
+// renderedEmail is the output of an emailTemplate
+type renderedEmail struct {
+	subject string
+	html    string
+	text    string
+}
+
+// render executes each part of the template with the same data
+func (t emailTemplate) render(data interface{}) (renderedEmail, error) {
+	var subject, html, text bytes.Buffer
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return renderedEmail{}, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return renderedEmail{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return renderedEmail{}, err
+	}
+	return renderedEmail{
+		subject: strings.TrimSpace(subject.String()),
+		html:    html.String(),
+		text:    text.String(),
+	}, nil
+}
+
+// isNil returns whether any part of the template is missing
+func (t emailTemplate) isNil() bool {
+	return t.subject == nil || t.html == nil || t.text == nil
+}
+
 // templates stores typed templates
 // since the template.Template uses string access
 type templates struct {
-	systemIntakeSubmissionTemplate templateCaller
-	businessCaseSubmissionTemplate templateCaller
-	intakeReviewTemplate           templateCaller
-	namedRequestWithdrawTemplate   templateCaller
-	unnamedRequestWithdrawTemplate templateCaller
-	issueLCIDTemplate              templateCaller
-	rejectRequestTemplate          templateCaller
+	systemIntakeSubmissionTemplate emailTemplate
+	businessCaseSubmissionTemplate emailTemplate
+	intakeReviewTemplate           emailTemplate
+	namedRequestWithdrawTemplate   emailTemplate
+	unnamedRequestWithdrawTemplate emailTemplate
+	issueLCIDTemplate              emailTemplate
+	rejectRequestTemplate          emailTemplate
+	lcidExpirationTemplate         emailTemplate
+	extendLCIDTemplate             emailTemplate
+	amendLCIDTemplate              emailTemplate
+	retireLCIDTemplate             emailTemplate
 }
 
 // sender is an interface for swapping out email provider implementations
 type sender interface {
-	Send(ctx context.Context, toAddress string, subject string, body string) error
+	Send(ctx context.Context, email models.Email) error
 }
 
+// createOutboxEmail persists an email for the OutboxWorker to deliver
+type createOutboxEmail func(context.Context, *models.OutboxEmail) (*models.OutboxEmail, error)
+
 // Client is an EASi SES client wrapper
 type Client struct {
 	config    Config
 	templates templates
 	sender    sender
+	outbox    createOutboxEmail
 }
 
 // templateError is just a helper method for formatting errors
@@ -51,62 +125,101 @@ func templateError(name string) error {
 	return fmt.Errorf("failed to get template: %s", name)
 }
 
+// rawTemplates are the parsed template files,
+// with HTML bodies escaped and text bodies and subjects left as written
+type rawTemplates struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// lookup finds all three parts of an email template
+func (r rawTemplates) lookup(name string) (emailTemplate, error) {
+	subject := r.text.Lookup(name + "_subject.gotext")
+	if subject == nil {
+		return emailTemplate{}, templateError(name + "_subject.gotext")
+	}
+	html := r.html.Lookup(name + ".gohtml")
+	if html == nil {
+		return emailTemplate{}, templateError(name + ".gohtml")
+	}
+	text := r.text.Lookup(name + ".gotext")
+	if text == nil {
+		return emailTemplate{}, templateError(name + ".gotext")
+	}
+	return emailTemplate{
+		subject: subject,
+		html:    html,
+		text:    text,
+	}, nil
+}
+
 // NewClient returns a new email client for EASi
 func NewClient(config Config, sender sender) (Client, error) {
-	rawTemplates, err := template.ParseGlob(path.Join(config.TemplateDirectory, "*.gohtml"))
+	htmlTemplates, err := htmltemplate.ParseGlob(path.Join(config.TemplateDirectory, "*.gohtml"))
+	if err != nil {
+		return Client{}, err
+	}
+	textTemplates, err := texttemplate.ParseGlob(path.Join(config.TemplateDirectory, "*.gotext"))
 	if err != nil {
 		return Client{}, err
 	}
+	raw := rawTemplates{html: htmlTemplates, text: textTemplates}
 	appTemplates := templates{}
 
-	systemIntakeSubmissionTemplateName := "system_intake_submission.gohtml"
-	systemIntakeSubmissionTemplate := rawTemplates.Lookup(systemIntakeSubmissionTemplateName)
-	if systemIntakeSubmissionTemplate == nil {
-		return Client{}, templateError(systemIntakeSubmissionTemplateName)
+	appTemplates.systemIntakeSubmissionTemplate, err = raw.lookup(systemIntakeSubmissionTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.systemIntakeSubmissionTemplate = systemIntakeSubmissionTemplate
 
-	businessCaseSubmissionTemplateName := "business_case_submission.gohtml"
-	businessCaseSubmissionTemplate := rawTemplates.Lookup(businessCaseSubmissionTemplateName)
-	if businessCaseSubmissionTemplate == nil {
-		return Client{}, templateError(businessCaseSubmissionTemplateName)
+	appTemplates.businessCaseSubmissionTemplate, err = raw.lookup(businessCaseSubmissionTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.businessCaseSubmissionTemplate = businessCaseSubmissionTemplate
 
-	intakeReviewTemplateName := "system_intake_review.gohtml"
-	intakeReviewTemplate := rawTemplates.Lookup(intakeReviewTemplateName)
-	if intakeReviewTemplate == nil {
-		return Client{}, templateError(intakeReviewTemplateName)
+	appTemplates.intakeReviewTemplate, err = raw.lookup(intakeReviewTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.intakeReviewTemplate = intakeReviewTemplate
 
-	namedRequestWithdrawTemplateName := "named_request_withdrawal.gohtml"
-	namedRequestWithdrawTemplate := rawTemplates.Lookup(namedRequestWithdrawTemplateName)
-	if namedRequestWithdrawTemplate == nil {
-		return Client{}, templateError(namedRequestWithdrawTemplateName)
+	appTemplates.namedRequestWithdrawTemplate, err = raw.lookup(namedRequestWithdrawTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.namedRequestWithdrawTemplate = namedRequestWithdrawTemplate
 
-	unnamedRequestWithdrawTemplateName := "unnamed_request_withdrawal.gohtml"
-	unnamedRequestWithdrawTemplate := rawTemplates.Lookup(unnamedRequestWithdrawTemplateName)
-	if unnamedRequestWithdrawTemplate == nil {
-		return Client{}, templateError(unnamedRequestWithdrawTemplateName)
+	appTemplates.unnamedRequestWithdrawTemplate, err = raw.lookup(unnamedRequestWithdrawTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.unnamedRequestWithdrawTemplate = unnamedRequestWithdrawTemplate
 
-	issueLCIDTemplateName := "issue_lcid.gohtml"
-	issueLCIDTemplate := rawTemplates.Lookup(issueLCIDTemplateName)
-	if issueLCIDTemplate == nil {
-		return Client{}, templateError(issueLCIDTemplateName)
+	appTemplates.issueLCIDTemplate, err = raw.lookup(issueLCIDTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.issueLCIDTemplate = issueLCIDTemplate
 
-	rejectRequestTemplateName := "reject_request.gohtml"
-	rejectRequestTemplate := rawTemplates.Lookup(rejectRequestTemplateName)
-	if rejectRequestTemplate == nil {
-		return Client{}, templateError(rejectRequestTemplateName)
+	appTemplates.rejectRequestTemplate, err = raw.lookup(rejectRequestTemplateName)
+	if err != nil {
+		return Client{}, err
+	}
+
+	appTemplates.lcidExpirationTemplate, err = raw.lookup(lcidExpirationTemplateName)
+	if err != nil {
+		return Client{}, err
+	}
+
+	appTemplates.extendLCIDTemplate, err = raw.lookup(extendLCIDTemplateName)
+	if err != nil {
+		return Client{}, err
+	}
+
+	appTemplates.amendLCIDTemplate, err = raw.lookup(amendLCIDTemplateName)
+	if err != nil {
+		return Client{}, err
+	}
+
+	appTemplates.retireLCIDTemplate, err = raw.lookup(retireLCIDTemplateName)
+	if err != nil {
+		return Client{}, err
 	}
-	appTemplates.rejectRequestTemplate = rejectRequestTemplate
 
 	client := Client{
 		config:    config,
@@ -126,8 +239,65 @@ func (c Client) urlFromPath(path string) string {
 	return u.String()
 }
 
+// decisionEmail addresses an email to the intake contacts a reviewer chose to notify,
+// copying the GRT if they asked and sending replies to the GRT
+func (c Client) decisionEmail(intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients) models.OutboxEmail {
+	email := models.OutboxEmail{
+		IntakeID:     &intakeID,
+		ToAddresses:  pq.StringArray{},
+		CCAddresses:  pq.StringArray{},
+		BCCAddresses: pq.StringArray{},
+		ReplyTo:      null.StringFrom(c.config.GRTEmail),
+	}
+	if recipients.NotifyRequester {
+		email.ToAddresses = append(email.ToAddresses, requesterEmail)
+	}
+	email.ToAddresses = append(email.ToAddresses, recipients.ContactEmails...)
+	if recipients.NotifyGRT {
+		email.CCAddresses = append(email.CCAddresses, c.config.GRTEmail)
+	}
+	return email
+}
+
+// WithOutbox returns a client that queues emails in the outbox
+// instead of handing them straight to the sender
+func (c Client) WithOutbox(outbox createOutboxEmail) Client {
+	c.outbox = outbox
+	return c
+}
+
+// send queues an email in the outbox, joining the transaction on the context if there is one.
+// Without an outbox, it hands the email to the sender once the transaction commits,
+// or right away if there is no transaction.
+// Failures after a commit can't be returned to the caller, so they are logged.
+func (c Client) send(ctx context.Context, email models.OutboxEmail) error {
+	if c.outbox != nil {
+		_, err := c.outbox(ctx, &email)
+		return err
+	}
+	deferred := appcontext.AfterCommit(ctx, func(ctx context.Context) {
+		err := c.sender.Send(ctx, email.Email())
+		if err != nil {
+			appcontext.ZLogger(ctx).Error(
+				"Failed to send email after commit",
+				zap.String("Subject", email.Subject),
+				zap.Error(err),
+			)
+		}
+	})
+	if deferred {
+		return nil
+	}
+	return c.sender.Send(ctx, email.Email())
+}
+
 // SendTestEmail sends an email to a no-reply address
 func (c Client) SendTestEmail(ctx context.Context) error {
 	const testToAddress = "success@simulator.amazonses.com"
-	return c.sender.Send(ctx, testToAddress, "test", "test")
+	return c.sender.Send(ctx, models.Email{
+		ToAddresses: []string{testToAddress},
+		Subject:     "test",
+		Body:        "test",
+		TextBody:    "test",
+	})
 }
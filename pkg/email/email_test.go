@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
 
@@ -23,18 +25,22 @@ type mockSender struct {
 	toAddress string
 	subject   string
 	body      string
+	textBody  string
+	email     models.Email
 }
 
-func (s *mockSender) Send(ctx context.Context, toAddress string, subject string, body string) error {
-	s.toAddress = toAddress
-	s.subject = subject
-	s.body = body
+func (s *mockSender) Send(ctx context.Context, email models.Email) error {
+	s.toAddress = strings.Join(email.ToAddresses, ", ")
+	s.subject = email.Subject
+	s.body = email.Body
+	s.textBody = email.TextBody
+	s.email = email
 	return nil
 }
 
 type mockFailedSender struct{}
 
-func (s *mockFailedSender) Send(ctx context.Context, toAddress string, subject string, body string) error {
+func (s *mockFailedSender) Send(ctx context.Context, email models.Email) error {
 	return errors.New("sender had an error")
 }
 
@@ -1,45 +1,41 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type intakeReview struct {
 	EmailText string
 }
 
-func (c Client) systemIntakeReviewBody(EmailText string) (string, error) {
+func (c Client) systemIntakeReviewBody(EmailText string) (renderedEmail, error) {
 	data := intakeReview{
 		EmailText: EmailText,
 	}
-	var b bytes.Buffer
-	if c.templates.intakeReviewTemplate == nil {
-		return "", errors.New("system intake review template is nil")
-	}
-	err := c.templates.intakeReviewTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
+	if c.templates.intakeReviewTemplate.isNil() {
+		return renderedEmail{}, errors.New("system intake review template is nil")
 	}
-	return b.String(), nil
+	return c.templates.intakeReviewTemplate.render(data)
 }
 
 // SendSystemIntakeReviewEmail sends an email for a submitted system intake
-func (c Client) SendSystemIntakeReviewEmail(ctx context.Context, emailText string, recipientAddress string) error {
-	subject := "Feedback on your intake request"
-	body, err := c.systemIntakeReviewBody(emailText)
+func (c Client) SendSystemIntakeReviewEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, emailText string) error {
+	rendered, err := c.systemIntakeReviewBody(emailText)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
-	err = c.sender.Send(
-		ctx,
-		recipientAddress,
-		subject,
-		body,
-	)
+	email := c.decisionEmail(intakeID, requesterEmail, recipients)
+	email.Subject = rendered.subject
+	email.Body = rendered.html
+	email.TextBody = rendered.text
+	email.TemplateName = intakeReviewTemplateName
+	err = c.send(ctx, email)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
@@ -3,7 +3,10 @@ package email
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 func (s *EmailTestSuite) TestSendIntakeReviewEmail() {
@@ -11,13 +14,15 @@ func (s *EmailTestSuite) TestSendIntakeReviewEmail() {
 	ctx := context.Background()
 	recipientAddress := "sample@test.com"
 	emailBody := "Test Text\n\nTest"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
 
 	s.Run("successful call has the right content", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 		expectedEmail := "<p>Test Text\n\nTest</p>\n"
 
-		err = client.SendSystemIntakeReviewEmail(ctx, emailBody, recipientAddress)
+		err = client.SendSystemIntakeReviewEmail(ctx, intakeID, recipientAddress, requesterOnly, emailBody)
 
 		s.NoError(err)
 		s.Equal(recipientAddress, sender.toAddress)
@@ -30,7 +35,7 @@ func (s *EmailTestSuite) TestSendIntakeReviewEmail() {
 		s.NoError(err)
 		client.templates = templates{}
 
-		err = client.SendSystemIntakeReviewEmail(ctx, emailBody, recipientAddress)
+		err = client.SendSystemIntakeReviewEmail(ctx, intakeID, recipientAddress, requesterOnly, emailBody)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -42,9 +47,9 @@ func (s *EmailTestSuite) TestSendIntakeReviewEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.intakeReviewTemplate = mockFailedTemplateCaller{}
+		client.templates.intakeReviewTemplate.html = mockFailedTemplateCaller{}
 
-		err = client.SendSystemIntakeReviewEmail(ctx, emailBody, recipientAddress)
+		err = client.SendSystemIntakeReviewEmail(ctx, intakeID, recipientAddress, requesterOnly, emailBody)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -59,7 +64,7 @@ func (s *EmailTestSuite) TestSendIntakeReviewEmail() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 
-		err = client.SendSystemIntakeReviewEmail(ctx, emailBody, recipientAddress)
+		err = client.SendSystemIntakeReviewEmail(ctx, intakeID, recipientAddress, requesterOnly, emailBody)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
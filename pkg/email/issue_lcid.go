@@ -1,12 +1,14 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type issueLCID struct {
@@ -17,7 +19,7 @@ type issueLCID struct {
 	Feedback    string
 }
 
-func (c Client) issueLCIDBody(lcid string, expiresAt *time.Time, scope string, nextSteps string, feedback string) (string, error) {
+func (c Client) issueLCIDBody(lcid string, expiresAt *time.Time, scope string, nextSteps string, feedback string) (renderedEmail, error) {
 	data := issueLCID{
 		LifecycleID: lcid,
 		ExpiresAt:   expiresAt.Format("January 2, 2006"),
@@ -25,30 +27,24 @@ func (c Client) issueLCIDBody(lcid string, expiresAt *time.Time, scope string, n
 		NextSteps:   nextSteps,
 		Feedback:    feedback,
 	}
-	var b bytes.Buffer
-	if c.templates.issueLCIDTemplate == nil {
-		return "", errors.New("issue LCID template is nil")
-	}
-	err := c.templates.issueLCIDTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
+	if c.templates.issueLCIDTemplate.isNil() {
+		return renderedEmail{}, errors.New("issue LCID template is nil")
 	}
-	return b.String(), nil
+	return c.templates.issueLCIDTemplate.render(data)
 }
 
 // SendIssueLCIDEmail sends an email for issuing an LCID
-func (c Client) SendIssueLCIDEmail(ctx context.Context, recipient string, lcid string, expirationDate *time.Time, scope string, nextSteps string, feedback string) error {
-	subject := "Your request has been approved"
-	body, err := c.issueLCIDBody(lcid, expirationDate, scope, nextSteps, feedback)
+func (c Client) SendIssueLCIDEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, lcid string, expirationDate *time.Time, scope string, nextSteps string, feedback string) error {
+	rendered, err := c.issueLCIDBody(lcid, expirationDate, scope, nextSteps, feedback)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
-	err = c.sender.Send(
-		ctx,
-		recipient,
-		subject,
-		body,
-	)
+	email := c.decisionEmail(intakeID, requesterEmail, recipients)
+	email.Subject = rendered.subject
+	email.Body = rendered.html
+	email.TextBody = rendered.text
+	email.TemplateName = issueLCIDTemplateName
+	err = c.send(ctx, email)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
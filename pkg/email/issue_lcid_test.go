@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
@@ -16,6 +19,8 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 	scope := "scope"
 	nextSteps := "nextSteps"
 	feedback := "feedback"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
 
 	s.Run("successful call has the right content", func() {
 		client, err := NewClient(s.config, &sender)
@@ -23,12 +28,15 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 
 		expectedEmail := "<p>Lifecycle ID: 123456</p>\n<p>Expiration Date: January 1, 0001</p>\n<p>Scope: scope</p>\n" +
 			"<p>Next Steps: nextSteps</p>\n\n<p>feedback</p>"
-		err = client.SendIssueLCIDEmail(ctx, recipient, lcid, &expiresAt, scope, nextSteps, feedback)
+		expectedText := "Lifecycle ID: 123456\nExpiration Date: January 1, 0001\nScope: scope\n" +
+			"Next Steps: nextSteps\n\nfeedback\n"
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, requesterOnly, lcid, &expiresAt, scope, nextSteps, feedback)
 
 		s.NoError(err)
 		s.Equal(recipient, sender.toAddress)
 		s.Equal("Your request has been approved", sender.subject)
 		s.Equal(expectedEmail, sender.body)
+		s.Equal(expectedText, sender.textBody)
 	})
 
 	s.Run("successful call has the right content with no next steps", func() {
@@ -37,7 +45,7 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 
 		expectedEmail := "<p>Lifecycle ID: 123456</p>\n<p>Expiration Date: January 1, 0001</p>\n<p>Scope: scope</p>" +
 			"\n\n<p>feedback</p>"
-		err = client.SendIssueLCIDEmail(ctx, recipient, lcid, &expiresAt, scope, "", feedback)
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, requesterOnly, lcid, &expiresAt, scope, "", feedback)
 
 		s.NoError(err)
 		s.Equal(recipient, sender.toAddress)
@@ -45,12 +53,43 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 		s.Equal(expectedEmail, sender.body)
 	})
 
+	s.Run("successful call goes to the chosen contacts and copies the GRT", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		recipients := models.EmailRecipients{
+			NotifyRequester: true,
+			NotifyGRT:       true,
+			ContactEmails:   []string{"owner@fake.com", "isso@fake.com"},
+		}
+
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, recipients, lcid, &expiresAt, scope, nextSteps, feedback)
+
+		s.NoError(err)
+		s.Equal([]string{recipient, "owner@fake.com", "isso@fake.com"}, sender.email.ToAddresses)
+		s.Equal([]string{s.config.GRTEmail}, sender.email.CCAddresses)
+		s.Equal(s.config.GRTEmail, sender.email.ReplyTo)
+	})
+
+	s.Run("successful call can leave out the requester", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		recipients := models.EmailRecipients{
+			ContactEmails: []string{"owner@fake.com"},
+		}
+
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, recipients, lcid, &expiresAt, scope, nextSteps, feedback)
+
+		s.NoError(err)
+		s.Equal([]string{"owner@fake.com"}, sender.email.ToAddresses)
+		s.Empty(sender.email.CCAddresses)
+	})
+
 	s.Run("if the template is nil, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 		client.templates = templates{}
 
-		err = client.SendIssueLCIDEmail(ctx, recipient, lcid, &expiresAt, scope, nextSteps, feedback)
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, requesterOnly, lcid, &expiresAt, scope, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -62,9 +101,9 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.issueLCIDTemplate = mockFailedTemplateCaller{}
+		client.templates.issueLCIDTemplate.html = mockFailedTemplateCaller{}
 
-		err = client.SendIssueLCIDEmail(ctx, recipient, lcid, &expiresAt, scope, nextSteps, feedback)
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, requesterOnly, lcid, &expiresAt, scope, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -79,7 +118,7 @@ func (s *EmailTestSuite) TestSendIssueLCIDEmail() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 
-		err = client.SendIssueLCIDEmail(ctx, recipient, lcid, &expiresAt, scope, nextSteps, feedback)
+		err = client.SendIssueLCIDEmail(ctx, intakeID, recipient, requesterOnly, lcid, &expiresAt, scope, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
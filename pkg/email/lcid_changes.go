@@ -0,0 +1,113 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type extendLCID struct {
+	LifecycleID       string
+	PreviousExpiresAt string
+	NewExpiresAt      string
+	Feedback          string
+}
+
+func (c Client) extendLCIDBody(lcid string, previousExpiresAt *time.Time, newExpiresAt *time.Time, feedback string) (renderedEmail, error) {
+	data := extendLCID{
+		LifecycleID:  lcid,
+		NewExpiresAt: newExpiresAt.Format("January 2, 2006"),
+		Feedback:     feedback,
+	}
+	if previousExpiresAt != nil {
+		data.PreviousExpiresAt = previousExpiresAt.Format("January 2, 2006")
+	}
+	if c.templates.extendLCIDTemplate.isNil() {
+		return renderedEmail{}, errors.New("extend LCID template is nil")
+	}
+	return c.templates.extendLCIDTemplate.render(data)
+}
+
+// SendExtendLCIDEmail sends an email for extending an LCID's expiration date
+func (c Client) SendExtendLCIDEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, lcid string, previousExpiresAt *time.Time, newExpiresAt *time.Time, feedback string) error {
+	rendered, err := c.extendLCIDBody(lcid, previousExpiresAt, newExpiresAt, feedback)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return c.sendLCIDChangeEmail(ctx, intakeID, requesterEmail, recipients, extendLCIDTemplateName, rendered)
+}
+
+type amendLCID struct {
+	LifecycleID string
+	Scope       string
+	NextSteps   string
+	Feedback    string
+}
+
+func (c Client) amendLCIDBody(lcid string, scope string, nextSteps string, feedback string) (renderedEmail, error) {
+	data := amendLCID{
+		LifecycleID: lcid,
+		Scope:       scope,
+		NextSteps:   nextSteps,
+		Feedback:    feedback,
+	}
+	if c.templates.amendLCIDTemplate.isNil() {
+		return renderedEmail{}, errors.New("amend LCID template is nil")
+	}
+	return c.templates.amendLCIDTemplate.render(data)
+}
+
+// SendAmendLCIDEmail sends an email for amending an LCID's scope or next steps
+func (c Client) SendAmendLCIDEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, lcid string, scope string, nextSteps string, feedback string) error {
+	rendered, err := c.amendLCIDBody(lcid, scope, nextSteps, feedback)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return c.sendLCIDChangeEmail(ctx, intakeID, requesterEmail, recipients, amendLCIDTemplateName, rendered)
+}
+
+type retireLCID struct {
+	LifecycleID string
+	Reason      string
+	Feedback    string
+}
+
+func (c Client) retireLCIDBody(lcid string, reason string, feedback string) (renderedEmail, error) {
+	data := retireLCID{
+		LifecycleID: lcid,
+		Reason:      reason,
+		Feedback:    feedback,
+	}
+	if c.templates.retireLCIDTemplate.isNil() {
+		return renderedEmail{}, errors.New("retire LCID template is nil")
+	}
+	return c.templates.retireLCIDTemplate.render(data)
+}
+
+// SendRetireLCIDEmail sends an email for retiring an LCID
+func (c Client) SendRetireLCIDEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, lcid string, reason string, feedback string) error {
+	rendered, err := c.retireLCIDBody(lcid, reason, feedback)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return c.sendLCIDChangeEmail(ctx, intakeID, requesterEmail, recipients, retireLCIDTemplateName, rendered)
+}
+
+// sendLCIDChangeEmail addresses and sends a rendered LCID change email
+func (c Client) sendLCIDChangeEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, templateName string, rendered renderedEmail) error {
+	email := c.decisionEmail(intakeID, requesterEmail, recipients)
+	email.Subject = rendered.subject
+	email.Body = rendered.html
+	email.TextBody = rendered.text
+	email.TemplateName = templateName
+	err := c.send(ctx, email)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s *EmailTestSuite) TestSendExtendLCIDEmail() {
+	sender := mockSender{}
+	ctx := context.Background()
+	recipient := "fake@fake.com"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
+	previous := time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC)
+	next := time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	s.Run("successful call has the right content", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		expectedEmail := "<p>Lifecycle ID: 123456</p>\n<p>Previous Expiration Date: December 25, 2021</p>\n" +
+			"<p>New Expiration Date: December 25, 2022</p>\n\n<p>feedback</p>\n"
+		err = client.SendExtendLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", &previous, &next, "feedback")
+
+		s.NoError(err)
+		s.Equal(recipient, sender.toAddress)
+		s.Equal("Lifecycle ID 123456 has been extended", sender.subject)
+		s.Equal(expectedEmail, sender.body)
+	})
+
+	s.Run("if the template is nil, we get the error from it", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		client.templates = templates{}
+
+		err = client.SendExtendLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", &previous, &next, "feedback")
+
+		s.IsType(err, &apperrors.NotificationError{})
+		s.Equal("extend LCID template is nil", err.(*apperrors.NotificationError).Err.Error())
+	})
+}
+
+func (s *EmailTestSuite) TestSendAmendLCIDEmail() {
+	sender := mockSender{}
+	ctx := context.Background()
+	recipient := "fake@fake.com"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
+
+	s.Run("successful call has the right content", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		expectedEmail := "<p>Lifecycle ID: 123456</p>\n<p>Scope: scope</p>\n<p>Next Steps: nextSteps</p>\n"
+		err = client.SendAmendLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", "scope", "nextSteps", "")
+
+		s.NoError(err)
+		s.Equal(recipient, sender.toAddress)
+		s.Equal("Lifecycle ID 123456 has been amended", sender.subject)
+		s.Equal(expectedEmail, sender.body)
+	})
+
+	s.Run("if the template fails to execute, we get the error from it", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		client.templates.amendLCIDTemplate.html = mockFailedTemplateCaller{}
+
+		err = client.SendAmendLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", "scope", "nextSteps", "")
+
+		s.IsType(err, &apperrors.NotificationError{})
+		s.Equal("template caller had an error", err.(*apperrors.NotificationError).Err.Error())
+	})
+}
+
+func (s *EmailTestSuite) TestSendRetireLCIDEmail() {
+	sender := mockSender{}
+	ctx := context.Background()
+	recipient := "fake@fake.com"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
+
+	s.Run("successful call has the right content", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		expectedEmail := "<p>Lifecycle ID: 123456</p>\n<p>Reason: reason</p>\n"
+		err = client.SendRetireLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", "reason", "")
+
+		s.NoError(err)
+		s.Equal(recipient, sender.toAddress)
+		s.Equal("Lifecycle ID 123456 has been retired", sender.subject)
+		s.Equal(expectedEmail, sender.body)
+		s.Equal("Lifecycle ID: 123456\nReason: reason\n", sender.textBody)
+	})
+
+	s.Run("if the sender fails, we get the error from it", func() {
+		sender := mockFailedSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		err = client.SendRetireLCIDEmail(ctx, intakeID, recipient, requesterOnly, "123456", "reason", "")
+
+		s.IsType(err, &apperrors.NotificationError{})
+		s.Equal("sender had an error", err.(*apperrors.NotificationError).Err.Error())
+	})
+}
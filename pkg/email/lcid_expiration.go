@@ -0,0 +1,52 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type lcidExpiration struct {
+	LifecycleID  string
+	ExpiresAt    string
+	DaysLeft     int
+	DecisionLink string
+}
+
+func (c Client) lcidExpirationBody(intakeID uuid.UUID, lcid string, expiresAt *time.Time, daysLeft int) (renderedEmail, error) {
+	decisionPath := path.Join("governance-task-list", intakeID.String(), "request-decision")
+	data := lcidExpiration{
+		LifecycleID:  lcid,
+		ExpiresAt:    expiresAt.Format("January 2, 2006"),
+		DaysLeft:     daysLeft,
+		DecisionLink: c.urlFromPath(decisionPath),
+	}
+	if c.templates.lcidExpirationTemplate.isNil() {
+		return renderedEmail{}, errors.New("LCID expiration template is nil")
+	}
+	return c.templates.lcidExpirationTemplate.render(data)
+}
+
+// SendLCIDExpirationReminderEmail reminds the requester and GRT that an LCID is expiring
+func (c Client) SendLCIDExpirationReminderEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, lcid string, expiresAt *time.Time, daysLeft int) error {
+	rendered, err := c.lcidExpirationBody(intakeID, lcid, expiresAt, daysLeft)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	email := c.decisionEmail(intakeID, requesterEmail, models.EmailRecipients{NotifyRequester: true, NotifyGRT: true})
+	email.Subject = rendered.subject
+	email.Body = rendered.html
+	email.TextBody = rendered.text
+	email.TemplateName = lcidExpirationTemplateName
+	err = c.send(ctx, email)
+	if err != nil {
+		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
+	}
+	return nil
+}
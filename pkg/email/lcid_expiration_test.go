@@ -0,0 +1,71 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+func (s *EmailTestSuite) TestSendLCIDExpirationReminderEmail() {
+	sender := mockSender{}
+	ctx := context.Background()
+	recipient := "fake@fake.com"
+	lcid := "123456"
+	expiresAt := time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC)
+	intakeID := uuid.New()
+
+	s.Run("successful call has the right content", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		expectedEmail := "<p>Hello,</p>\n\n" +
+			"<p>\n  Lifecycle ID 123456 expires on December 25, 2021, in 30 days.\n  " +
+			"If the project will continue past that date, please contact the Governance Review Team to renew it.\n</p>\n\n" +
+			fmt.Sprintf(
+				"<a href=\"%s://%s/governance-task-list/%s/request-decision\" >",
+				s.config.URLScheme,
+				s.config.URLHost,
+				intakeID.String(),
+			) +
+			"View the decision in EASi</a>\n"
+		err = client.SendLCIDExpirationReminderEmail(ctx, intakeID, recipient, lcid, &expiresAt, 30)
+
+		s.NoError(err)
+		s.Equal([]string{recipient}, sender.email.ToAddresses)
+		s.Equal([]string{s.config.GRTEmail}, sender.email.CCAddresses)
+		s.Equal("Lifecycle ID 123456 expires in 30 days", sender.subject)
+		s.Equal(expectedEmail, sender.body)
+		s.Contains(sender.textBody, "Lifecycle ID 123456 expires on December 25, 2021, in 30 days.")
+	})
+
+	s.Run("if the template is nil, we get the error from it", func() {
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		client.templates = templates{}
+
+		err = client.SendLCIDExpirationReminderEmail(ctx, intakeID, recipient, lcid, &expiresAt, 30)
+
+		s.Error(err)
+		s.IsType(err, &apperrors.NotificationError{})
+		e := err.(*apperrors.NotificationError)
+		s.Equal(apperrors.DestinationTypeEmail, e.DestinationType)
+		s.Equal("LCID expiration template is nil", e.Err.Error())
+	})
+
+	s.Run("if the sender fails, we get the error from it", func() {
+		sender := mockFailedSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		err = client.SendLCIDExpirationReminderEmail(ctx, intakeID, recipient, lcid, &expiresAt, 30)
+
+		s.Error(err)
+		s.IsType(err, &apperrors.NotificationError{})
+		e := err.(*apperrors.NotificationError)
+		s.Equal("sender had an error", e.Err.Error())
+	})
+}
@@ -0,0 +1,131 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// OutboxConfig holds configs for delivering outbox emails
+type OutboxConfig struct {
+	// Interval is how often the worker checks for due emails
+	Interval time.Duration
+	// BatchSize is the most emails delivered per check
+	BatchSize int
+	// MaxAttempts is how many times an email is tried before it needs a re-drive
+	MaxAttempts int
+	// Lease is how long a claimed email is hidden from other workers
+	Lease time.Duration
+	// BaseBackoff is the wait after the first failure, doubled for each one after
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between attempts
+	MaxBackoff time.Duration
+}
+
+// NewOutboxConfig returns the default outbox configs
+func NewOutboxConfig() OutboxConfig {
+	return OutboxConfig{
+		Interval:    15 * time.Second,
+		BatchSize:   25,
+		MaxAttempts: 8,
+		Lease:       5 * time.Minute,
+		BaseBackoff: 30 * time.Second,
+		MaxBackoff:  time.Hour,
+	}
+}
+
+// OutboxWorker delivers queued outbox emails with a client's sender
+type OutboxWorker struct {
+	config OutboxConfig
+	logger *zap.Logger
+	clock  clock.Clock
+	sender sender
+	claim  func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (models.OutboxEmails, error)
+	update func(context.Context, *models.OutboxEmail) (*models.OutboxEmail, error)
+}
+
+// NewOutboxWorker is a constructor for OutboxWorker
+func NewOutboxWorker(
+	config OutboxConfig,
+	logger *zap.Logger,
+	clock clock.Clock,
+	client Client,
+	claim func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (models.OutboxEmails, error),
+	update func(context.Context, *models.OutboxEmail) (*models.OutboxEmail, error),
+) OutboxWorker {
+	return OutboxWorker{
+		config: config,
+		logger: logger,
+		clock:  clock,
+		sender: client.sender,
+		claim:  claim,
+		update: update,
+	}
+}
+
+// Run delivers due emails every interval until the context is done
+func (w OutboxWorker) Run(ctx context.Context) {
+	ctx = appcontext.WithLogger(ctx, w.logger)
+	ticker := w.clock.Ticker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.DeliverDue(ctx); err != nil {
+				w.logger.Error("Failed to deliver outbox emails", zap.Error(err))
+			}
+		}
+	}
+}
+
+// DeliverDue sends one batch of due emails,
+// scheduling a retry with exponential backoff for any that fail
+func (w OutboxWorker) DeliverDue(ctx context.Context) error {
+	emails, err := w.claim(ctx, w.config.BatchSize, w.config.MaxAttempts, w.config.Lease)
+	if err != nil {
+		return err
+	}
+	for i := range emails {
+		email := &emails[i]
+		email.Attempts++
+		sendErr := w.sender.Send(ctx, email.Email())
+		now := w.clock.Now()
+		if sendErr != nil {
+			w.logger.Warn(
+				"Failed to send outbox email",
+				zap.String("id", email.ID.String()),
+				zap.Int("attempts", email.Attempts),
+				zap.Error(sendErr),
+			)
+			email.LastError = null.StringFrom(sendErr.Error())
+			next := now.Add(w.backoff(email.Attempts))
+			email.NextAttemptAt = &next
+		} else {
+			email.SentAt = &now
+		}
+		if _, err = w.update(ctx, email); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backoff is the wait before the next attempt after the given number of attempts
+func (w OutboxWorker) backoff(attempts int) time.Duration {
+	wait := w.config.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= w.config.MaxBackoff {
+			return w.config.MaxBackoff
+		}
+	}
+	return wait
+}
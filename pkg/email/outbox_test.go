@@ -0,0 +1,112 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s *EmailTestSuite) TestOutboxWorker() {
+	ctx := context.Background()
+	config := OutboxConfig{
+		Interval:    time.Second,
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Lease:       time.Minute,
+		BaseBackoff: time.Minute,
+		MaxBackoff:  3 * time.Minute,
+	}
+	mockClock := clock.NewMock()
+	newEmail := func(attempts int) models.OutboxEmail {
+		return models.OutboxEmail{
+			ID:          uuid.New(),
+			ToAddresses: pq.StringArray{"requester@example.com"},
+			Subject:     "subject",
+			Body:        "body",
+			Attempts:    attempts,
+		}
+	}
+	var updated []models.OutboxEmail
+	update := func(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+		updated = append(updated, *email)
+		return email, nil
+	}
+	claimEmails := func(emails ...models.OutboxEmail) func(context.Context, int, int, time.Duration) (models.OutboxEmails, error) {
+		return func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (models.OutboxEmails, error) {
+			s.Equal(config.BatchSize, limit)
+			s.Equal(config.MaxAttempts, maxAttempts)
+			s.Equal(config.Lease, lease)
+			return emails, nil
+		}
+	}
+
+	s.Run("marks delivered emails as sent", func() {
+		updated = nil
+		sender := mockSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		worker := NewOutboxWorker(config, s.logger, mockClock, client, claimEmails(newEmail(0)), update)
+
+		err = worker.DeliverDue(ctx)
+
+		s.NoError(err)
+		s.Equal("requester@example.com", sender.toAddress)
+		s.Len(updated, 1)
+		s.Equal(1, updated[0].Attempts)
+		s.Equal(mockClock.Now(), *updated[0].SentAt)
+		s.False(updated[0].LastError.Valid)
+	})
+
+	s.Run("schedules a retry with backoff when sending fails", func() {
+		updated = nil
+		client, err := NewClient(s.config, &mockFailedSender{})
+		s.NoError(err)
+		worker := NewOutboxWorker(config, s.logger, mockClock, client, claimEmails(newEmail(0), newEmail(1), newEmail(2)), update)
+
+		err = worker.DeliverDue(ctx)
+
+		s.NoError(err)
+		s.Len(updated, 3)
+		for _, email := range updated {
+			s.Nil(email.SentAt)
+			s.Equal("sender had an error", email.LastError.String)
+		}
+		s.Equal(mockClock.Now().Add(time.Minute), *updated[0].NextAttemptAt)
+		s.Equal(mockClock.Now().Add(2*time.Minute), *updated[1].NextAttemptAt)
+		s.Equal(mockClock.Now().Add(3*time.Minute), *updated[2].NextAttemptAt)
+	})
+
+	s.Run("returns the error if claiming fails", func() {
+		client, err := NewClient(s.config, &mockSender{})
+		s.NoError(err)
+		claimErr := errors.New("failed to claim")
+		failClaim := func(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (models.OutboxEmails, error) {
+			return nil, claimErr
+		}
+		worker := NewOutboxWorker(config, s.logger, mockClock, client, failClaim, update)
+
+		err = worker.DeliverDue(ctx)
+
+		s.Equal(claimErr, err)
+	})
+
+	s.Run("returns the error if saving the result fails", func() {
+		client, err := NewClient(s.config, &mockSender{})
+		s.NoError(err)
+		updateErr := errors.New("failed to update")
+		failUpdate := func(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+			return nil, updateErr
+		}
+		worker := NewOutboxWorker(config, s.logger, mockClock, client, claimEmails(newEmail(0)), failUpdate)
+
+		err = worker.DeliverDue(ctx)
+
+		s.Equal(updateErr, err)
+	})
+}
@@ -1,11 +1,13 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type rejectRequest struct {
@@ -14,36 +16,30 @@ type rejectRequest struct {
 	Feedback  string
 }
 
-func (c Client) rejectRequestBody(reason string, nextSteps string, feedback string) (string, error) {
+func (c Client) rejectRequestBody(reason string, nextSteps string, feedback string) (renderedEmail, error) {
 	data := rejectRequest{
 		Reason:    reason,
 		NextSteps: nextSteps,
 		Feedback:  feedback,
 	}
-	var b bytes.Buffer
-	if c.templates.rejectRequestTemplate == nil {
-		return "", errors.New("reject request template is nil")
-	}
-	err := c.templates.rejectRequestTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
+	if c.templates.rejectRequestTemplate.isNil() {
+		return renderedEmail{}, errors.New("reject request template is nil")
 	}
-	return b.String(), nil
+	return c.templates.rejectRequestTemplate.render(data)
 }
 
 // SendRejectRequestEmail sends an email for rejecting a request
-func (c Client) SendRejectRequestEmail(ctx context.Context, recipient string, reason string, nextSteps string, feedback string) error {
-	subject := "Your request has not been approved"
-	body, err := c.rejectRequestBody(reason, nextSteps, feedback)
+func (c Client) SendRejectRequestEmail(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, reason string, nextSteps string, feedback string) error {
+	rendered, err := c.rejectRequestBody(reason, nextSteps, feedback)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
-	err = c.sender.Send(
-		ctx,
-		recipient,
-		subject,
-		body,
-	)
+	email := c.decisionEmail(intakeID, requesterEmail, recipients)
+	email.Subject = rendered.subject
+	email.Body = rendered.html
+	email.TextBody = rendered.text
+	email.TemplateName = rejectRequestTemplateName
+	err = c.send(ctx, email)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
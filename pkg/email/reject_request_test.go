@@ -3,7 +3,10 @@ package email
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 func (s *EmailTestSuite) TestSendRejectRequestEmail() {
@@ -13,13 +16,15 @@ func (s *EmailTestSuite) TestSendRejectRequestEmail() {
 	reason := "reason"
 	nextSteps := "nextSteps"
 	feedback := "feedback"
+	intakeID := uuid.New()
+	requesterOnly := models.EmailRecipients{NotifyRequester: true}
 
 	s.Run("successful call has the right content", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 
 		expectedEmail := "<p>Reason: reason</p>\n<p>Next Steps: nextSteps</p>\n\n<p>feedback</p>"
-		err = client.SendRejectRequestEmail(ctx, recipient, reason, nextSteps, feedback)
+		err = client.SendRejectRequestEmail(ctx, intakeID, recipient, requesterOnly, reason, nextSteps, feedback)
 
 		s.NoError(err)
 		s.Equal(recipient, sender.toAddress)
@@ -32,7 +37,7 @@ func (s *EmailTestSuite) TestSendRejectRequestEmail() {
 		s.NoError(err)
 
 		expectedEmail := "<p>Reason: reason</p>\n\n<p>feedback</p>"
-		err = client.SendRejectRequestEmail(ctx, recipient, reason, "", feedback)
+		err = client.SendRejectRequestEmail(ctx, intakeID, recipient, requesterOnly, reason, "", feedback)
 
 		s.NoError(err)
 		s.Equal(recipient, sender.toAddress)
@@ -45,7 +50,7 @@ func (s *EmailTestSuite) TestSendRejectRequestEmail() {
 		s.NoError(err)
 		client.templates = templates{}
 
-		err = client.SendRejectRequestEmail(ctx, recipient, reason, nextSteps, feedback)
+		err = client.SendRejectRequestEmail(ctx, intakeID, recipient, requesterOnly, reason, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -57,9 +62,9 @@ func (s *EmailTestSuite) TestSendRejectRequestEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.rejectRequestTemplate = mockFailedTemplateCaller{}
+		client.templates.rejectRequestTemplate.html = mockFailedTemplateCaller{}
 
-		err = client.SendRejectRequestEmail(ctx, recipient, reason, nextSteps, feedback)
+		err = client.SendRejectRequestEmail(ctx, intakeID, recipient, requesterOnly, reason, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -74,7 +79,7 @@ func (s *EmailTestSuite) TestSendRejectRequestEmail() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 
-		err = client.SendRejectRequestEmail(ctx, recipient, reason, nextSteps, feedback)
+		err = client.SendRejectRequestEmail(ctx, intakeID, recipient, requesterOnly, reason, nextSteps, feedback)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
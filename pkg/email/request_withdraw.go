@@ -1,69 +1,64 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type namedRequestWithdraw struct {
 	RequestName string
 }
 
-func (c Client) withdrawNamedRequestBody(requestName string) (string, error) {
+func (c Client) withdrawNamedRequestBody(requestName string) (renderedEmail, error) {
 	data := namedRequestWithdraw{
 		RequestName: requestName,
 	}
-	var b bytes.Buffer
-	if c.templates.namedRequestWithdrawTemplate == nil {
-		return "", errors.New("withdraw named request template is nil")
-	}
-	err := c.templates.namedRequestWithdrawTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
+	if c.templates.namedRequestWithdrawTemplate.isNil() {
+		return renderedEmail{}, errors.New("withdraw named request template is nil")
 	}
-	return b.String(), nil
+	return c.templates.namedRequestWithdrawTemplate.render(data)
 }
 
 type unnamedRequestWithdraw struct{}
 
-func (c Client) withdrawUnnamedRequestBody() (string, error) {
-	var b bytes.Buffer
-	if c.templates.unnamedRequestWithdrawTemplate == nil {
-		return "", errors.New("withdraw unnamed request template is nil")
+func (c Client) withdrawUnnamedRequestBody() (renderedEmail, error) {
+	if c.templates.unnamedRequestWithdrawTemplate.isNil() {
+		return renderedEmail{}, errors.New("withdraw unnamed request template is nil")
 	}
 
-	err := c.templates.unnamedRequestWithdrawTemplate.Execute(&b, unnamedRequestWithdraw{})
-	if err != nil {
-		return "", err
-	}
-	return b.String(), nil
+	return c.templates.unnamedRequestWithdrawTemplate.render(unnamedRequestWithdraw{})
 }
 
 // SendWithdrawRequestEmail sends an email for a submitted system intake
-func (c Client) SendWithdrawRequestEmail(ctx context.Context, requestName string) error {
-	var subject, body string
+func (c Client) SendWithdrawRequestEmail(ctx context.Context, intakeID uuid.UUID, requestName string) error {
+	var rendered renderedEmail
+	var templateName string
 	var err error
 	if requestName == "" {
-		subject = "Request Withdrawn"
-		body, err = c.withdrawUnnamedRequestBody()
+		templateName = unnamedRequestWithdrawTemplateName
+		rendered, err = c.withdrawUnnamedRequestBody()
 	} else {
-		subject = fmt.Sprintf("Request Withdrawn: %s", requestName)
-		body, err = c.withdrawNamedRequestBody(requestName)
+		templateName = namedRequestWithdrawTemplateName
+		rendered, err = c.withdrawNamedRequestBody(requestName)
 	}
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
 
-	err = c.sender.Send(
-		ctx,
-		c.config.GRTEmail,
-		subject,
-		body,
-	)
+	err = c.send(ctx, models.OutboxEmail{
+		IntakeID:     &intakeID,
+		ToAddresses:  pq.StringArray{c.config.GRTEmail},
+		Subject:      rendered.subject,
+		Body:         rendered.html,
+		TextBody:     rendered.text,
+		TemplateName: templateName,
+	})
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 )
 
@@ -21,7 +23,7 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 			"No further action is required for the withdrawal.\n" +
 			"</p>\n"
 
-		err = client.SendWithdrawRequestEmail(ctx, requestName)
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), requestName)
 
 		s.NoError(err)
 		s.Equal(s.config.GRTEmail, sender.toAddress)
@@ -38,7 +40,7 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 			"No further action is required for the withdrawal.\n" +
 			"</p>\n"
 
-		err = client.SendWithdrawRequestEmail(ctx, "")
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), "")
 
 		s.NoError(err)
 		s.Equal(s.config.GRTEmail, sender.toAddress)
@@ -51,7 +53,7 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 		s.NoError(err)
 		client.templates = templates{}
 
-		err = client.SendWithdrawRequestEmail(ctx, requestName)
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), requestName)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -65,7 +67,7 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 		s.NoError(err)
 		client.templates = templates{}
 
-		err = client.SendWithdrawRequestEmail(ctx, "")
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), "")
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -77,9 +79,9 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.namedRequestWithdrawTemplate = mockFailedTemplateCaller{}
+		client.templates.namedRequestWithdrawTemplate.html = mockFailedTemplateCaller{}
 
-		err = client.SendWithdrawRequestEmail(ctx, requestName)
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), requestName)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
@@ -94,7 +96,7 @@ func (s *EmailTestSuite) TestSendWithdrawRequestEmail() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
 
-		err = client.SendWithdrawRequestEmail(ctx, requestName)
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), requestName)
 
 		s.Error(err)
 		s.IsType(err, &apperrors.NotificationError{})
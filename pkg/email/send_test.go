@@ -0,0 +1,103 @@
+package email
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s *EmailTestSuite) TestSendAfterCommit() {
+	s.Run("sends immediately outside of a transaction", func() {
+		sender := mockSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+
+		err = client.SendWithdrawRequestEmail(context.Background(), uuid.New(), "")
+
+		s.NoError(err)
+		s.Equal(s.config.GRTEmail, sender.toAddress)
+	})
+
+	s.Run("waits for the commit inside a transaction", func() {
+		sender := mockSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		ctx, hooks := appcontext.WithCommitHooks(context.Background())
+
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), "")
+
+		s.NoError(err)
+		s.Empty(sender.toAddress)
+		hooks.Run(ctx)
+		s.Equal(s.config.GRTEmail, sender.toAddress)
+	})
+
+	s.Run("is never sent if the transaction is not committed", func() {
+		sender := mockSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		ctx, _ := appcontext.WithCommitHooks(context.Background())
+
+		err = client.SendWithdrawRequestEmail(ctx, uuid.New(), "")
+
+		s.NoError(err)
+		s.Empty(sender.toAddress)
+	})
+}
+
+func (s *EmailTestSuite) TestSendWithOutbox() {
+	var queued *models.OutboxEmail
+	outbox := func(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+		queued = email
+		return email, nil
+	}
+
+	s.Run("queues the rendered email instead of sending it", func() {
+		sender := mockSender{}
+		client, err := NewClient(s.config, &sender)
+		s.NoError(err)
+		client = client.WithOutbox(outbox)
+		intakeID := uuid.New()
+
+		err = client.SendSystemIntakeSubmissionEmail(context.Background(), "Requester", intakeID)
+
+		s.NoError(err)
+		s.Empty(sender.toAddress)
+		s.Equal(pq.StringArray{s.config.GRTEmail}, queued.ToAddresses)
+		s.Equal("New intake request: Requester", queued.Subject)
+		s.Contains(queued.Body, intakeID.String())
+		s.Equal(systemIntakeSubmissionTemplateName, queued.TemplateName)
+		s.Equal(&intakeID, queued.IntakeID)
+	})
+
+	s.Run("queues a withdrawal with its intake", func() {
+		client, err := NewClient(s.config, &mockSender{})
+		s.NoError(err)
+		client = client.WithOutbox(outbox)
+		intakeID := uuid.New()
+
+		err = client.SendWithdrawRequestEmail(context.Background(), intakeID, "Request Name")
+
+		s.NoError(err)
+		s.Equal(namedRequestWithdrawTemplateName, queued.TemplateName)
+		s.Equal(&intakeID, queued.IntakeID)
+	})
+
+	s.Run("returns an error if the email can't be queued", func() {
+		client, err := NewClient(s.config, &mockSender{})
+		s.NoError(err)
+		client = client.WithOutbox(func(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+			return nil, errors.New("failed to queue")
+		})
+
+		err = client.SendWithdrawRequestEmail(context.Background(), uuid.New(), "")
+
+		s.IsType(&apperrors.NotificationError{}, err)
+	})
+}
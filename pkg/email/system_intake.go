@@ -1,50 +1,48 @@
 package email
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
 	"path"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 type systemIntakeSubmission struct {
+	Requester  string
 	IntakeLink string
 }
 
-func (c Client) systemIntakeSubmissionBody(intakeID uuid.UUID) (string, error) {
+func (c Client) systemIntakeSubmissionBody(requester string, intakeID uuid.UUID) (renderedEmail, error) {
 	intakePath := path.Join("governance-review-team", intakeID.String(), "intake-request")
 	data := systemIntakeSubmission{
+		Requester:  requester,
 		IntakeLink: c.urlFromPath(intakePath),
 	}
-	var b bytes.Buffer
-	if c.templates.systemIntakeSubmissionTemplate == nil {
-		return "", errors.New("system intake submission template is nil")
+	if c.templates.systemIntakeSubmissionTemplate.isNil() {
+		return renderedEmail{}, errors.New("system intake submission template is nil")
 	}
-	err := c.templates.systemIntakeSubmissionTemplate.Execute(&b, data)
-	if err != nil {
-		return "", err
-	}
-	return b.String(), nil
+	return c.templates.systemIntakeSubmissionTemplate.render(data)
 }
 
 // SendSystemIntakeSubmissionEmail sends an email for a submitted system intake
 func (c Client) SendSystemIntakeSubmissionEmail(ctx context.Context, requester string, intakeID uuid.UUID) error {
-	subject := fmt.Sprintf("New intake request: %s", requester)
-	body, err := c.systemIntakeSubmissionBody(intakeID)
+	rendered, err := c.systemIntakeSubmissionBody(requester, intakeID)
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
-	err = c.sender.Send(
-		ctx,
-		c.config.GRTEmail,
-		subject,
-		body,
-	)
+	err = c.send(ctx, models.OutboxEmail{
+		ToAddresses:  pq.StringArray{c.config.GRTEmail},
+		Subject:      rendered.subject,
+		Body:         rendered.html,
+		TextBody:     rendered.text,
+		TemplateName: systemIntakeSubmissionTemplateName,
+		IntakeID:     &intakeID,
+	})
 	if err != nil {
 		return &apperrors.NotificationError{Err: err, DestinationType: apperrors.DestinationTypeEmail}
 	}
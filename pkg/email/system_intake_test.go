@@ -55,7 +55,7 @@ func (s *EmailTestSuite) TestSendSystemIntakeEmail() {
 	s.Run("if the template fails to execute, we get the error from it", func() {
 		client, err := NewClient(s.config, &sender)
 		s.NoError(err)
-		client.templates.systemIntakeSubmissionTemplate = mockFailedTemplateCaller{}
+		client.templates.systemIntakeSubmissionTemplate.html = mockFailedTemplateCaller{}
 
 		err = client.SendSystemIntakeSubmissionEmail(ctx, tester, intakeID)
 
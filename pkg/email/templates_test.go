@@ -0,0 +1,86 @@
+package email
+
+func (s *EmailTestSuite) TestTemplatesRenderAllParts() {
+	client, err := NewClient(s.config, &mockSender{})
+	s.NoError(err)
+
+	samples := map[string]struct {
+		template emailTemplate
+		data     interface{}
+	}{
+		systemIntakeSubmissionTemplateName: {
+			template: client.templates.systemIntakeSubmissionTemplate,
+			data:     systemIntakeSubmission{Requester: "Jane Doe", IntakeLink: "http://localhost/intake"},
+		},
+		businessCaseSubmissionTemplateName: {
+			template: client.templates.businessCaseSubmissionTemplate,
+			data:     businessCaseSubmission{Requester: "Jane Doe", BusinessCaseLink: "http://localhost/business-case"},
+		},
+		intakeReviewTemplateName: {
+			template: client.templates.intakeReviewTemplate,
+			data:     intakeReview{EmailText: "Please add more detail"},
+		},
+		namedRequestWithdrawTemplateName: {
+			template: client.templates.namedRequestWithdrawTemplate,
+			data:     namedRequestWithdraw{RequestName: "Easy Access"},
+		},
+		unnamedRequestWithdrawTemplateName: {
+			template: client.templates.unnamedRequestWithdrawTemplate,
+			data:     unnamedRequestWithdraw{},
+		},
+		issueLCIDTemplateName: {
+			template: client.templates.issueLCIDTemplate,
+			data: issueLCID{
+				LifecycleID: "210001",
+				ExpiresAt:   "December 25, 2021",
+				Scope:       "scope",
+				NextSteps:   "nextSteps",
+				Feedback:    "feedback",
+			},
+		},
+		rejectRequestTemplateName: {
+			template: client.templates.rejectRequestTemplate,
+			data:     rejectRequest{Reason: "reason", NextSteps: "nextSteps", Feedback: "feedback"},
+		},
+		lcidExpirationTemplateName: {
+			template: client.templates.lcidExpirationTemplate,
+			data: lcidExpiration{
+				LifecycleID:  "210001",
+				ExpiresAt:    "December 25, 2021",
+				DaysLeft:     30,
+				DecisionLink: "http://localhost/request-decision",
+			},
+		},
+		extendLCIDTemplateName: {
+			template: client.templates.extendLCIDTemplate,
+			data: extendLCID{
+				LifecycleID:       "210001",
+				PreviousExpiresAt: "December 25, 2021",
+				NewExpiresAt:      "December 25, 2022",
+				Feedback:          "feedback",
+			},
+		},
+		amendLCIDTemplateName: {
+			template: client.templates.amendLCIDTemplate,
+			data:     amendLCID{LifecycleID: "210001", Scope: "scope", NextSteps: "nextSteps", Feedback: "feedback"},
+		},
+		retireLCIDTemplateName: {
+			template: client.templates.retireLCIDTemplate,
+			data:     retireLCID{LifecycleID: "210001", Reason: "reason", Feedback: "feedback"},
+		},
+	}
+
+	for name, sample := range samples {
+		s.Run(name, func() {
+			rendered, err := sample.template.render(sample.data)
+
+			s.NoError(err)
+			s.NotEmpty(rendered.subject)
+			s.NotContains(rendered.subject, "\n")
+			s.Contains(rendered.html, "<p>")
+			s.NotEmpty(rendered.text)
+			s.NotContains(rendered.text, "<p>")
+			s.NotContains(rendered.text, "<no value>")
+		})
+	}
+}
@@ -31,6 +31,7 @@ type AccessibilityRequestEdge struct {
 // A collection of AccessibilityRequests
 type AccessibilityRequestsConnection struct {
 	Edges      []*AccessibilityRequestEdge `json:"edges"`
+	PageInfo   *models.PageInfo            `json:"pageInfo"`
 	TotalCount int                         `json:"totalCount"`
 }
 
@@ -46,6 +47,33 @@ type CreateAccessibilityRequestPayload struct {
 	UserErrors           []*UserError                 `json:"userErrors"`
 }
 
+// Parameters for taking an action on a system intake
+type CreateSystemIntakeActionInput struct {
+	ActionType models.ActionType     `json:"actionType"`
+	Feedback   *string               `json:"feedback"`
+	IntakeID   uuid.UUID             `json:"intakeId"`
+	Recipients *EmailRecipientsInput `json:"recipients"`
+}
+
+// Parameters for starting a system intake
+type CreateSystemIntakeInput struct {
+	Requester   string                         `json:"requester"`
+	RequestType models.SystemIntakeRequestType `json:"requestType"`
+}
+
+// Parameters for adding a note to a system intake
+type CreateSystemIntakeNoteInput struct {
+	AuthorName string    `json:"authorName"`
+	Content    string    `json:"content"`
+	IntakeID   uuid.UUID `json:"intakeId"`
+}
+
+// Result of createSystemIntakeNote
+type CreateSystemIntakeNotePayload struct {
+	Note       *models.Note `json:"note"`
+	UserErrors []*UserError `json:"userErrors"`
+}
+
 // Parameters for creating a test date
 type CreateTestDateInput struct {
 	Date      time.Time               `json:"date"`
@@ -60,6 +88,13 @@ type CreateTestDatePayload struct {
 	UserErrors []*UserError     `json:"userErrors"`
 }
 
+// Who to email about an action taken on a system intake
+type EmailRecipientsInput struct {
+	ContactEmails   []string `json:"contactEmails"`
+	NotifyGrt       bool     `json:"notifyGrt"`
+	NotifyRequester bool     `json:"notifyRequester"`
+}
+
 // Parameters required to generate a presigned upload URL
 type GeneratePresignedUploadURLInput struct {
 	MimeType string `json:"mimeType"`
@@ -71,10 +106,36 @@ type GeneratePresignedUploadURLPayload struct {
 	UserErrors []*UserError `json:"userErrors"`
 }
 
+// Parameters for issuing a Lifecycle ID. A new LCID is generated when lcid is left out.
+type IssueLifecycleIDInput struct {
+	ExpiresAt  time.Time             `json:"expiresAt"`
+	Feedback   string                `json:"feedback"`
+	IntakeID   uuid.UUID             `json:"intakeId"`
+	Lcid       *string               `json:"lcid"`
+	NextSteps  string                `json:"nextSteps"`
+	Recipients *EmailRecipientsInput `json:"recipients"`
+	Scope      string                `json:"scope"`
+}
+
+// Parameters for rejecting a system intake
+type RejectIntakeInput struct {
+	Feedback   string                `json:"feedback"`
+	IntakeID   uuid.UUID             `json:"intakeId"`
+	NextSteps  string                `json:"nextSteps"`
+	Reason     string                `json:"reason"`
+	Recipients *EmailRecipientsInput `json:"recipients"`
+}
+
+// Parameters for submitting a system intake to the GRT
+type SubmitIntakeInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
 // A collection of Systems
 type SystemConnection struct {
-	Edges      []*SystemEdge `json:"edges"`
-	TotalCount int           `json:"totalCount"`
+	Edges      []*SystemEdge    `json:"edges"`
+	PageInfo   *models.PageInfo `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
 }
 
 // An edge of an SystemConnection
@@ -83,6 +144,67 @@ type SystemEdge struct {
 	Node   *models.System `json:"node"`
 }
 
+// Parameters for searching system intakes.
+// submittedAfter is inclusive and submittedBefore is exclusive.
+// first is how many results to return, and after is the endCursor of the previous page.
+type SystemIntakeSearchInput struct {
+	After           *string                          `json:"after"`
+	Components      []string                         `json:"components"`
+	First           int                              `json:"first"`
+	HasLcid         *bool                            `json:"hasLcid"`
+	Query           *string                          `json:"query"`
+	RequestTypes    []models.SystemIntakeRequestType `json:"requestTypes"`
+	Statuses        []models.SystemIntakeStatus      `json:"statuses"`
+	SubmittedAfter  *time.Time                       `json:"submittedAfter"`
+	SubmittedBefore *time.Time                       `json:"submittedBefore"`
+}
+
+// Parameters for updating a system intake. Fields that are left out are not changed.
+type UpdateSystemIntakeInput struct {
+	BusinessNeed                *string                         `json:"businessNeed"`
+	BusinessOwner               *string                         `json:"businessOwner"`
+	BusinessOwnerComponent      *string                         `json:"businessOwnerComponent"`
+	Component                   *string                         `json:"component"`
+	ContractEndMonth            *string                         `json:"contractEndMonth"`
+	ContractEndYear             *string                         `json:"contractEndYear"`
+	Contractor                  *string                         `json:"contractor"`
+	ContractStartMonth          *string                         `json:"contractStartMonth"`
+	ContractStartYear           *string                         `json:"contractStartYear"`
+	ContractVehicle             *string                         `json:"contractVehicle"`
+	CostIncrease                *string                         `json:"costIncrease"`
+	CostIncreaseAmount          *string                         `json:"costIncreaseAmount"`
+	EaCollaborator              *string                         `json:"eaCollaborator"`
+	EaCollaboratorName          *string                         `json:"eaCollaboratorName"`
+	EaSupportRequest            *bool                           `json:"eaSupportRequest"`
+	ExistingContract            *string                         `json:"existingContract"`
+	ExistingFunding             *bool                           `json:"existingFunding"`
+	FundingNumber               *string                         `json:"fundingNumber"`
+	FundingSource               *string                         `json:"fundingSource"`
+	ID                          uuid.UUID                       `json:"id"`
+	Isso                        *string                         `json:"isso"`
+	IssoName                    *string                         `json:"issoName"`
+	OitSecurityCollaborator     *string                         `json:"oitSecurityCollaborator"`
+	OitSecurityCollaboratorName *string                         `json:"oitSecurityCollaboratorName"`
+	ProcessStatus               *string                         `json:"processStatus"`
+	ProductManager              *string                         `json:"productManager"`
+	ProductManagerComponent     *string                         `json:"productManagerComponent"`
+	ProjectAcronym              *string                         `json:"projectAcronym"`
+	ProjectName                 *string                         `json:"projectName"`
+	Requester                   *string                         `json:"requester"`
+	RequestType                 *models.SystemIntakeRequestType `json:"requestType"`
+	Solution                    *string                         `json:"solution"`
+	TrbCollaborator             *string                         `json:"trbCollaborator"`
+	TrbCollaboratorName         *string                         `json:"trbCollaboratorName"`
+	// The version being updated. If the intake has changed since, the update fails.
+	Version *int `json:"version"`
+}
+
+// Result of a mutation on a system intake
+type UpdateSystemIntakePayload struct {
+	SystemIntake *models.SystemIntake `json:"systemIntake"`
+	UserErrors   []*UserError         `json:"userErrors"`
+}
+
 // UserError represents application-level errors that are the result of
 // either user or application developer error.
 type UserError struct {
@@ -2,7 +2,14 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/dataloaders"
+	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -23,7 +30,24 @@ type Resolver struct {
 
 // ResolverService holds service methods for use in resolvers
 type ResolverService struct {
-	CreateTestDate func(context.Context, *models.TestDate) (*models.TestDate, error)
+	CreateTestDate        func(context.Context, *models.TestDate) (*models.TestDate, error)
+	SearchSystemIntakes   func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error)
+	FetchSystemIntakeByID func(context.Context, uuid.UUID) (*models.SystemIntake, error)
+	CreateSystemIntake    func(context.Context, *models.SystemIntake) (*models.SystemIntake, error)
+	UpdateSystemIntake    func(context.Context, *models.SystemIntake) (*models.SystemIntake, error)
+	TakeAction            func(context.Context, *models.Action) error
+	IssueLifecycleID      func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
+	RejectIntake          func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
+	CreateNote            func(context.Context, *models.Note) (*models.Note, error)
+	FetchBusinessCaseByID func(context.Context, uuid.UUID) (*models.BusinessCase, error)
+	FetchRelatedIntakes   func(context.Context) (models.SystemIntakes, error)
+
+	SummarizeLifecycleCosts func(models.EstimatedLifecycleCosts, *float64) (*models.LifecycleCostSummary, error)
+	SearchUsers             func(context.Context, string) ([]*models.UserInfo, error)
+
+	SubscribeIntakeStatusChanged func(context.Context, uuid.UUID) (<-chan *models.SystemIntake, error)
+	SubscribeNoteAdded           func(context.Context, uuid.UUID) (<-chan *models.Note, error)
+	SubscribeDocumentAdded       func(context.Context, uuid.UUID) (<-chan *models.UploadedFile, error)
 }
 
 // NewResolver constructs a resolver
@@ -34,3 +58,33 @@ func NewResolver(
 ) *Resolver {
 	return &Resolver{store: store, service: service, s3Client: s3Client}
 }
+
+// loaders returns the request's dataloaders, which relation resolvers use instead of querying per node
+func loaders(ctx context.Context) (*dataloaders.Loaders, error) {
+	l, ok := dataloaders.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no dataloaders on context")
+	}
+	return l, nil
+}
+
+// accessibilityRequestDocument describes an uploaded file as the ix'th document of its request
+func accessibilityRequestDocument(file models.UploadedFile, ix int) *model.AccessibilityRequestDocument {
+	status := model.AccessibilityRequestDocumentStatusPending
+	if file.VirusScanned == null.BoolFrom(true) {
+		if file.VirusClean == null.BoolFrom(false) {
+			status = model.AccessibilityRequestDocumentStatusUnavailable
+		}
+		if file.VirusClean == null.BoolFrom(true) {
+			status = model.AccessibilityRequestDocumentStatusAvailable
+		}
+	}
+
+	return &model.AccessibilityRequestDocument{
+		ID:         file.ID,
+		RequestID:  file.RequestID,
+		Name:       fmt.Sprintf("Test Doc Number %+v", ix+1),
+		UploadedAt: *file.CreatedAt,
+		Status:     status,
+	}
+}
@@ -5,7 +5,7 @@ package graph
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/guregu/null"
@@ -17,7 +17,11 @@ import (
 )
 
 func (r *accessibilityRequestResolver) Documents(ctx context.Context, obj *models.AccessibilityRequest) ([]*model.AccessibilityRequestDocument, error) {
-	files, fileErr := r.store.FetchFilesByAccessibilityRequestID(ctx, obj.ID)
+	l, err := loaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files, fileErr := l.FilesByAccessibilityRequestID(ctx, obj.ID)
 
 	if fileErr != nil {
 		return nil, fileErr
@@ -25,35 +29,19 @@ func (r *accessibilityRequestResolver) Documents(ctx context.Context, obj *model
 
 	documents := []*model.AccessibilityRequestDocument{}
 
-	for i, file := range *files {
-		document := &model.AccessibilityRequestDocument{
-			ID:         file.ID,
-			RequestID:  file.RequestID,
-			Name:       fmt.Sprintf("Test Doc Number %+v", i+1),
-			UploadedAt: *file.CreatedAt,
-		}
-
-		status := "PENDING"
-
-		if file.VirusScanned == null.BoolFrom(true) {
-			if file.VirusClean == null.BoolFrom(false) {
-				status = "UNAVAILABLE"
-			}
-
-			if file.VirusClean == null.BoolFrom(true) {
-				status = "AVAILABLE"
-			}
-		}
-
-		document.Status = model.AccessibilityRequestDocumentStatus(status)
-		documents = append(documents, document)
+	for i, file := range files {
+		documents = append(documents, accessibilityRequestDocument(file, i))
 	}
 
 	return documents, nil
 }
 
 func (r *accessibilityRequestResolver) System(ctx context.Context, obj *models.AccessibilityRequest) (*models.System, error) {
-	system, systemErr := r.store.FetchSystemByIntakeID(ctx, obj.IntakeID)
+	l, err := loaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	system, systemErr := l.SystemByIntakeID(ctx, obj.IntakeID)
 	if systemErr != nil {
 		return nil, systemErr
 	}
@@ -65,6 +53,46 @@ func (r *accessibilityRequestResolver) System(ctx context.Context, obj *models.A
 	return system, nil
 }
 
+func (r *businessCaseResolver) LifecycleCostLines(ctx context.Context, obj *models.BusinessCase) ([]*models.EstimatedLifecycleCost, error) {
+	lines := []*models.EstimatedLifecycleCost{}
+	for ix := range obj.LifecycleCostLines {
+		lines = append(lines, &obj.LifecycleCostLines[ix])
+	}
+	return lines, nil
+}
+
+func (r *businessCaseResolver) LifecycleCostSummary(ctx context.Context, obj *models.BusinessCase, discountRate *float64) (*models.LifecycleCostSummary, error) {
+	return r.service.SummarizeLifecycleCosts(obj.LifecycleCostLines, discountRate)
+}
+
+func (r *estimatedLifecycleCostResolver) Phase(ctx context.Context, obj *models.EstimatedLifecycleCost) (*string, error) {
+	if obj.Phase == nil {
+		return nil, nil
+	}
+	phase := string(*obj.Phase)
+	return &phase, nil
+}
+
+func (r *estimatedLifecycleCostResolver) Solution(ctx context.Context, obj *models.EstimatedLifecycleCost) (string, error) {
+	return string(obj.Solution), nil
+}
+
+func (r *estimatedLifecycleCostResolver) Year(ctx context.Context, obj *models.EstimatedLifecycleCost) (string, error) {
+	return string(obj.Year), nil
+}
+
+func (r *lifecycleCostPhaseTotalResolver) Phase(ctx context.Context, obj *models.LifecycleCostPhaseTotal) (string, error) {
+	return string(obj.Phase), nil
+}
+
+func (r *lifecycleCostSolutionSummaryResolver) Solution(ctx context.Context, obj *models.LifecycleCostSolutionSummary) (string, error) {
+	return string(obj.Solution), nil
+}
+
+func (r *lifecycleCostYearTotalResolver) Year(ctx context.Context, obj *models.LifecycleCostYearTotal) (string, error) {
+	return string(obj.Year), nil
+}
+
 func (r *mutationResolver) CreateAccessibilityRequest(ctx context.Context, input *model.CreateAccessibilityRequestInput) (*model.CreateAccessibilityRequestPayload, error) {
 	request, err := r.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
 		Name:     input.Name,
@@ -93,6 +121,47 @@ func (r *mutationResolver) CreateTestDate(ctx context.Context, input *model.Crea
 	return &model.CreateTestDatePayload{TestDate: testDate, UserErrors: nil}, nil
 }
 
+func (r *mutationResolver) CreateSystemIntake(ctx context.Context, input model.CreateSystemIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	if err := validateRequired(models.SystemIntake{}, map[string]string{"requester": input.Requester}); err != nil {
+		return systemIntakePayload(nil, err)
+	}
+	return systemIntakePayload(r.service.CreateSystemIntake(ctx, &models.SystemIntake{
+		Status:      models.SystemIntakeStatusINTAKEDRAFT,
+		Requester:   input.Requester,
+		RequestType: input.RequestType,
+	}))
+}
+
+func (r *mutationResolver) CreateSystemIntakeAction(ctx context.Context, input model.CreateSystemIntakeActionInput) (*model.UpdateSystemIntakePayload, error) {
+	return r.takeAction(ctx, &models.Action{
+		IntakeID:        &input.IntakeID,
+		ActionType:      input.ActionType,
+		Feedback:        null.StringFromPtr(input.Feedback),
+		EmailRecipients: emailRecipientsFromInput(input.Recipients),
+	})
+}
+
+func (r *mutationResolver) CreateSystemIntakeNote(ctx context.Context, input model.CreateSystemIntakeNoteInput) (*model.CreateSystemIntakeNotePayload, error) {
+	err := validateRequired(models.Note{}, map[string]string{"content": input.Content})
+	if err == nil {
+		var note *models.Note
+		note, err = r.service.CreateNote(ctx, &models.Note{
+			SystemIntakeID: input.IntakeID,
+			AuthorName:     null.StringFrom(input.AuthorName),
+			Content:        null.StringFrom(input.Content),
+		})
+		if err == nil {
+			return &model.CreateSystemIntakeNotePayload{Note: note}, nil
+		}
+	}
+
+	userErrors, err := userErrorsFrom(err)
+	if err != nil {
+		return nil, err
+	}
+	return &model.CreateSystemIntakeNotePayload{UserErrors: userErrors}, nil
+}
+
 func (r *mutationResolver) GeneratePresignedUploadURL(ctx context.Context, input *model.GeneratePresignedUploadURLInput) (*model.GeneratePresignedUploadURLPayload, error) {
 	url, err := r.s3Client.NewPutPresignedURL(input.MimeType)
 	if err != nil {
@@ -103,58 +172,298 @@ func (r *mutationResolver) GeneratePresignedUploadURL(ctx context.Context, input
 	}, nil
 }
 
+func (r *mutationResolver) IssueLifecycleID(ctx context.Context, input model.IssueLifecycleIDInput) (*model.UpdateSystemIntakePayload, error) {
+	err := validateRequired(models.SystemIntake{}, map[string]string{
+		"scope":     input.Scope,
+		"nextSteps": input.NextSteps,
+		"feedback":  input.Feedback,
+	})
+	if err != nil {
+		return systemIntakePayload(nil, err)
+	}
+
+	intake := &models.SystemIntake{
+		ID:                 input.IntakeID,
+		LifecycleID:        null.StringFromPtr(input.Lcid),
+		LifecycleExpiresAt: &input.ExpiresAt,
+		LifecycleScope:     null.StringFrom(input.Scope),
+		DecisionNextSteps:  null.StringFrom(input.NextSteps),
+	}
+	action := &models.Action{
+		Feedback:        null.StringFrom(input.Feedback),
+		EmailRecipients: emailRecipientsFromInput(input.Recipients),
+	}
+	return systemIntakePayload(r.service.IssueLifecycleID(ctx, intake, action))
+}
+
+func (r *mutationResolver) RejectIntake(ctx context.Context, input model.RejectIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	err := validateRequired(models.SystemIntake{}, map[string]string{
+		"reason":    input.Reason,
+		"nextSteps": input.NextSteps,
+		"feedback":  input.Feedback,
+	})
+	if err != nil {
+		return systemIntakePayload(nil, err)
+	}
+
+	intake := &models.SystemIntake{
+		ID:                input.IntakeID,
+		RejectionReason:   null.StringFrom(input.Reason),
+		DecisionNextSteps: null.StringFrom(input.NextSteps),
+	}
+	action := &models.Action{
+		IntakeID:        &input.IntakeID,
+		Feedback:        null.StringFrom(input.Feedback),
+		EmailRecipients: emailRecipientsFromInput(input.Recipients),
+	}
+	return systemIntakePayload(r.service.RejectIntake(ctx, intake, action))
+}
+
+func (r *mutationResolver) SubmitIntake(ctx context.Context, input model.SubmitIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	return r.takeAction(ctx, &models.Action{
+		IntakeID:   &input.ID,
+		ActionType: models.ActionTypeSUBMITINTAKE,
+	})
+}
+
+func (r *mutationResolver) UpdateSystemIntake(ctx context.Context, input model.UpdateSystemIntakeInput) (*model.UpdateSystemIntakePayload, error) {
+	intake, err := r.service.FetchSystemIntakeByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	applyUpdateSystemIntakeInput(intake, input)
+	return systemIntakePayload(r.service.UpdateSystemIntake(ctx, intake))
+}
+
 func (r *queryResolver) AccessibilityRequest(ctx context.Context, id uuid.UUID) (*models.AccessibilityRequest, error) {
 	return r.store.FetchAccessibilityRequestByID(ctx, id)
 }
 
-func (r *queryResolver) AccessibilityRequests(ctx context.Context, after *string, first int) (*model.AccessibilityRequestsConnection, error) {
-	requests, queryErr := r.store.FetchAccessibilityRequests(ctx)
+func (r *queryResolver) AccessibilityRequests(ctx context.Context, after *string, direction *models.SortDirection, first int, sortBy *models.SortField) (*model.AccessibilityRequestsConnection, error) {
+	request := models.PageRequest{First: first}
+	if after != nil {
+		request.After = *after
+	}
+	if sortBy != nil {
+		request.SortBy = *sortBy
+	}
+	if direction != nil {
+		request.Direction = *direction
+	}
+	page, queryErr := r.store.FetchAccessibilityRequestsPage(ctx, request)
 	if queryErr != nil {
 		return nil, gqlerror.Errorf("query error: %s", queryErr)
 	}
 
 	edges := []*model.AccessibilityRequestEdge{}
 
-	for _, request := range requests {
+	for ix, request := range page.Results {
 		node := request
 		edges = append(edges, &model.AccessibilityRequestEdge{
-			Node: &node,
+			Cursor: page.Cursors[ix],
+			Node:   &node,
 		})
 	}
 
-	return &model.AccessibilityRequestsConnection{Edges: edges}, nil
+	return &model.AccessibilityRequestsConnection{
+		Edges:      edges,
+		PageInfo:   &page.PageInfo,
+		TotalCount: page.TotalCount,
+	}, nil
+}
+
+func (r *queryResolver) BusinessCase(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+	return r.service.FetchBusinessCaseByID(ctx, id)
+}
+
+func (r *queryResolver) RelatedSystemIntakes(ctx context.Context) ([]*models.SystemIntake, error) {
+	intakes, err := r.service.FetchRelatedIntakes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	related := make([]*models.SystemIntake, len(intakes))
+	for i := range intakes {
+		related[i] = &intakes[i]
+	}
+	return related, nil
+}
+
+func (r *queryResolver) SystemIntake(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+	return r.service.FetchSystemIntakeByID(ctx, id)
+}
+
+func (r *queryResolver) SystemIntakeSearch(ctx context.Context, input model.SystemIntakeSearchInput) (*models.SystemIntakeSearchResult, error) {
+	search := models.SystemIntakeSearch{
+		Statuses:        input.Statuses,
+		RequestTypes:    input.RequestTypes,
+		Components:      input.Components,
+		SubmittedAfter:  input.SubmittedAfter,
+		SubmittedBefore: input.SubmittedBefore,
+		HasLifecycleID:  input.HasLcid,
+		First:           input.First,
+	}
+	if input.Query != nil {
+		search.Query = strings.TrimSpace(*input.Query)
+	}
+	if input.After != nil {
+		search.After = *input.After
+	}
+	return r.service.SearchSystemIntakes(ctx, search)
 }
 
 func (r *queryResolver) Systems(ctx context.Context, after *string, first int) (*model.SystemConnection, error) {
-	systems, err := r.store.ListSystems(ctx)
+	request := models.PageRequest{First: first}
+	if after != nil {
+		request.After = *after
+	}
+	page, err := r.store.ListSystemsPage(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	conn := &model.SystemConnection{}
-	for _, system := range systems {
+	conn := &model.SystemConnection{
+		Edges:      []*model.SystemEdge{},
+		PageInfo:   &page.PageInfo,
+		TotalCount: page.TotalCount,
+	}
+	for ix, system := range page.Results {
 		system.BusinessOwner = &models.BusinessOwner{
 			Name:      system.BusinessOwnerName.String,
 			Component: system.BusinessOwnerComponent.String,
 		}
 		conn.Edges = append(conn.Edges, &model.SystemEdge{
-			Node: system,
+			Cursor: page.Cursors[ix],
+			Node:   system,
 		})
 	}
 	return conn, nil
 }
 
+func (r *queryResolver) UserSearch(ctx context.Context, commonName string) ([]*models.UserInfo, error) {
+	return r.service.SearchUsers(ctx, commonName)
+}
+
+func (r *subscriptionResolver) AccessibilityDocumentAdded(ctx context.Context, requestID uuid.UUID) (<-chan *model.AccessibilityRequestDocument, error) {
+	files, err := r.service.SubscribeDocumentAdded(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make(chan *model.AccessibilityRequestDocument)
+	go func() {
+		defer close(documents)
+		for file := range files {
+			// documents are numbered by their place among the request's files
+			requestFiles, err := r.store.FetchFilesByAccessibilityRequestID(ctx, requestID)
+			if err != nil {
+				continue
+			}
+			for i, requestFile := range *requestFiles {
+				if requestFile.ID != file.ID {
+					continue
+				}
+				select {
+				case documents <- accessibilityRequestDocument(*file, i):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return documents, nil
+}
+
+func (r *subscriptionResolver) IntakeStatusChanged(ctx context.Context, intakeID uuid.UUID) (<-chan *models.SystemIntake, error) {
+	return r.service.SubscribeIntakeStatusChanged(ctx, intakeID)
+}
+
+func (r *subscriptionResolver) NoteAdded(ctx context.Context, intakeID uuid.UUID) (<-chan *models.Note, error) {
+	return r.service.SubscribeNoteAdded(ctx, intakeID)
+}
+
+func (r *systemIntakeResolver) Actions(ctx context.Context, obj *models.SystemIntake) ([]*models.Action, error) {
+	l, err := loaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := l.ActionsBySystemIntakeID(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*models.Action{}
+	for ix := range actions {
+		results = append(results, &actions[ix])
+	}
+	return results, nil
+}
+
+func (r *systemIntakeResolver) BusinessCase(ctx context.Context, obj *models.SystemIntake) (*models.BusinessCase, error) {
+	if obj.BusinessCaseID == nil {
+		return nil, nil
+	}
+	l, err := loaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return l.BusinessCaseByID(ctx, *obj.BusinessCaseID)
+}
+
+func (r *systemIntakeResolver) Notes(ctx context.Context, obj *models.SystemIntake) ([]*models.Note, error) {
+	l, err := loaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return l.NotesBySystemIntakeID(ctx, obj.ID)
+}
+
 // AccessibilityRequest returns generated.AccessibilityRequestResolver implementation.
 func (r *Resolver) AccessibilityRequest() generated.AccessibilityRequestResolver {
 	return &accessibilityRequestResolver{r}
 }
 
+// BusinessCase returns generated.BusinessCaseResolver implementation.
+func (r *Resolver) BusinessCase() generated.BusinessCaseResolver { return &businessCaseResolver{r} }
+
+// EstimatedLifecycleCost returns generated.EstimatedLifecycleCostResolver implementation.
+func (r *Resolver) EstimatedLifecycleCost() generated.EstimatedLifecycleCostResolver {
+	return &estimatedLifecycleCostResolver{r}
+}
+
+// LifecycleCostPhaseTotal returns generated.LifecycleCostPhaseTotalResolver implementation.
+func (r *Resolver) LifecycleCostPhaseTotal() generated.LifecycleCostPhaseTotalResolver {
+	return &lifecycleCostPhaseTotalResolver{r}
+}
+
+// LifecycleCostSolutionSummary returns generated.LifecycleCostSolutionSummaryResolver implementation.
+func (r *Resolver) LifecycleCostSolutionSummary() generated.LifecycleCostSolutionSummaryResolver {
+	return &lifecycleCostSolutionSummaryResolver{r}
+}
+
+// LifecycleCostYearTotal returns generated.LifecycleCostYearTotalResolver implementation.
+func (r *Resolver) LifecycleCostYearTotal() generated.LifecycleCostYearTotalResolver {
+	return &lifecycleCostYearTotalResolver{r}
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// SystemIntake returns generated.SystemIntakeResolver implementation.
+func (r *Resolver) SystemIntake() generated.SystemIntakeResolver { return &systemIntakeResolver{r} }
+
 type accessibilityRequestResolver struct{ *Resolver }
+type businessCaseResolver struct{ *Resolver }
+type estimatedLifecycleCostResolver struct{ *Resolver }
+type lifecycleCostPhaseTotalResolver struct{ *Resolver }
+type lifecycleCostSolutionSummaryResolver struct{ *Resolver }
+type lifecycleCostYearTotalResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type systemIntakeResolver struct{ *Resolver }
@@ -2,27 +2,37 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/google/uuid"
 	"github.com/guregu/null"
 	_ "github.com/lib/pq" // required for postgres driver in sql
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/zap"
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/dataloaders"
 	"github.com/cmsgov/easi-app/pkg/graph/generated"
+	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/services"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -87,8 +97,18 @@ func TestGraphQLTestSuite(t *testing.T) {
 	mockClient := mockS3Client{}
 	s3Client := upload.NewS3ClientUsingClient(mockClient, s3Config)
 
-	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(store, ResolverService{}, &s3Client)})
-	graphQLClient := client.New(handler.NewDefaultServer(schema))
+	resolverService := ResolverService{
+		SearchSystemIntakes:     store.SearchSystemIntakes,
+		FetchSystemIntakeByID:   store.FetchSystemIntakeByID,
+		CreateSystemIntake:      store.CreateSystemIntake,
+		UpdateSystemIntake:      store.UpdateSystemIntake,
+		CreateNote:              store.CreateNote,
+		FetchBusinessCaseByID:   store.FetchBusinessCaseByID,
+		SummarizeLifecycleCosts: services.SummarizeLifecycleCosts,
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(store, resolverService, &s3Client), Directives: testDirectives})
+	loaderMiddleware := dataloaders.NewMiddleware(storeFetchers(store))
+	graphQLClient := client.New(loaderMiddleware(handler.NewDefaultServer(schema)))
 
 	storeTestSuite := &GraphQLTestSuite{
 		Suite:  suite.Suite{},
@@ -100,6 +120,51 @@ func TestGraphQLTestSuite(t *testing.T) {
 	suite.Run(t, storeTestSuite)
 }
 
+// testDirectives let every test through role checks, which the services themselves are tested for
+var testDirectives = generated.DirectiveRoot{
+	HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+		return next(ctx)
+	},
+	IsRequesterOrRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+		return next(ctx)
+	},
+}
+
+// storeFetchers has the dataloaders query the store directly
+func storeFetchers(store *storage.Store) dataloaders.Fetchers {
+	return dataloaders.Fetchers{
+		FetchSystemsByIntakeIDs:             store.FetchSystemsByIntakeIDs,
+		FetchFilesByAccessibilityRequestIDs: store.FetchFilesByAccessibilityRequestIDs,
+		FetchBusinessCasesByIDs:             store.FetchBusinessCasesByIDs,
+		FetchNotesBySystemIntakeIDs:         store.FetchNotesBySystemIntakeIDs,
+		FetchActionsBySystemIntakeIDs:       store.GetActionsByRequestIDs,
+	}
+}
+
+func (s GraphQLTestSuite) TestEveryOperationIsAuthorized() {
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+
+	for _, root := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		for _, field := range root.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			// the root has no requester to check, so only a role will do
+			s.NotNil(field.Directives.ForName("hasRole"), "%s.%s has no @hasRole directive", root.Name, field.Name)
+		}
+	}
+
+	for _, definition := range schema.Types {
+		for _, field := range definition.Fields {
+			if field.Directives.ForName("isRequesterOrRole") == nil {
+				continue
+			}
+			s.Contains([]string{"SystemIntake", "BusinessCase"}, definition.Name,
+				"%s.%s has @isRequesterOrRole but %s has no requester", definition.Name, field.Name, definition.Name)
+		}
+	}
+}
+
 func (s GraphQLTestSuite) TestAccessibilityRequestQuery() {
 	ctx := context.Background()
 
@@ -169,6 +234,643 @@ func (s GraphQLTestSuite) TestAccessibilityRequestQuery() {
 	s.Equal("OIT", resp.AccessibilityRequest.System.BusinessOwner.Component)
 }
 
+func (s GraphQLTestSuite) TestAccessibilityRequestsQuery() {
+	ctx := context.Background()
+
+	intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+		Status:      models.SystemIntakeStatusLCIDISSUED,
+		RequestType: models.SystemIntakeRequestTypeNEW,
+	})
+	s.NoError(intakeErr)
+	for i := 0; i < 2; i++ {
+		_, requestErr := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+			IntakeID: intake.ID,
+		})
+		s.NoError(requestErr)
+	}
+
+	type page struct {
+		AccessibilityRequests struct {
+			Edges []struct {
+				Cursor string
+				Node   struct {
+					ID string
+				}
+			}
+			PageInfo struct {
+				EndCursor   string
+				HasNextPage bool
+			}
+			TotalCount int
+		}
+	}
+
+	var first page
+	s.client.MustPost(
+		`query {
+			accessibilityRequests(first: 1, sortBy: SUBMITTED, direction: DESC) {
+				edges {
+					cursor
+					node {
+						id
+					}
+				}
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+				totalCount
+			}
+		}`, &first)
+
+	s.GreaterOrEqual(first.AccessibilityRequests.TotalCount, 2)
+	s.Len(first.AccessibilityRequests.Edges, 1)
+	s.True(first.AccessibilityRequests.PageInfo.HasNextPage)
+	s.Equal(first.AccessibilityRequests.Edges[0].Cursor, first.AccessibilityRequests.PageInfo.EndCursor)
+
+	var second page
+	s.client.MustPost(fmt.Sprintf(
+		`query {
+			accessibilityRequests(first: 1, sortBy: SUBMITTED, direction: DESC, after: "%s") {
+				edges {
+					cursor
+					node {
+						id
+					}
+				}
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+				totalCount
+			}
+		}`, first.AccessibilityRequests.PageInfo.EndCursor), &second)
+
+	s.Len(second.AccessibilityRequests.Edges, 1)
+	s.NotEqual(first.AccessibilityRequests.Edges[0].Node.ID, second.AccessibilityRequests.Edges[0].Node.ID)
+}
+
+func (s GraphQLTestSuite) TestAccessibilityRequestsQueryBatchesRelations() {
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+			ProjectName: null.StringFrom(fmt.Sprintf("Batched Project %d", i)),
+			Status:      models.SystemIntakeStatusLCIDISSUED,
+			RequestType: models.SystemIntakeRequestTypeNEW,
+		})
+		s.NoError(intakeErr)
+		lifecycleID, lcidErr := s.store.GenerateLifecycleID(ctx)
+		s.NoError(lcidErr)
+		intake.LifecycleID = null.StringFrom(lifecycleID)
+		_, updateErr := s.store.UpdateSystemIntake(ctx, intake)
+		s.NoError(updateErr)
+
+		_, requestErr := s.store.CreateAccessibilityRequest(ctx, &models.AccessibilityRequest{
+			IntakeID: intake.ID,
+		})
+		s.NoError(requestErr)
+	}
+
+	// count the queries each relation makes while resolving the list
+	var mu sync.Mutex
+	queries := map[string]int{}
+	count := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		queries[name]++
+	}
+	fetchers := storeFetchers(s.store)
+	fetchers.FetchSystemsByIntakeIDs = func(ctx context.Context, ids []uuid.UUID) ([]*models.System, error) {
+		count("systems")
+		return s.store.FetchSystemsByIntakeIDs(ctx, ids)
+	}
+	fetchers.FetchFilesByAccessibilityRequestIDs = func(ctx context.Context, ids []uuid.UUID) ([]models.UploadedFile, error) {
+		count("files")
+		return s.store.FetchFilesByAccessibilityRequestIDs(ctx, ids)
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(s.store, ResolverService{}, nil), Directives: testDirectives})
+	countingClient := client.New(dataloaders.NewMiddleware(fetchers)(handler.NewDefaultServer(schema)))
+
+	var resp struct {
+		AccessibilityRequests struct {
+			Edges []struct {
+				Node struct {
+					ID        string
+					Documents []struct {
+						ID string
+					}
+					System struct {
+						Name string
+					}
+				}
+			}
+		}
+	}
+	countingClient.MustPost(
+		`query {
+			accessibilityRequests(first: 3, sortBy: SUBMITTED, direction: DESC) {
+				edges {
+					node {
+						id
+						documents {
+							id
+						}
+						system {
+							name
+						}
+					}
+				}
+			}
+		}`, &resp)
+
+	s.Len(resp.AccessibilityRequests.Edges, 3)
+	for _, edge := range resp.AccessibilityRequests.Edges {
+		s.Contains(edge.Node.System.Name, "Batched Project")
+		s.Empty(edge.Node.Documents)
+	}
+	s.Equal(map[string]int{"systems": 1, "files": 1}, queries)
+}
+
+func (s GraphQLTestSuite) TestSystemIntakeSearchBatchesRelations() {
+	intakes := []models.SystemIntake{}
+	for i := 0; i < 3; i++ {
+		businessCaseID := uuid.New()
+		intakes = append(intakes, models.SystemIntake{
+			ID:             uuid.New(),
+			Status:         models.SystemIntakeStatusINTAKESUBMITTED,
+			RequestType:    models.SystemIntakeRequestTypeNEW,
+			BusinessCaseID: &businessCaseID,
+		})
+	}
+	service := ResolverService{
+		SearchSystemIntakes: func(_ context.Context, _ models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			return &models.SystemIntakeSearchResult{
+				TotalCount: len(intakes),
+				Results:    intakes,
+				Facets:     []models.SystemIntakeSearchFacetCounts{},
+			}, nil
+		},
+	}
+
+	// count the queries each relation makes while resolving the list
+	var mu sync.Mutex
+	queries := map[string]int{}
+	count := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		queries[name]++
+	}
+	fetchers := dataloaders.Fetchers{
+		FetchBusinessCasesByIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+			count("businessCases")
+			businessCases := []*models.BusinessCase{}
+			for _, id := range ids {
+				businessCases = append(businessCases, &models.BusinessCase{ID: id, Status: models.BusinessCaseStatusOPEN})
+			}
+			return businessCases, nil
+		},
+		FetchNotesBySystemIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+			count("notes")
+			notes := []*models.Note{}
+			for _, id := range ids {
+				notes = append(notes, &models.Note{ID: uuid.New(), SystemIntakeID: id, AuthorEUAID: "ABCD"})
+			}
+			return notes, nil
+		},
+		FetchActionsBySystemIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]models.Action, error) {
+			count("actions")
+			return []models.Action{}, nil
+		},
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives})
+	countingClient := client.New(dataloaders.NewMiddleware(fetchers)(handler.NewDefaultServer(schema)))
+
+	var resp struct {
+		SystemIntakeSearch struct {
+			Results []struct {
+				ID           string
+				Actions      []struct{ ID string }
+				BusinessCase struct{ ID string }
+				Notes        []struct{ ID string }
+			}
+		}
+	}
+	countingClient.MustPost(
+		`query {
+			systemIntakeSearch(input: {first: 10}) {
+				results {
+					id
+					actions {
+						id
+					}
+					businessCase {
+						id
+					}
+					notes {
+						id
+					}
+				}
+			}
+		}`, &resp)
+
+	s.Len(resp.SystemIntakeSearch.Results, 3)
+	for ix, result := range resp.SystemIntakeSearch.Results {
+		s.Equal(intakes[ix].BusinessCaseID.String(), result.BusinessCase.ID)
+		s.Len(result.Notes, 1)
+		s.Empty(result.Actions)
+	}
+	s.Equal(map[string]int{"businessCases": 1, "notes": 1, "actions": 1}, queries)
+}
+
+func (s GraphQLTestSuite) TestBusinessCaseLifecycleCostSummary() {
+	development := models.LifecycleCostPhaseDEVELOPMENT
+	cost := func(c int) *int { return &c }
+	businessCase := &models.BusinessCase{
+		ID:     uuid.New(),
+		Status: models.BusinessCaseStatusOPEN,
+		LifecycleCostLines: models.EstimatedLifecycleCosts{
+			{Solution: models.LifecycleCostSolutionASIS, Phase: &development, Year: models.LifecycleCostYear1, Cost: cost(100)},
+			{Solution: models.LifecycleCostSolutionPREFERRED, Phase: &development, Year: models.LifecycleCostYear2, Cost: cost(300)},
+		},
+	}
+	service := ResolverService{
+		FetchBusinessCaseByID: func(_ context.Context, _ uuid.UUID) (*models.BusinessCase, error) {
+			return businessCase, nil
+		},
+		SummarizeLifecycleCosts: services.SummarizeLifecycleCosts,
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives})
+	summaryClient := client.New(handler.NewDefaultServer(schema))
+
+	var resp struct {
+		BusinessCase struct {
+			LifecycleCostSummary struct {
+				DiscountRate *float64
+				Solutions    []struct {
+					Solution        string
+					Total           int
+					DeltaFromAsIs   *int
+					NetPresentValue *float64
+					Phases          []struct {
+						Phase string
+						Total int
+					}
+				}
+			}
+		}
+	}
+	summaryClient.MustPost(
+		`query($id: UUID!) {
+			businessCase(id: $id) {
+				lifecycleCostSummary(discountRate: 0.1) {
+					discountRate
+					solutions {
+						solution
+						total
+						deltaFromAsIs
+						netPresentValue
+						phases {
+							phase
+							total
+						}
+					}
+				}
+			}
+		}`, &resp, client.Var("id", businessCase.ID))
+
+	summary := resp.BusinessCase.LifecycleCostSummary
+	s.Equal(0.1, *summary.DiscountRate)
+	s.Len(summary.Solutions, 2)
+	s.Equal("As Is", summary.Solutions[0].Solution)
+	s.Equal(100, summary.Solutions[0].Total)
+	s.Nil(summary.Solutions[0].DeltaFromAsIs)
+	s.Equal("Preferred", summary.Solutions[1].Solution)
+	s.Equal(200, *summary.Solutions[1].DeltaFromAsIs)
+	s.InDelta(300/1.21, *summary.Solutions[1].NetPresentValue, 0.001)
+	s.Equal("Development", summary.Solutions[1].Phases[0].Phase)
+
+	s.Run("rejects a negative discount rate", func() {
+		var errResp struct{}
+		err := summaryClient.Post(
+			`query($id: UUID!) {
+				businessCase(id: $id) {
+					lifecycleCostSummary(discountRate: -0.1) {
+						discountRate
+					}
+				}
+			}`, &errResp, client.Var("id", businessCase.ID))
+		s.Error(err)
+	})
+}
+
+func (s GraphQLTestSuite) TestIntakeStatusChangedSubscription() {
+	intakeID := uuid.New()
+	statuses := []models.SystemIntakeStatus{
+		models.SystemIntakeStatusREADYFORGRT,
+		models.SystemIntakeStatusREADYFORGRB,
+	}
+	service := ResolverService{
+		SubscribeIntakeStatusChanged: func(_ context.Context, id uuid.UUID) (<-chan *models.SystemIntake, error) {
+			intakes := make(chan *models.SystemIntake, len(statuses))
+			for _, status := range statuses {
+				intakes <- &models.SystemIntake{ID: id, Status: status, RequestType: models.SystemIntakeRequestTypeNEW}
+			}
+			close(intakes)
+			return intakes, nil
+		},
+	}
+
+	// a note is added between the two status changes
+	var mu sync.Mutex
+	noteCount := 0
+	fetchers := dataloaders.Fetchers{
+		FetchNotesBySystemIntakeIDs: func(_ context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			noteCount++
+			notes := []*models.Note{}
+			for i := 0; i < noteCount; i++ {
+				notes = append(notes, &models.Note{ID: uuid.New(), SystemIntakeID: ids[0], AuthorEUAID: "ABCD"})
+			}
+			return notes, nil
+		},
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives})
+	srv := handler.NewDefaultServer(schema)
+	srv.Use(dataloaders.SubscriptionExtension{})
+	subscriptionClient := client.New(dataloaders.NewMiddleware(fetchers)(srv))
+
+	subscription := subscriptionClient.Websocket(fmt.Sprintf(
+		`subscription {
+			intakeStatusChanged(intakeID: "%s") {
+				id
+				status
+				notes {
+					id
+				}
+			}
+		}`, intakeID))
+	defer func() { _ = subscription.Close() }()
+
+	for ix, status := range statuses {
+		var resp struct {
+			IntakeStatusChanged struct {
+				ID     string
+				Status string
+				Notes  []struct{ ID string }
+			}
+		}
+		s.NoError(subscription.Next(&resp))
+		s.Equal(intakeID.String(), resp.IntakeStatusChanged.ID)
+		s.Equal(string(status), resp.IntakeStatusChanged.Status)
+		// relations are loaded fresh for every change
+		s.Len(resp.IntakeStatusChanged.Notes, ix+1)
+	}
+}
+
+func (s GraphQLTestSuite) TestSystemIntakeSearchQuery() {
+	ctx := context.Background()
+
+	intake, intakeErr := s.store.CreateSystemIntake(ctx, &models.SystemIntake{
+		ProjectName: null.StringFrom("Searchable Wombat Registry"),
+		Status:      models.SystemIntakeStatusINTAKESUBMITTED,
+		RequestType: models.SystemIntakeRequestTypeNEW,
+		Requester:   "Searchable Requester",
+	})
+	s.NoError(intakeErr)
+
+	var resp struct {
+		SystemIntakeSearch struct {
+			TotalCount int
+			Results    []struct {
+				ID          string
+				ProjectName string
+				Status      string
+				Lcid        *string
+			}
+			Facets []struct {
+				Facet  string
+				Counts []struct {
+					Value string
+					Count int
+				}
+			}
+		}
+	}
+
+	s.client.MustPost(
+		`query {
+			systemIntakeSearch(input: {query: "wombat registry", statuses: [INTAKE_SUBMITTED], first: 10}) {
+				totalCount
+				results {
+					id
+					projectName
+					status
+					lcid
+				}
+				facets {
+					facet
+					counts {
+						value
+						count
+					}
+				}
+			}
+		}`, &resp)
+
+	s.Equal(1, resp.SystemIntakeSearch.TotalCount)
+	s.Equal(intake.ID.String(), resp.SystemIntakeSearch.Results[0].ID)
+	s.Equal("Searchable Wombat Registry", resp.SystemIntakeSearch.Results[0].ProjectName)
+	s.Equal("INTAKE_SUBMITTED", resp.SystemIntakeSearch.Results[0].Status)
+	s.Nil(resp.SystemIntakeSearch.Results[0].Lcid)
+	s.Equal("STATUS", resp.SystemIntakeSearch.Facets[0].Facet)
+}
+
+func (s GraphQLTestSuite) TestSystemIntakeMutations() {
+	ctx := context.Background()
+
+	var created struct {
+		CreateSystemIntake struct {
+			SystemIntake struct {
+				ID     string
+				Status string
+			}
+		}
+	}
+	s.client.MustPost(
+		`mutation {
+			createSystemIntake(input: {requester: "Graph Requester", requestType: NEW}) {
+				systemIntake {
+					id
+					status
+				}
+			}
+		}`, &created)
+	intakeID := created.CreateSystemIntake.SystemIntake.ID
+	s.NotEmpty(intakeID)
+	s.Equal("INTAKE_DRAFT", created.CreateSystemIntake.SystemIntake.Status)
+
+	var updated struct {
+		UpdateSystemIntake struct {
+			SystemIntake struct {
+				ExistingFunding *bool
+				FundingSource   *string
+				ProjectName     *string
+				Requester       string
+				Version         int
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			updateSystemIntake(input: {id: "%s", projectName: "Graph Project", existingFunding: false, version: 1}) {
+				systemIntake {
+					existingFunding
+					fundingSource
+					projectName
+					requester
+					version
+				}
+			}
+		}`, intakeID), &updated)
+	intake := updated.UpdateSystemIntake.SystemIntake
+	s.Equal("Graph Requester", intake.Requester)
+	s.Equal("Graph Project", *intake.ProjectName)
+	s.False(*intake.ExistingFunding)
+	s.Nil(intake.FundingSource)
+	s.Equal(2, intake.Version)
+
+	err := s.client.Post(fmt.Sprintf(
+		`mutation {
+			updateSystemIntake(input: {id: "%s", projectName: "Stale Project", version: 1}) {
+				systemIntake {
+					projectName
+				}
+			}
+		}`, intakeID), &updated)
+	s.Error(err, "an update to a stale version should fail")
+
+	var noted struct {
+		CreateSystemIntakeNote struct {
+			Note struct {
+				Content string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			createSystemIntakeNote(input: {intakeId: "%s", authorName: "Reviewer", content: "looks good"}) {
+				note {
+					content
+				}
+			}
+		}`, intakeID), &noted)
+	s.Equal("looks good", noted.CreateSystemIntakeNote.Note.Content)
+
+	// attach a business case, which can only be done in the store
+	fetched, err := s.store.FetchSystemIntakeByID(ctx, uuid.MustParse(intakeID))
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.SystemIntakeID = fetched.ID
+	createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+	fetched.BusinessCaseID = &createdBusinessCase.ID
+	_, err = s.store.UpdateSystemIntake(ctx, fetched)
+	s.NoError(err)
+
+	var queried struct {
+		SystemIntake struct {
+			BusinessCase struct {
+				ID                 string
+				LifecycleCostLines []struct {
+					Phase    *string
+					Solution string
+					Year     string
+				}
+			}
+			Notes []struct {
+				AuthorName string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`query {
+			systemIntake(id: "%s") {
+				businessCase {
+					id
+					lifecycleCostLines {
+						phase
+						solution
+						year
+					}
+				}
+				notes {
+					authorName
+				}
+			}
+		}`, intakeID), &queried)
+	s.Equal(createdBusinessCase.ID.String(), queried.SystemIntake.BusinessCase.ID)
+	s.Len(queried.SystemIntake.BusinessCase.LifecycleCostLines, len(businessCase.LifecycleCostLines))
+	s.Equal("Reviewer", queried.SystemIntake.Notes[0].AuthorName)
+}
+
+func (s GraphQLTestSuite) TestRejectIntakeReturnsUserErrors() {
+	var resp struct {
+		RejectIntake struct {
+			SystemIntake *struct {
+				ID string
+			}
+			UserErrors []struct {
+				Message string
+				Path    []string
+			}
+		}
+	}
+	s.client.MustPost(fmt.Sprintf(
+		`mutation {
+			rejectIntake(input: {intakeId: "%s", reason: " ", nextSteps: "try again", feedback: "no"}) {
+				systemIntake {
+					id
+				}
+				userErrors {
+					message
+					path
+				}
+			}
+		}`, uuid.New()), &resp)
+
+	s.Nil(resp.RejectIntake.SystemIntake)
+	s.Len(resp.RejectIntake.UserErrors, 1)
+	s.Equal("is required", resp.RejectIntake.UserErrors[0].Message)
+	s.Equal([]string{"input", "reason"}, resp.RejectIntake.UserErrors[0].Path)
+}
+
+func (s GraphQLTestSuite) TestUserErrorsFrom() {
+	s.Run("returns validations sorted by key", func() {
+		valErr := apperrors.NewValidationError(errors.New("failed validation"), models.SystemIntake{}, "")
+		valErr.WithValidation("ProjectName", "is required")
+		valErr.WithValidation("Component", "is required")
+
+		userErrors, err := userErrorsFrom(&apperrors.QueryError{Err: &valErr})
+
+		s.NoError(err)
+		s.Equal([]*model.UserError{
+			{Message: "is required", Path: []string{"Component"}},
+			{Message: "is required", Path: []string{"ProjectName"}},
+		}, userErrors)
+	})
+
+	s.Run("passes other errors through", func() {
+		queryErr := &apperrors.QueryError{Err: errors.New("forced error")}
+
+		userErrors, err := userErrorsFrom(queryErr)
+
+		s.Nil(userErrors)
+		s.Equal(queryErr, err)
+	})
+}
+
 func (s GraphQLTestSuite) TestGeneratePresignedUploadURLMutation() {
 	var resp struct {
 		GeneratePresignedUploadURL struct {
@@ -194,3 +896,66 @@ func (s GraphQLTestSuite) TestGeneratePresignedUploadURLMutation() {
 	s.Equal("https://signed.example.com/signed/123", resp.GeneratePresignedUploadURL.URL)
 	s.Equal(0, len(resp.GeneratePresignedUploadURL.UserErrors))
 }
+
+func (s GraphQLTestSuite) TestUserSearch() {
+	searched := ""
+	service := ResolverService{
+		SearchUsers: func(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+			searched = commonName
+			return []*models.UserInfo{{CommonName: "Adeline Aarons", Email: "adeline.aarons@local.fake", EuaUserID: "ABCD"}}, nil
+		},
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives})
+	searchClient := client.New(handler.NewDefaultServer(schema))
+
+	var resp struct {
+		UserSearch []struct {
+			CommonName string
+			Email      string
+			EuaUserID  string
+		}
+	}
+	searchClient.MustPost(
+		`query {
+			userSearch(commonName: "Adeline") {
+				commonName
+				email
+				euaUserId
+			}
+		}`, &resp)
+
+	s.Equal("Adeline", searched)
+	s.Len(resp.UserSearch, 1)
+	s.Equal("Adeline Aarons", resp.UserSearch[0].CommonName)
+	s.Equal("ABCD", resp.UserSearch[0].EuaUserID)
+}
+
+func (s GraphQLTestSuite) TestRelatedSystemIntakes() {
+	intake := testhelpers.NewSystemIntake()
+	intake.ProjectName = null.StringFrom("Related project")
+	service := ResolverService{
+		FetchRelatedIntakes: func(context.Context) (models.SystemIntakes, error) {
+			return models.SystemIntakes{intake}, nil
+		},
+	}
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives})
+	relatedClient := client.New(handler.NewDefaultServer(schema))
+
+	var resp struct {
+		RelatedSystemIntakes []struct {
+			ID          string
+			ProjectName string
+		}
+	}
+	relatedClient.MustPost(
+		`query {
+			relatedSystemIntakes {
+				id
+				projectName
+			}
+		}`, &resp)
+
+	s.Len(resp.RelatedSystemIntakes, 1)
+	s.Equal(intake.ID.String(), resp.RelatedSystemIntakes[0].ID)
+	s.Equal("Related project", resp.RelatedSystemIntakes[0].ProjectName)
+}
@@ -0,0 +1,228 @@
+package graph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+)
+
+// DefaultComplexityLimit is the most complex operation the server runs when no limit is configured
+const DefaultComplexityLimit = 500
+
+// DefaultDepthLimit is the most deeply nested operation the server runs when no limit is configured
+const DefaultDepthLimit = 10
+
+// ServerConfig limits what operations the GraphQL server will run
+type ServerConfig struct {
+	ComplexityLimit int
+	DepthLimit      int
+	// PersistedQueries, when set, are the only queries the server will run, keyed by the SHA-256 hash
+	// of the exact text clients send, so they must be generated from the client's own documents.
+	// Without them, clients may persist any query automatically.
+	PersistedQueries map[string]string
+	// WebsocketInit authorizes subscriptions from their connection_init payload
+	WebsocketInit transport.WebsocketInitFunc
+}
+
+// NewServer returns a GraphQL server for the schema that enforces the config's limits
+// and logs every operation it runs
+func NewServer(schema graphql.ExecutableSchema, config ServerConfig) *handler.Server {
+	srv := handler.New(schema)
+
+	srv.AddTransport(transport.Websocket{
+		InitFunc:              config.WebsocketInit,
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	if config.PersistedQueries != nil {
+		srv.Use(persistedQueryAllowlist{queries: config.PersistedQueries})
+	} else {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New(100),
+		})
+	}
+	srv.Use(extension.FixedComplexityLimit(config.ComplexityLimit))
+	srv.Use(depthLimit{limit: config.DepthLimit})
+	srv.Use(operationLogger{})
+
+	return srv
+}
+
+// LoadPersistedQueries reads a JSON object of queries keyed by their SHA-256 hash
+func LoadPersistedQueries(path string) (map[string]string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	queries := map[string]string{}
+	if err = json.Unmarshal(contents, &queries); err != nil {
+		return nil, err
+	}
+	for hash, query := range queries {
+		if queryHash(query) != hash {
+			return nil, fmt.Errorf("persisted query %s does not match its hash", hash)
+		}
+	}
+	return queries, nil
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// persistedQueryAllowlist runs only the persisted queries, whether clients send
+// the whole query or, as with automatic persisted queries, just its hash
+type persistedQueryAllowlist struct {
+	queries map[string]string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = persistedQueryAllowlist{}
+
+func (persistedQueryAllowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (persistedQueryAllowlist) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a persistedQueryAllowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if rawParams.Query != "" {
+		if _, ok := a.queries[queryHash(rawParams.Query)]; !ok {
+			err := gqlerror.Errorf("query is not persisted")
+			errcode.Set(err, "PERSISTED_QUERY_NOT_ALLOWED")
+			return err
+		}
+		return nil
+	}
+
+	persistedQuery, _ := rawParams.Extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := persistedQuery["sha256Hash"].(string)
+	query, ok := a.queries[hash]
+	if !ok {
+		err := gqlerror.Errorf("PersistedQueryNotFound")
+		errcode.Set(err, "PERSISTED_QUERY_NOT_FOUND")
+		return err
+	}
+	rawParams.Query = query
+	return nil
+}
+
+// depthLimit rejects operations that nest fields more deeply than the limit
+type depthLimit struct {
+	limit int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit{}
+
+func (depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (depthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth := selectionDepth(rc.Operation.SelectionSet)
+	if depth > d.limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.limit)
+		errcode.Set(err, "DEPTH_LIMIT_EXCEEDED")
+		return err
+	}
+	return nil
+}
+
+// selectionDepth is how deeply fields nest in a selection set, looking through fragments.
+// Introspection fields don't count; the schema bounds how deep they go.
+func selectionDepth(selectionSet ast.SelectionSet) int {
+	max := 0
+	for _, selection := range selectionSet {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			depth = selectionDepth(s.Definition.SelectionSet)
+		}
+		if depth > max {
+			max = depth
+		}
+	}
+	return max
+}
+
+// operationLogger logs each operation's name, complexity and latency.
+// Subscriptions log each event they send, timed from when they started.
+type operationLogger struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = operationLogger{}
+
+func (operationLogger) ExtensionName() string {
+	return "OperationLogger"
+}
+
+func (operationLogger) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (operationLogger) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+
+	rc := graphql.GetOperationContext(ctx)
+	fields := []zap.Field{
+		zap.String("operationName", rc.OperationName),
+		zap.Duration("latency", graphql.Now().Sub(rc.Stats.OperationStart)),
+		zap.Int("errors", len(resp.Errors)),
+	}
+	if rc.Operation != nil {
+		fields = append(fields, zap.String("operationType", string(rc.Operation.Operation)))
+	}
+	if stats := extension.GetComplexityStats(ctx); stats != nil {
+		fields = append(fields, zap.Int("complexity", stats.Complexity))
+	}
+	appcontext.ZLogger(ctx).Info("GraphQL operation", fields...)
+	return resp
+}
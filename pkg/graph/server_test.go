@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/introspection"
+
+	"github.com/cmsgov/easi-app/pkg/graph/generated"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// searchSchema resolves intake searches without a database
+func searchSchema() generated.Config {
+	service := ResolverService{
+		SearchSystemIntakes: func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			return &models.SystemIntakeSearchResult{
+				Results: []models.SystemIntake{},
+				Facets:  []models.SystemIntakeSearchFacetCounts{},
+			}, nil
+		},
+	}
+	return generated.Config{Resolvers: NewResolver(nil, service, nil), Directives: testDirectives}
+}
+
+const shallowSearch = `query { systemIntakeSearch(input: {first: 10}) { totalCount } }`
+
+const deepSearch = `query {
+	systemIntakeSearch(input: {first: 10}) {
+		results {
+			businessCase {
+				lifecycleCostLines {
+					cost
+				}
+			}
+		}
+	}
+}`
+
+func (s GraphQLTestSuite) TestServerLimits() {
+	s.Run("runs operations within the limits", func() {
+		srv := NewServer(generated.NewExecutableSchema(searchSchema()), ServerConfig{ComplexityLimit: 10, DepthLimit: 5})
+		var resp map[string]interface{}
+
+		s.NoError(client.New(srv).Post(deepSearch, &resp))
+	})
+
+	s.Run("rejects operations nested too deeply", func() {
+		srv := NewServer(generated.NewExecutableSchema(searchSchema()), ServerConfig{ComplexityLimit: 10, DepthLimit: 4})
+		var resp map[string]interface{}
+
+		err := client.New(srv).Post(deepSearch, &resp)
+		s.Error(err)
+		s.Contains(err.Error(), "DEPTH_LIMIT_EXCEEDED")
+	})
+
+	s.Run("rejects operations that are too complex", func() {
+		srv := NewServer(generated.NewExecutableSchema(searchSchema()), ServerConfig{ComplexityLimit: 3, DepthLimit: 5})
+		var resp map[string]interface{}
+
+		err := client.New(srv).Post(deepSearch, &resp)
+		s.Error(err)
+		s.Contains(err.Error(), "COMPLEXITY_LIMIT_EXCEEDED")
+	})
+
+	s.Run("the default limits allow introspection", func() {
+		config := ServerConfig{ComplexityLimit: DefaultComplexityLimit, DepthLimit: DefaultDepthLimit}
+		srv := NewServer(generated.NewExecutableSchema(searchSchema()), config)
+		var resp map[string]interface{}
+
+		s.NoError(client.New(srv).Post(introspection.Query, &resp))
+	})
+}
+
+// postParams posts raw GraphQL params, which the client can't send with extensions
+func postParams(srv http.Handler, params map[string]interface{}) map[string]interface{} {
+	body, _ := json.Marshal(params)
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	srv.ServeHTTP(rr, req)
+
+	resp := map[string]interface{}{}
+	_ = json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp
+}
+
+func (s GraphQLTestSuite) TestPersistedQueryAllowlist() {
+	config := ServerConfig{
+		ComplexityLimit:  DefaultComplexityLimit,
+		DepthLimit:       DefaultDepthLimit,
+		PersistedQueries: map[string]string{queryHash(shallowSearch): shallowSearch},
+	}
+	srv := NewServer(generated.NewExecutableSchema(searchSchema()), config)
+
+	s.Run("runs a persisted query sent whole", func() {
+		resp := postParams(srv, map[string]interface{}{"query": shallowSearch})
+		s.Nil(resp["errors"])
+		s.NotNil(resp["data"])
+	})
+
+	s.Run("runs a persisted query sent by its hash", func() {
+		resp := postParams(srv, map[string]interface{}{
+			"extensions": map[string]interface{}{
+				"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": queryHash(shallowSearch)},
+			},
+		})
+		s.Nil(resp["errors"])
+		s.NotNil(resp["data"])
+	})
+
+	s.Run("rejects a query that isn't persisted", func() {
+		resp := postParams(srv, map[string]interface{}{"query": deepSearch})
+		s.Contains(resp["errors"].([]interface{})[0].(map[string]interface{})["message"], "not persisted")
+	})
+
+	s.Run("rejects an unknown hash", func() {
+		resp := postParams(srv, map[string]interface{}{
+			"extensions": map[string]interface{}{
+				"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": queryHash(deepSearch)},
+			},
+		})
+		s.Equal("PersistedQueryNotFound", resp["errors"].([]interface{})[0].(map[string]interface{})["message"])
+	})
+}
+
+func (s GraphQLTestSuite) TestLoadPersistedQueries() {
+	dir, err := ioutil.TempDir("", "persisted-queries")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	s.Run("loads queries keyed by their hash", func() {
+		path := filepath.Join(dir, "valid.json")
+		contents, _ := json.Marshal(map[string]string{queryHash(shallowSearch): shallowSearch})
+		s.NoError(ioutil.WriteFile(path, contents, 0600))
+
+		queries, err := LoadPersistedQueries(path)
+		s.NoError(err)
+		s.Equal(shallowSearch, queries[queryHash(shallowSearch)])
+	})
+
+	s.Run("rejects a query under the wrong hash", func() {
+		path := filepath.Join(dir, "invalid.json")
+		contents, _ := json.Marshal(map[string]string{queryHash(shallowSearch): deepSearch})
+		s.NoError(ioutil.WriteFile(path, contents, 0600))
+
+		_, err := LoadPersistedQueries(path)
+		s.Error(err)
+	})
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/graph/model"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// applyUpdateSystemIntakeInput copies the fields given in an update onto an intake
+func applyUpdateSystemIntakeInput(intake *models.SystemIntake, input model.UpdateSystemIntakeInput) {
+	setString := func(field *null.String, value *string) {
+		if value != nil {
+			*field = null.StringFrom(*value)
+		}
+	}
+	setBool := func(field *null.Bool, value *bool) {
+		if value != nil {
+			*field = null.BoolFrom(*value)
+		}
+	}
+
+	if input.Requester != nil {
+		intake.Requester = *input.Requester
+	}
+	if input.RequestType != nil {
+		intake.RequestType = *input.RequestType
+	}
+	setString(&intake.BusinessNeed, input.BusinessNeed)
+	setString(&intake.BusinessOwner, input.BusinessOwner)
+	setString(&intake.BusinessOwnerComponent, input.BusinessOwnerComponent)
+	setString(&intake.Component, input.Component)
+	setString(&intake.ContractEndMonth, input.ContractEndMonth)
+	setString(&intake.ContractEndYear, input.ContractEndYear)
+	setString(&intake.Contractor, input.Contractor)
+	setString(&intake.ContractStartMonth, input.ContractStartMonth)
+	setString(&intake.ContractStartYear, input.ContractStartYear)
+	setString(&intake.ContractVehicle, input.ContractVehicle)
+	setString(&intake.CostIncrease, input.CostIncrease)
+	setString(&intake.CostIncreaseAmount, input.CostIncreaseAmount)
+	setString(&intake.EACollaborator, input.EaCollaborator)
+	setString(&intake.EACollaboratorName, input.EaCollaboratorName)
+	setBool(&intake.EASupportRequest, input.EaSupportRequest)
+	setString(&intake.ExistingContract, input.ExistingContract)
+	setBool(&intake.ExistingFunding, input.ExistingFunding)
+	setString(&intake.FundingNumber, input.FundingNumber)
+	setString(&intake.FundingSource, input.FundingSource)
+	setString(&intake.ISSO, input.Isso)
+	setString(&intake.ISSOName, input.IssoName)
+	setString(&intake.OITSecurityCollaborator, input.OitSecurityCollaborator)
+	setString(&intake.OITSecurityCollaboratorName, input.OitSecurityCollaboratorName)
+	setString(&intake.ProcessStatus, input.ProcessStatus)
+	setString(&intake.ProductManager, input.ProductManager)
+	setString(&intake.ProductManagerComponent, input.ProductManagerComponent)
+	setString(&intake.ProjectAcronym, input.ProjectAcronym)
+	setString(&intake.ProjectName, input.ProjectName)
+	setString(&intake.Solution, input.Solution)
+	setString(&intake.TRBCollaborator, input.TrbCollaborator)
+	setString(&intake.TRBCollaboratorName, input.TrbCollaboratorName)
+	if input.Version != nil {
+		intake.Version = *input.Version
+	}
+}
+
+// emailRecipientsFromInput reads who to email about an action, defaulting to no one
+func emailRecipientsFromInput(input *model.EmailRecipientsInput) models.EmailRecipients {
+	if input == nil {
+		return models.EmailRecipients{}
+	}
+	return models.EmailRecipients{
+		NotifyRequester: input.NotifyRequester,
+		NotifyGRT:       input.NotifyGrt,
+		ContactEmails:   input.ContactEmails,
+	}
+}
+
+// takeAction takes an action on an intake and returns the intake it left
+func (r *Resolver) takeAction(ctx context.Context, action *models.Action) (*model.UpdateSystemIntakePayload, error) {
+	if err := r.service.TakeAction(ctx, action); err != nil {
+		return systemIntakePayload(nil, err)
+	}
+	return systemIntakePayload(r.service.FetchSystemIntakeByID(ctx, *action.IntakeID))
+}
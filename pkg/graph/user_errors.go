@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/graph/model"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// userErrorsFrom turns a validation error into user errors, so mutations can return them in their payload.
+// Any other error is returned as is.
+func userErrorsFrom(err error) ([]*model.UserError, error) {
+	var valErr *apperrors.ValidationError
+	if !errors.As(err, &valErr) {
+		return nil, err
+	}
+
+	keys := []string{}
+	for key := range valErr.Validations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	userErrors := []*model.UserError{}
+	for _, key := range keys {
+		userErrors = append(userErrors, &model.UserError{
+			Message: valErr.Validations[key],
+			Path:    strings.Split(key, "."),
+		})
+	}
+	return userErrors, nil
+}
+
+// validateRequired returns a validation error naming the input fields that are blank
+func validateRequired(resource interface{}, fields map[string]string) error {
+	valErr := apperrors.NewValidationError(errors.New("input failed validation"), resource, "")
+	for key, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			valErr.WithValidation("input."+key, "is required")
+		}
+	}
+	if len(valErr.Validations) > 0 {
+		return &valErr
+	}
+	return nil
+}
+
+// systemIntakePayload is the result of a mutation on a system intake,
+// with any validation error returned as user errors
+func systemIntakePayload(intake *models.SystemIntake, err error) (*model.UpdateSystemIntakePayload, error) {
+	if err != nil {
+		userErrors, err := userErrorsFrom(err)
+		if err != nil {
+			return nil, err
+		}
+		return &model.UpdateSystemIntakePayload{UserErrors: userErrors}, nil
+	}
+	return &model.UpdateSystemIntakePayload{SystemIntake: intake}, nil
+}
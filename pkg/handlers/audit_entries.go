@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchAuditEntries func(context.Context, models.AuditEntity, uuid.UUID) ([]models.AuditEntry, error)
+
+// NewAuditEntriesHandler is a constructor for AuditEntriesHandler
+func NewAuditEntriesHandler(base HandlerBase, fetch fetchAuditEntries) AuditEntriesHandler {
+	return AuditEntriesHandler{
+		HandlerBase:       base,
+		FetchAuditEntries: fetch,
+	}
+}
+
+// AuditEntriesHandler is the handler for the history of changes to system intakes and business cases
+type AuditEntriesHandler struct {
+	HandlerBase
+	FetchAuditEntries fetchAuditEntries
+}
+
+// Handle handles a request for the history of the entity identified by the idVar path variable
+func (h AuditEntriesHandler) Handle(entity models.AuditEntity, idVar string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			id, err := uuid.Parse(mux.Vars(r)[idVar])
+			if err != nil {
+				valErr := apperrors.NewValidationError(
+					errors.New("audit entries failed validation"),
+					models.AuditEntry{},
+					"",
+				)
+				valErr.WithValidation("path."+idVar, "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			entries, err := h.FetchAuditEntries(r.Context(), entity, id)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(entries)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestAuditEntriesHandler() {
+	id := uuid.New()
+	fetch := func(_ context.Context, entity models.AuditEntity, entityID uuid.UUID) ([]models.AuditEntry, error) {
+		return []models.AuditEntry{{ID: uuid.New(), Entity: entity, EntityID: entityID}}, nil
+	}
+
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/business_case/%s/history", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		NewAuditEntriesHandler(s.base, fetch).Handle(models.AuditEntityBusinessCase, "business_case_id")(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		entries := []models.AuditEntry{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &entries))
+		s.Len(entries, 1)
+		s.Equal(models.AuditEntityBusinessCase, entries[0].Entity)
+		s.Equal(id, entries[0].EntityID)
+	})
+
+	s.Run("GET fails with an invalid ID", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intake/fake/history", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": "fake"})
+		NewAuditEntriesHandler(s.base, fetch).Handle(models.AuditEntitySystemIntake, "intake_id")(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("GET fails if the service fails", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/system_intake/%s/history", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": id.String()})
+		failFetch := func(context.Context, models.AuditEntity, uuid.UUID) ([]models.AuditEntry, error) {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("unauthorized")}
+		}
+		NewAuditEntriesHandler(s.base, failFetch).Handle(models.AuditEntitySystemIntake, "intake_id")(rr, req)
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+}
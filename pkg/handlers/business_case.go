@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -18,6 +19,7 @@ import (
 type fetchBusinessCaseByID func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error)
 type createBusinessCase func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error)
 type updateBusinessCase func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error)
+type summarizeLifecycleCosts func(lines models.EstimatedLifecycleCosts, discountRate *float64) (*models.LifecycleCostSummary, error)
 
 // NewBusinessCaseHandler is a constructor for BusinessCaseHandler
 func NewBusinessCaseHandler(
@@ -25,21 +27,49 @@ func NewBusinessCaseHandler(
 	fetch fetchBusinessCaseByID,
 	create createBusinessCase,
 	update updateBusinessCase,
+	summarize summarizeLifecycleCosts,
 ) BusinessCaseHandler {
 	return BusinessCaseHandler{
-		HandlerBase:           base,
-		FetchBusinessCaseByID: fetch,
-		CreateBusinessCase:    create,
-		UpdateBusinessCase:    update,
+		HandlerBase:             base,
+		FetchBusinessCaseByID:   fetch,
+		CreateBusinessCase:      create,
+		UpdateBusinessCase:      update,
+		SummarizeLifecycleCosts: summarize,
 	}
 }
 
 // BusinessCaseHandler is the handler for CRUD operations on business case
 type BusinessCaseHandler struct {
 	HandlerBase
-	FetchBusinessCaseByID fetchBusinessCaseByID
-	CreateBusinessCase    createBusinessCase
-	UpdateBusinessCase    updateBusinessCase
+	FetchBusinessCaseByID   fetchBusinessCaseByID
+	CreateBusinessCase      createBusinessCase
+	UpdateBusinessCase      updateBusinessCase
+	SummarizeLifecycleCosts summarizeLifecycleCosts
+}
+
+// businessCaseResponse is a business case with the totals of its lifecycle costs
+type businessCaseResponse struct {
+	*models.BusinessCase
+	LifecycleCostSummary *models.LifecycleCostSummary `json:"lifecycleCostSummary"`
+}
+
+// optionalDiscountRate is the discountRate query parameter, if there is one
+func optionalDiscountRate(r *http.Request) (*float64, error) {
+	param := r.URL.Query().Get("discountRate")
+	if param == "" {
+		return nil, nil
+	}
+	discountRate, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		valErr := apperrors.NewValidationError(
+			errors.New("business case failed validation"),
+			models.BusinessCase{},
+			"",
+		)
+		valErr.WithValidation("query.discountRate", "must be a number")
+		return nil, &valErr
+	}
+	return &discountRate, nil
 }
 
 func requireBusinessCaseID(reqVars map[string]string) (uuid.UUID, error) {
@@ -72,18 +102,34 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			discountRate, err := optionalDiscountRate(r)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
 			businessCase, err := h.FetchBusinessCaseByID(r.Context(), businessCaseID)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
 				return
 			}
 
-			responseBody, err := json.Marshal(businessCase)
+			summary, err := h.SummarizeLifecycleCosts(businessCase.LifecycleCostLines, discountRate)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(businessCaseResponse{
+				BusinessCase:         businessCase,
+				LifecycleCostSummary: summary,
+			})
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
 				return
 			}
 
+			writeVersionETag(w, businessCase.Version)
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -133,6 +179,7 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			writeVersionETag(w, businessCase.Version)
 			w.WriteHeader(http.StatusCreated)
 			_, err = w.Write(responseBody)
 			if err != nil {
@@ -172,11 +219,18 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 			}
 			businessCaseToUpdate.EUAUserID = principal.ID()
 
+			businessCaseToUpdate.Version, err = requiredVersion(r, businessCaseToUpdate.Version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
 			updatedBusinessCase, err := h.UpdateBusinessCase(r.Context(), &businessCaseToUpdate)
 			if err != nil {
 				h.Logger.Error(fmt.Sprintf("Failed to update business case to response: %v", err))
 
 				h.WriteErrorResponse(r.Context(), w, err)
+				return
 			}
 
 			responseBody, err := json.Marshal(updatedBusinessCase)
@@ -185,6 +239,7 @@ func (h BusinessCaseHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			writeVersionETag(w, updatedBusinessCase.Version)
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
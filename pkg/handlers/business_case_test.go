@@ -42,6 +42,12 @@ func newMockUpdateBusinessCase(err error) func(context context.Context, business
 	}
 }
 
+func newMockSummarizeLifecycleCosts() summarizeLifecycleCosts {
+	return func(lines models.EstimatedLifecycleCosts, discountRate *float64) (*models.LifecycleCostSummary, error) {
+		return &models.LifecycleCostSummary{DiscountRate: discountRate}, nil
+	}
+}
+
 func (s HandlerTestSuite) TestBusinessCaseHandler() {
 	requestContext := context.Background()
 	requestContext = appcontext.WithPrincipal(requestContext, &authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true})
@@ -53,11 +59,50 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 		s.NoError(err)
 		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
 		BusinessCaseHandler{
-			HandlerBase:           s.base,
-			FetchBusinessCaseByID: newMockFetchBusinessCaseByID(nil),
-			CreateBusinessCase:    nil,
+			HandlerBase:             s.base,
+			FetchBusinessCaseByID:   newMockFetchBusinessCaseByID(nil),
+			CreateBusinessCase:      nil,
+			SummarizeLifecycleCosts: newMockSummarizeLifecycleCosts(),
 		}.Handle()(rr, req)
 		s.Equal(http.StatusOK, rr.Code)
+		response := map[string]interface{}{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+		s.Equal(id.String(), response["id"])
+		s.NotNil(response["lifecycleCostSummary"])
+	})
+
+	s.Run("GET passes the discount rate to the lifecycle cost summary", func() {
+		var discountRate *float64
+		summarize := func(_ models.EstimatedLifecycleCosts, rate *float64) (*models.LifecycleCostSummary, error) {
+			discountRate = rate
+			return &models.LifecycleCostSummary{DiscountRate: rate}, nil
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "GET", fmt.Sprintf("/business_case/%s?discountRate=0.07", id.String()), bytes.NewBufferString(""))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		BusinessCaseHandler{
+			HandlerBase:             s.base,
+			FetchBusinessCaseByID:   newMockFetchBusinessCaseByID(nil),
+			SummarizeLifecycleCosts: summarize,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(0.07, *discountRate)
+	})
+
+	s.Run("GET returns an error if the discount rate is not a number", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "GET", fmt.Sprintf("/business_case/%s?discountRate=seven", id.String()), bytes.NewBufferString(""))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		BusinessCaseHandler{
+			HandlerBase:             s.base,
+			FetchBusinessCaseByID:   newMockFetchBusinessCaseByID(nil),
+			SummarizeLifecycleCosts: newMockSummarizeLifecycleCosts(),
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
 	})
 
 	s.Run("GET returns an error if the uuid is not valid", func() {
@@ -167,8 +212,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 
 	s.Run("golden path PUT passes", func() {
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
@@ -183,6 +229,22 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 		s.Equal(http.StatusOK, rr.Code)
 	})
 
+	s.Run("PUT fails without the version it replaces", func() {
+		rr := httptest.NewRecorder()
+		body, err := json.Marshal(map[string]string{
+			"requesterPhoneNumber": "1234567890",
+		})
+		s.NoError(err)
+		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		BusinessCaseHandler{
+			HandlerBase:        s.base,
+			UpdateBusinessCase: newMockUpdateBusinessCase(nil),
+		}.Handle()(rr, req)
+		s.Equal(http.StatusPreconditionRequired, rr.Code)
+	})
+
 	s.Run("returns an error if the body is empty", func() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBufferString(""))
@@ -199,8 +261,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 
 	s.Run("returns an error if there is no id in the url", func() {
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", "3"), bytes.NewBuffer(body))
@@ -217,8 +280,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 	s.Run("PUT fails if there is no eua ID in the context", func() {
 		badContext := context.Background()
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(badContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
@@ -236,8 +300,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 
 	s.Run("returns an error if there updating fails with a validation error", func() {
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
@@ -255,8 +320,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 
 	s.Run("returns an error if there updating fails with a resource conflict error", func() {
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
@@ -274,8 +340,9 @@ func (s HandlerTestSuite) TestBusinessCaseHandler() {
 
 	s.Run("returns an error if there updating fails in another way", func() {
 		rr := httptest.NewRecorder()
-		body, err := json.Marshal(map[string]string{
+		body, err := json.Marshal(map[string]interface{}{
 			"requesterPhoneNumber": "1234567890",
+			"version":              1,
 		})
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", fmt.Sprintf("/business_case/%s", id.String()), bytes.NewBuffer(body))
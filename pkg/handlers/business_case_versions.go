@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchBusinessCaseVersions func(context.Context, uuid.UUID) ([]models.BusinessCaseVersion, error)
+type fetchBusinessCaseVersionDiff func(context.Context, uuid.UUID, int, int) (*models.BusinessCaseVersionDiff, error)
+
+// NewBusinessCaseVersionsHandler is a constructor for BusinessCaseVersionsHandler
+func NewBusinessCaseVersionsHandler(
+	base HandlerBase,
+	fetchVersions fetchBusinessCaseVersions,
+	fetchDiff fetchBusinessCaseVersionDiff,
+) BusinessCaseVersionsHandler {
+	return BusinessCaseVersionsHandler{
+		HandlerBase:                  base,
+		FetchBusinessCaseVersions:    fetchVersions,
+		FetchBusinessCaseVersionDiff: fetchDiff,
+	}
+}
+
+// BusinessCaseVersionsHandler is the handler for the submitted versions of a business case
+type BusinessCaseVersionsHandler struct {
+	HandlerBase
+	FetchBusinessCaseVersions    fetchBusinessCaseVersions
+	FetchBusinessCaseVersionDiff fetchBusinessCaseVersionDiff
+}
+
+// Handle handles a request for every submitted version of a business case
+func (h BusinessCaseVersionsHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			businessCaseID, err := requireBusinessCaseID(mux.Vars(r))
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			versions, err := h.FetchBusinessCaseVersions(r.Context(), businessCaseID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			h.writeJSON(w, r, versions)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+// HandleDiff handles a request for what changed in a business case
+// between the versions numbered by the from and to query parameters
+func (h BusinessCaseVersionsHandler) HandleDiff() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			businessCaseID, err := requireBusinessCaseID(mux.Vars(r))
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			valErr := apperrors.NewValidationError(
+				errors.New("business case version diff failed validation"),
+				models.BusinessCaseVersionDiff{},
+				businessCaseID.String(),
+			)
+			numbers := map[string]int{}
+			for _, param := range []string{"from", "to"} {
+				number, err := strconv.Atoi(r.URL.Query().Get(param))
+				if err != nil || number < 1 {
+					valErr.WithValidation("query."+param, "must be a version number")
+				}
+				numbers[param] = number
+			}
+			if len(valErr.Validations) > 0 {
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			diff, err := h.FetchBusinessCaseVersionDiff(r.Context(), businessCaseID, numbers["from"], numbers["to"])
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			h.writeJSON(w, r, diff)
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+func (h BusinessCaseVersionsHandler) writeJSON(w http.ResponseWriter, r *http.Request, body interface{}) {
+	js, err := json.Marshal(body)
+	if err != nil {
+		h.WriteErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	_, err = w.Write(js)
+	if err != nil {
+		h.WriteErrorResponse(r.Context(), w, err)
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestBusinessCaseVersionsHandler() {
+	id := uuid.New()
+	fetchVersions := func(_ context.Context, businessCaseID uuid.UUID) ([]models.BusinessCaseVersion, error) {
+		return []models.BusinessCaseVersion{{BusinessCaseID: businessCaseID, Number: 1}}, nil
+	}
+	fetchDiff := func(_ context.Context, businessCaseID uuid.UUID, from int, to int) (*models.BusinessCaseVersionDiff, error) {
+		if to > 2 {
+			return nil, &apperrors.ResourceNotFoundError{Resource: models.BusinessCaseVersion{}}
+		}
+		return &models.BusinessCaseVersionDiff{From: from, To: to}, nil
+	}
+	handler := NewBusinessCaseVersionsHandler(s.base, fetchVersions, fetchDiff)
+
+	s.Run("golden path GET versions passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/business_case/%s/versions", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		handler.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		versions := []models.BusinessCaseVersion{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &versions))
+		s.Len(versions, 1)
+		s.Equal(id, versions[0].BusinessCaseID)
+	})
+
+	s.Run("golden path GET diff passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/business_case/%s/versions/diff?from=1&to=2", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		handler.HandleDiff()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		diff := models.BusinessCaseVersionDiff{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &diff))
+		s.Equal(1, diff.From)
+		s.Equal(2, diff.To)
+	})
+
+	s.Run("GET diff fails without version numbers", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/business_case/%s/versions/diff?from=first", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		handler.HandleDiff()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+		responseErr := errorResponse{}
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &responseErr))
+		s.Len(responseErr.Errors, 2)
+	})
+
+	s.Run("GET diff fails if a version doesn't exist", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/business_case/%s/versions/diff?from=1&to=3", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"business_case_id": id.String()})
+		handler.HandleDiff()(rr, req)
+
+		s.Equal(http.StatusNotFound, rr.Code)
+	})
+}
@@ -12,21 +12,26 @@ import (
 
 type fetchBusinessCases func(context.Context, string) (models.BusinessCases, error)
 
+type fetchBusinessCasesPage func(context.Context, string, models.PageRequest) (*models.BusinessCasesPage, error)
+
 // NewBusinessCasesHandler is a constructor for BusinessCasesHandler
-func NewBusinessCasesHandler(base HandlerBase, fetch fetchBusinessCases) BusinessCasesHandler {
+func NewBusinessCasesHandler(base HandlerBase, fetch fetchBusinessCases, fetchPage fetchBusinessCasesPage) BusinessCasesHandler {
 	return BusinessCasesHandler{
-		HandlerBase:        base,
-		FetchBusinessCases: fetch,
+		HandlerBase:            base,
+		FetchBusinessCases:     fetch,
+		FetchBusinessCasesPage: fetchPage,
 	}
 }
 
 // BusinessCasesHandler is the handler for CRUD operations on business cases
 type BusinessCasesHandler struct {
 	HandlerBase
-	FetchBusinessCases fetchBusinessCases
+	FetchBusinessCases     fetchBusinessCases
+	FetchBusinessCasesPage fetchBusinessCasesPage
 }
 
-// Handle handles a request for System Intakes
+// Handle handles a request for System Intakes.
+// Requests with page parameters get a page of business cases, otherwise they get all of them.
 func (h BusinessCasesHandler) Handle() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
@@ -43,7 +48,18 @@ func (h BusinessCasesHandler) Handle() http.HandlerFunc {
 				return
 			}
 
-			businessCases, err := h.FetchBusinessCases(r.Context(), principal.ID())
+			pageRequest, paged, err := pageRequestFromQuery(r.URL.Query())
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			var businessCases interface{}
+			if paged {
+				businessCases, err = h.FetchBusinessCasesPage(r.Context(), principal.ID(), pageRequest)
+			} else {
+				businessCases, err = h.FetchBusinessCases(r.Context(), principal.ID())
+			}
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
 				return
@@ -20,6 +20,12 @@ func newMockFetchBusinessCases(businessCases models.BusinessCases, err error) fe
 	}
 }
 
+func newMockFetchBusinessCasesPage(page *models.BusinessCasesPage, err error) fetchBusinessCasesPage {
+	return func(ctx context.Context, euaID string, request models.PageRequest) (*models.BusinessCasesPage, error) {
+		return page, err
+	}
+}
+
 func (s HandlerTestSuite) TestBusinessCasesHandler() {
 	s.Run("golden path FETCH passes", func() {
 		rr := httptest.NewRecorder()
@@ -50,4 +56,26 @@ func (s HandlerTestSuite) TestBusinessCasesHandler() {
 		s.NoError(err)
 		s.Equal("Something went wrong", responseErr.Message)
 	})
+	s.Run("FETCH with page parameters returns a page", func() {
+		rr := httptest.NewRecorder()
+		requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "EUAID", JobCodeEASi: true})
+		req, err := http.NewRequestWithContext(requestContext, "GET", "/business_cases/?first=10", nil)
+		s.NoError(err)
+		BusinessCasesHandler{
+			FetchBusinessCases: newMockFetchBusinessCases(nil, fmt.Errorf("should not fetch every business case")),
+			FetchBusinessCasesPage: newMockFetchBusinessCasesPage(&models.BusinessCasesPage{
+				TotalCount: 0,
+				Results:    []models.BusinessCase{},
+			}, nil),
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		page := models.BusinessCasesPage{}
+		err = json.Unmarshal(rr.Body.Bytes(), &page)
+		s.NoError(err)
+		s.False(page.PageInfo.HasNextPage)
+		s.Nil(page.PageInfo.EndCursor)
+		s.Empty(page.Results)
+	})
 }
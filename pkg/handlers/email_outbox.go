@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchFailedOutboxEmails func(context.Context) (models.OutboxEmails, error)
+type redriveOutboxEmail func(context.Context, uuid.UUID) (*models.OutboxEmail, error)
+
+// NewEmailOutboxHandler is a constructor for EmailOutboxHandler
+func NewEmailOutboxHandler(base HandlerBase, fetch fetchFailedOutboxEmails) EmailOutboxHandler {
+	return EmailOutboxHandler{
+		HandlerBase:             base,
+		FetchFailedOutboxEmails: fetch,
+	}
+}
+
+// EmailOutboxHandler is the handler for listing emails that failed delivery
+type EmailOutboxHandler struct {
+	HandlerBase
+	FetchFailedOutboxEmails fetchFailedOutboxEmails
+}
+
+// Handle handles a request for failed outbox emails
+func (h EmailOutboxHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			emails, err := h.FetchFailedOutboxEmails(r.Context())
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(emails)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+// NewEmailOutboxRedriveHandler is a constructor for EmailOutboxRedriveHandler
+func NewEmailOutboxRedriveHandler(base HandlerBase, redrive redriveOutboxEmail) EmailOutboxRedriveHandler {
+	return EmailOutboxRedriveHandler{
+		HandlerBase:        base,
+		RedriveOutboxEmail: redrive,
+	}
+}
+
+// EmailOutboxRedriveHandler is the handler for re-driving a failed email
+type EmailOutboxRedriveHandler struct {
+	HandlerBase
+	RedriveOutboxEmail redriveOutboxEmail
+}
+
+// Handle handles a request to re-drive an outbox email
+func (h EmailOutboxRedriveHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			valErr := apperrors.NewValidationError(
+				errors.New("email failed validation"),
+				models.OutboxEmail{},
+				"",
+			)
+			emailID, err := uuid.Parse(mux.Vars(r)["email_id"])
+			if err != nil {
+				valErr.WithValidation("path.emailID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			email, err := h.RedriveOutboxEmail(r.Context(), emailID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(email)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
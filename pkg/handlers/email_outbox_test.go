@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestEmailOutboxHandler() {
+	s.Run("golden path GET passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/email_outbox", nil)
+		s.NoError(err)
+		EmailOutboxHandler{
+			HandlerBase: s.base,
+			FetchFailedOutboxEmails: func(context.Context) (models.OutboxEmails, error) {
+				return models.OutboxEmails{}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+	})
+
+	s.Run("GET fails if the service fails", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/email_outbox", nil)
+		s.NoError(err)
+		EmailOutboxHandler{
+			HandlerBase: s.base,
+			FetchFailedOutboxEmails: func(context.Context) (models.OutboxEmails, error) {
+				return nil, &apperrors.UnauthorizedError{Err: errors.New("unauthorized")}
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func (s HandlerTestSuite) TestEmailOutboxRedriveHandler() {
+	id := uuid.New()
+	redrive := func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+		return &models.OutboxEmail{ID: id}, nil
+	}
+
+	s.Run("golden path POST passes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", fmt.Sprintf("/email_outbox/%s/redrive", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"email_id": id.String()})
+		EmailOutboxRedriveHandler{
+			HandlerBase:        s.base,
+			RedriveOutboxEmail: redrive,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+	})
+
+	s.Run("POST fails with an invalid email ID", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/email_outbox/fake/redrive", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"email_id": "fake"})
+		EmailOutboxRedriveHandler{
+			HandlerBase:        s.base,
+			RedriveOutboxEmail: redrive,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST fails if the email was already sent", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", fmt.Sprintf("/email_outbox/%s/redrive", id), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"email_id": id.String()})
+		EmailOutboxRedriveHandler{
+			HandlerBase: s.base,
+			RedriveOutboxEmail: func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+				return nil, &apperrors.ResourceConflictError{Err: errors.New("sent"), ResourceID: id.String()}
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusConflict, rr.Code)
+	})
+
+	s.Run("GET is not allowed", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/email_outbox/%s/redrive", id), nil)
+		s.NoError(err)
+		EmailOutboxRedriveHandler{
+			HandlerBase:        s.base,
+			RedriveOutboxEmail: redrive,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusMethodNotAllowed, rr.Code)
+	})
+}
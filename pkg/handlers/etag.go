@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+)
+
+// writeVersionETag tells the client which version of a resource it has,
+// so it can send the version back in If-Match when it updates the resource
+func writeVersionETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", fmt.Sprintf(`"%d"`, version))
+}
+
+// requiredVersion is the version of a resource a request expects to update,
+// from If-Match or else the version in the body.
+// An update that names neither would silently overwrite other people's changes, so it's refused.
+func requiredVersion(r *http.Request, bodyVersion int) (int, error) {
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		return 0, err
+	}
+	if version == 0 {
+		version = bodyVersion
+	}
+	if version < 1 {
+		return 0, &apperrors.PreconditionRequiredError{
+			Err: errors.New("update needs the version it replaces in If-Match or the body"),
+		}
+	}
+	return version, nil
+}
+
+// ifMatchVersion is the version of a resource a request expects to update,
+// or 0 if the request doesn't name one
+func ifMatchVersion(r *http.Request) (int, error) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+	if err != nil || version < 1 {
+		return 0, &apperrors.BadRequestError{Err: fmt.Errorf("If-Match %s is not a version", ifMatch)}
+	}
+	return version, nil
+}
@@ -94,6 +94,13 @@ func (b HandlerBase) WriteErrorResponse(ctx context.Context, w http.ResponseWrit
 				"Resource not found",
 				traceID,
 			)
+		case *apperrors.ResourceConflictError:
+			code = http.StatusConflict
+			response = newErrorResponse(
+				code,
+				"Resource conflict",
+				traceID,
+			)
 		default:
 			code = http.StatusInternalServerError
 			response = newErrorResponse(
@@ -159,6 +166,14 @@ func (b HandlerBase) WriteErrorResponse(ctx context.Context, w http.ResponseWrit
 			"Bad request",
 			traceID,
 		)
+	case *apperrors.PreconditionRequiredError:
+		logger.Info("Returning precondition required error from handler", zap.Error(appErr))
+		code = http.StatusPreconditionRequired
+		response = newErrorResponse(
+			code,
+			"Precondition required",
+			traceID,
+		)
 	case *apperrors.UnknownRouteError:
 		logger.Info("Returning status not found error from handler", zap.Error(appErr))
 		code = http.StatusNotFound
@@ -132,6 +132,16 @@ func (s HandlerTestSuite) TestWriteErrorResponse() {
 				TraceID: traceID,
 			},
 		},
+		{
+			&apperrors.PreconditionRequiredError{},
+			http.StatusPreconditionRequired,
+			errorResponse{
+				Errors:  []errorItem{},
+				Code:    http.StatusPreconditionRequired,
+				Message: "Precondition required",
+				TraceID: traceID,
+			},
+		},
 		{
 			&apperrors.UnknownRouteError{},
 			http.StatusNotFound,
@@ -152,6 +162,16 @@ func (s HandlerTestSuite) TestWriteErrorResponse() {
 				TraceID: traceID,
 			},
 		},
+		{
+			&apperrors.QueryError{Err: &apperrors.ResourceConflictError{}},
+			http.StatusConflict,
+			errorResponse{
+				Errors:  []errorItem{},
+				Code:    http.StatusConflict,
+				Message: "Resource conflict",
+				TraceID: traceID,
+			},
+		},
 		{
 			errors.New("unknown error"),
 			http.StatusInternalServerError,
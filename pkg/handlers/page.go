@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// pageRequestFromQuery reads the first, after, sortBy and direction query parameters.
+// paged is false when none are given, so the whole list should be returned as before.
+func pageRequestFromQuery(query url.Values) (request models.PageRequest, paged bool, err error) {
+	for _, param := range []string{"first", "after", "sortBy", "direction"} {
+		if _, ok := query[param]; ok {
+			paged = true
+		}
+	}
+	if !paged {
+		return request, false, nil
+	}
+
+	request.After = query.Get("after")
+	request.SortBy = models.SortField(strings.ToUpper(query.Get("sortBy")))
+	request.Direction = models.SortDirection(strings.ToUpper(query.Get("direction")))
+	request.First, err = strconv.Atoi(query.Get("first"))
+	if err != nil {
+		valErr := apperrors.NewValidationError(
+			errors.New("page request failed validation"),
+			models.PageRequest{},
+			"",
+		)
+		valErr.WithValidation("query.first", "must be a number")
+		return request, true, &valErr
+	}
+	return request, true, nil
+}
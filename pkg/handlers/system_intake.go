@@ -81,6 +81,7 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			writeVersionETag(w, intake.Version)
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -117,6 +118,7 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			writeVersionETag(w, createdIntake.Version)
 			w.WriteHeader(http.StatusCreated)
 			_, err = w.Write(responseBody)
 			if err != nil {
@@ -155,6 +157,12 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 			}
 			intake.EUAUserID = null.StringFrom(principal.ID())
 
+			intake.Version, err = requiredVersion(r, intake.Version)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
 			updatedIntake, err := h.UpdateSystemIntake(r.Context(), &intake)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -167,6 +175,7 @@ func (h SystemIntakeHandler) Handle() http.HandlerFunc {
 				return
 			}
 
+			writeVersionETag(w, updatedIntake.Version)
 			_, err = w.Write(responseBody)
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
@@ -226,11 +235,12 @@ type SystemIntakeLifecycleIDHandler struct {
 }
 
 type lcidFields struct {
-	LCID      *string `json:"lcid"`
-	ExpiresAt string  `json:"lcidExpiresAt"`
-	Scope     string  `json:"lcidScope"`
-	NextSteps string  `json:"lcidNextSteps"`
-	Feedback  string  `json:"feedback"`
+	LCID       *string                `json:"lcid"`
+	ExpiresAt  string                 `json:"lcidExpiresAt"`
+	Scope      string                 `json:"lcidScope"`
+	NextSteps  string                 `json:"lcidNextSteps"`
+	Feedback   string                 `json:"feedback"`
+	Recipients models.EmailRecipients `json:"recipients"`
 }
 
 // Handle handles a request for the system intake form
@@ -258,7 +268,7 @@ func (h SystemIntakeLifecycleIDHandler) Handle() http.HandlerFunc {
 			intake := &models.SystemIntake{
 				LifecycleID: null.StringFromPtr(fields.LCID),
 			}
-			action := &models.Action{}
+			action := &models.Action{EmailRecipients: fields.Recipients}
 
 			valFail := false
 			valErr := apperrors.NewValidationError(
@@ -365,9 +375,10 @@ type SystemIntakeRejectionHandler struct {
 }
 
 type rejectionFields struct {
-	Reason    string `json:"rejectionReason"`
-	NextSteps string `json:"rejectionNextSteps"`
-	Feedback  string
+	Reason     string `json:"rejectionReason"`
+	NextSteps  string `json:"rejectionNextSteps"`
+	Feedback   string
+	Recipients models.EmailRecipients `json:"recipients"`
 }
 
 func validateRejection(id string, data rejectionFields) (*uuid.UUID, error) {
@@ -446,8 +457,9 @@ func (h SystemIntakeRejectionHandler) Handle() http.HandlerFunc {
 				DecisionNextSteps: null.StringFrom(fields.NextSteps),
 			}
 			action := &models.Action{
-				Feedback: null.StringFrom(fields.Feedback),
-				IntakeID: uuid,
+				Feedback:        null.StringFrom(fields.Feedback),
+				IntakeID:        uuid,
+				EmailRecipients: fields.Recipients,
 			}
 
 			// send it to the database
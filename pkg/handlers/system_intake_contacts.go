@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type fetchSystemIntakeContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error)
+type createSystemIntakeContact func(context.Context, *models.SystemIntakeContact) (*models.SystemIntakeContact, error)
+type deleteSystemIntakeContact func(context.Context, uuid.UUID, uuid.UUID) error
+type fetchRelatedSystemIntakes func(context.Context) (models.SystemIntakes, error)
+
+// NewSystemIntakeContactsHandler is a constructor for SystemIntakeContactsHandler
+func NewSystemIntakeContactsHandler(
+	base HandlerBase,
+	fetch fetchSystemIntakeContacts,
+	create createSystemIntakeContact,
+	deleteContact deleteSystemIntakeContact,
+	fetchRelated fetchRelatedSystemIntakes,
+) SystemIntakeContactsHandler {
+	return SystemIntakeContactsHandler{
+		HandlerBase:               base,
+		FetchSystemIntakeContacts: fetch,
+		CreateSystemIntakeContact: create,
+		DeleteSystemIntakeContact: deleteContact,
+		FetchRelatedSystemIntakes: fetchRelated,
+	}
+}
+
+// SystemIntakeContactsHandler is the handler for the people who hold roles on a SystemIntake
+type SystemIntakeContactsHandler struct {
+	HandlerBase
+	FetchSystemIntakeContacts fetchSystemIntakeContacts
+	CreateSystemIntakeContact createSystemIntakeContact
+	DeleteSystemIntakeContact deleteSystemIntakeContact
+	FetchRelatedSystemIntakes fetchRelatedSystemIntakes
+}
+
+// Handle handles a request to list, add or remove the contacts of an intake
+func (h SystemIntakeContactsHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valErr := apperrors.NewValidationError(
+			errors.New("system intake contact failed validation"),
+			models.SystemIntakeContact{},
+			"",
+		)
+		intakeID, err := uuid.Parse(mux.Vars(r)["intake_id"])
+		if err != nil {
+			valErr.WithValidation("path.intakeID", "must be UUID")
+			h.WriteErrorResponse(r.Context(), w, &valErr)
+			return
+		}
+
+		switch r.Method {
+		case "GET":
+			contacts, err := h.FetchSystemIntakeContacts(r.Context(), intakeID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(contacts)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+		case "POST":
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			contact := models.SystemIntakeContact{}
+			err := json.NewDecoder(r.Body).Decode(&contact)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+			contact.SystemIntakeID = intakeID
+
+			createdContact, err := h.CreateSystemIntakeContact(r.Context(), &contact)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(createdContact)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+		case "DELETE":
+			contactID, err := uuid.Parse(mux.Vars(r)["contact_id"])
+			if err != nil {
+				valErr.WithValidation("path.contactID", "must be UUID")
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			err = h.DeleteSystemIntakeContact(r.Context(), intakeID, contactID)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
+
+// HandleRelated handles a request for the intakes where the user holds any contact role
+func (h SystemIntakeContactsHandler) HandleRelated() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			intakes, err := h.FetchRelatedSystemIntakes(r.Context())
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(intakes)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
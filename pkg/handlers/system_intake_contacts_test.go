@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSystemIntakeContactsHandler() {
+	intakeID := uuid.New()
+	contactID := uuid.New()
+	path := fmt.Sprintf("/system_intake/%s/contacts", intakeID)
+
+	s.Run("golden path GET returns the contacts", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", path, nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": intakeID.String()})
+
+		SystemIntakeContactsHandler{
+			HandlerBase: s.base,
+			FetchSystemIntakeContacts: func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+				return []models.SystemIntakeContact{{ID: contactID, Role: models.SystemIntakeContactRoleISSO, EUAUserID: "ABCD"}}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var contacts []models.SystemIntakeContact
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &contacts))
+		s.Len(contacts, 1)
+		s.Equal("ABCD", contacts[0].EUAUserID)
+	})
+
+	s.Run("GET fails with an intake ID that isn't a UUID", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intake/NOT_A_UUID/contacts", nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": "NOT_A_UUID"})
+
+		SystemIntakeContactsHandler{HandlerBase: s.base}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("golden path POST adds the contact to the intake", func() {
+		body, err := json.Marshal(map[string]string{"role": "BUSINESS_OWNER", "euaUserId": "ABCD"})
+		s.NoError(err)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": intakeID.String()})
+		var created *models.SystemIntakeContact
+
+		SystemIntakeContactsHandler{
+			HandlerBase: s.base,
+			CreateSystemIntakeContact: func(_ context.Context, contact *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+				created = contact
+				return contact, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusCreated, rr.Code)
+		s.Equal(intakeID, created.SystemIntakeID)
+		s.Equal(models.SystemIntakeContactRoleBUSINESSOWNER, created.Role)
+	})
+
+	s.Run("POST fails when the person already holds the role", func() {
+		body, err := json.Marshal(map[string]string{"role": "ISSO", "euaUserId": "ABCD"})
+		s.NoError(err)
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": intakeID.String()})
+
+		SystemIntakeContactsHandler{
+			HandlerBase: s.base,
+			CreateSystemIntakeContact: func(context.Context, *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+				return nil, &apperrors.ResourceConflictError{Err: errors.New("already a contact")}
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusConflict, rr.Code)
+	})
+
+	s.Run("golden path DELETE removes the contact", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s", path, contactID), nil)
+		s.NoError(err)
+		req = mux.SetURLVars(req, map[string]string{"intake_id": intakeID.String(), "contact_id": contactID.String()})
+		deleted := uuid.Nil
+
+		SystemIntakeContactsHandler{
+			HandlerBase: s.base,
+			DeleteSystemIntakeContact: func(_ context.Context, _ uuid.UUID, id uuid.UUID) error {
+				deleted = id
+				return nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusNoContent, rr.Code)
+		s.Equal(contactID, deleted)
+	})
+
+	s.Run("golden path GET related returns the intakes", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intakes/related", nil)
+		s.NoError(err)
+
+		SystemIntakeContactsHandler{
+			HandlerBase: s.base,
+			FetchRelatedSystemIntakes: func(context.Context) (models.SystemIntakes, error) {
+				return models.SystemIntakes{{ID: intakeID}}, nil
+			},
+		}.HandleRelated()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		var intakes []models.SystemIntake
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &intakes))
+		s.Len(intakes, 1)
+		s.Equal(intakeID, intakes[0].ID)
+	})
+}
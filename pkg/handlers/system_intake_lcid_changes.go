@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewSystemIntakeLCIDChangeHandler is a constructor for how we handle
+// extending, amending or retiring an issued LifecycleID
+func NewSystemIntakeLCIDChangeHandler(
+	base HandlerBase,
+	change func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error),
+) SystemIntakeLCIDChangeHandler {
+	return SystemIntakeLCIDChangeHandler{
+		HandlerBase:       base,
+		ChangeLifecycleID: change,
+	}
+}
+
+// SystemIntakeLCIDChangeHandler is the handler for changing
+// the LifecycleID issued to a SystemIntake
+type SystemIntakeLCIDChangeHandler struct {
+	HandlerBase
+	ChangeLifecycleID func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
+}
+
+// lcidChangeFields are the fields for any LCID change;
+// the service checks the ones its change needs
+type lcidChangeFields struct {
+	ExpiresAt        string                 `json:"lcidExpiresAt"`
+	Scope            string                 `json:"lcidScope"`
+	NextSteps        string                 `json:"lcidNextSteps"`
+	RetirementReason string                 `json:"lcidRetirementReason"`
+	Feedback         string                 `json:"feedback"`
+	Recipients       models.EmailRecipients `json:"recipients"`
+}
+
+// Handle handles a request to change a LifecycleID
+func (h SystemIntakeLCIDChangeHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			if r.Body == nil {
+				h.WriteErrorResponse(
+					r.Context(),
+					w,
+					&apperrors.BadRequestError{Err: errors.New("empty request not allowed")},
+				)
+				return
+			}
+			defer r.Body.Close()
+
+			fields := lcidChangeFields{}
+			if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+				h.WriteErrorResponse(r.Context(), w, &apperrors.BadRequestError{Err: err})
+				return
+			}
+
+			intake := &models.SystemIntake{
+				LifecycleScope:            null.NewString(fields.Scope, fields.Scope != ""),
+				DecisionNextSteps:         null.NewString(fields.NextSteps, fields.NextSteps != ""),
+				LifecycleRetirementReason: null.NewString(fields.RetirementReason, fields.RetirementReason != ""),
+			}
+			action := &models.Action{
+				Feedback:        null.NewString(fields.Feedback, fields.Feedback != ""),
+				EmailRecipients: fields.Recipients,
+			}
+
+			valErr := apperrors.NewValidationError(
+				errors.New("system intake lifecycle change failed validation"),
+				models.SystemIntake{},
+				"",
+			)
+			id, err := uuid.Parse(mux.Vars(r)["intake_id"])
+			if err != nil {
+				valErr.WithValidation("path.intakeID", "must be UUID")
+			} else {
+				intake.ID = id
+			}
+			if fields.ExpiresAt != "" {
+				exp, tErr := time.Parse("2006-1-2", fields.ExpiresAt)
+				if tErr != nil {
+					valErr.WithValidation("body.lcidExpiresAt", tErr.Error())
+				} else {
+					intake.LifecycleExpiresAt = &exp
+				}
+			}
+			if len(valErr.Validations) > 0 {
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			updatedIntake, err := h.ChangeLifecycleID(r.Context(), intake, action)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			responseBody, err := json.Marshal(updatedIntake)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			_, err = w.Write(responseBody)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+			return
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
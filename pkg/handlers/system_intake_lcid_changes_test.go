@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSystemIntakeLCIDChangeHandler() {
+	id := uuid.New()
+	requestURL := fmt.Sprintf("/system_intake/%s/lcid/extend", id)
+
+	newRequest := func(body map[string]interface{}, intakeID string) *http.Request {
+		payload, err := json.Marshal(body)
+		s.NoError(err)
+		req, err := http.NewRequest("POST", requestURL, bytes.NewBuffer(payload))
+		s.NoError(err)
+		return mux.SetURLVars(req, map[string]string{"intake_id": intakeID})
+	}
+
+	s.Run("golden path POST passes the fields to the service", func() {
+		rr := httptest.NewRecorder()
+		var gotIntake *models.SystemIntake
+		var gotAction *models.Action
+		req := newRequest(map[string]interface{}{
+			"lcidExpiresAt": "2022-12-25",
+			"lcidScope":     "scope",
+			"feedback":      "feedback",
+		}, id.String())
+
+		SystemIntakeLCIDChangeHandler{
+			HandlerBase: s.base,
+			ChangeLifecycleID: func(_ context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+				gotIntake = intake
+				gotAction = action
+				return intake, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(id, gotIntake.ID)
+		s.Equal(time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC), *gotIntake.LifecycleExpiresAt)
+		s.Equal("scope", gotIntake.LifecycleScope.String)
+		s.False(gotIntake.DecisionNextSteps.Valid)
+		s.Equal("feedback", gotAction.Feedback.String)
+	})
+
+	s.Run("POST fails with a bad expiration date", func() {
+		rr := httptest.NewRecorder()
+		req := newRequest(map[string]interface{}{"lcidExpiresAt": "December"}, id.String())
+
+		SystemIntakeLCIDChangeHandler{
+			HandlerBase: s.base,
+			ChangeLifecycleID: func(_ context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+				return intake, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST fails with a bad intake ID", func() {
+		rr := httptest.NewRecorder()
+		req := newRequest(map[string]interface{}{"lcidRetirementReason": "reason"}, "fake")
+
+		SystemIntakeLCIDChangeHandler{
+			HandlerBase: s.base,
+			ChangeLifecycleID: func(_ context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+				return intake, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("POST fails if the LCID can't be changed", func() {
+		rr := httptest.NewRecorder()
+		req := newRequest(map[string]interface{}{"lcidRetirementReason": "reason"}, id.String())
+
+		SystemIntakeLCIDChangeHandler{
+			HandlerBase: s.base,
+			ChangeLifecycleID: func(_ context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+				return nil, &apperrors.ResourceConflictError{
+					Err:        errors.New("lifecycle id has not been issued"),
+					Resource:   models.SystemIntake{},
+					ResourceID: id.String(),
+				}
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusConflict, rr.Code)
+	})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type searchSystemIntakes func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error)
+
+// NewSystemIntakeSearchHandler is a constructor for SystemIntakeSearchHandler
+func NewSystemIntakeSearchHandler(base HandlerBase, search searchSystemIntakes) SystemIntakeSearchHandler {
+	return SystemIntakeSearchHandler{
+		HandlerBase:         base,
+		SearchSystemIntakes: search,
+	}
+}
+
+// SystemIntakeSearchHandler is the handler for searching system intakes
+type SystemIntakeSearchHandler struct {
+	HandlerBase
+	SearchSystemIntakes searchSystemIntakes
+}
+
+// parseSearchTime reads a search time as either a date or an RFC3339 time
+func parseSearchTime(value string) (*time.Time, error) {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		t, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		return nil, errors.New("must be a date like 2006-01-02 or an RFC3339 time")
+	}
+	return &t, nil
+}
+
+// Handle handles a request to search System Intakes
+//
+//	The query parameters are
+//		q - the text to search for
+//		status, requestType, component - facet values to filter by, which may be repeated
+//		submittedAfter, submittedBefore - the submitted date range, including the start but not the end
+//		hasLcid - "true" or "false"
+//		first - how many results to return, which is required
+//		after - the endCursor of the previous page
+func (h SystemIntakeSearchHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			query := r.URL.Query()
+			search := models.SystemIntakeSearch{
+				Query:      strings.TrimSpace(query.Get("q")),
+				Components: query["component"],
+				After:      query.Get("after"),
+			}
+			for _, status := range query["status"] {
+				search.Statuses = append(search.Statuses, models.SystemIntakeStatus(strings.ToUpper(status)))
+			}
+			for _, requestType := range query["requestType"] {
+				search.RequestTypes = append(search.RequestTypes, models.SystemIntakeRequestType(strings.ToUpper(requestType)))
+			}
+
+			valErr := apperrors.NewValidationError(
+				errors.New("system intake search failed validation"),
+				models.SystemIntakeSearch{},
+				"",
+			)
+			if value := query.Get("submittedAfter"); value != "" {
+				t, err := parseSearchTime(value)
+				if err != nil {
+					valErr.WithValidation("query.submittedAfter", err.Error())
+				}
+				search.SubmittedAfter = t
+			}
+			if value := query.Get("submittedBefore"); value != "" {
+				t, err := parseSearchTime(value)
+				if err != nil {
+					valErr.WithValidation("query.submittedBefore", err.Error())
+				}
+				search.SubmittedBefore = t
+			}
+			if value := query.Get("hasLcid"); value != "" {
+				hasLCID, err := strconv.ParseBool(value)
+				if err != nil {
+					valErr.WithValidation("query.hasLcid", "must be true or false")
+				}
+				search.HasLifecycleID = &hasLCID
+			}
+			first, err := strconv.Atoi(query.Get("first"))
+			if err != nil {
+				valErr.WithValidation("query.first", "must be a number")
+			}
+			search.First = first
+			if len(valErr.Validations) > 0 {
+				h.WriteErrorResponse(r.Context(), w, &valErr)
+				return
+			}
+
+			result, err := h.SearchSystemIntakes(r.Context(), search)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(result)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
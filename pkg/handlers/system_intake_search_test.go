@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestSystemIntakeSearchHandler() {
+	s.Run("golden path GET parses the search", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(
+			"GET",
+			"/system_intakes/search?q=easi+app&status=open&status=LCID_ISSUED&requestType=new&component=OIT&submittedAfter=2021-01-01&hasLcid=true&first=20&after=abc",
+			nil,
+		)
+		s.NoError(err)
+		var got models.SystemIntakeSearch
+
+		SystemIntakeSearchHandler{
+			HandlerBase: s.base,
+			SearchSystemIntakes: func(_ context.Context, search models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+				got = search
+				return &models.SystemIntakeSearchResult{}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal("easi app", got.Query)
+		s.Equal([]models.SystemIntakeStatus{"OPEN", models.SystemIntakeStatusLCIDISSUED}, got.Statuses)
+		s.Equal([]models.SystemIntakeRequestType{models.SystemIntakeRequestTypeNEW}, got.RequestTypes)
+		s.Equal([]string{"OIT"}, got.Components)
+		s.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), *got.SubmittedAfter)
+		s.Nil(got.SubmittedBefore)
+		s.True(*got.HasLifecycleID)
+		s.Equal(20, got.First)
+		s.Equal("abc", got.After)
+	})
+
+	s.Run("GET fails without a page size", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intakes/search?q=easi", nil)
+		s.NoError(err)
+
+		SystemIntakeSearchHandler{
+			HandlerBase: s.base,
+			SearchSystemIntakes: func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+				return &models.SystemIntakeSearchResult{}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("GET fails with a bad date", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intakes/search?submittedBefore=January&first=10", nil)
+		s.NoError(err)
+
+		SystemIntakeSearchHandler{
+			HandlerBase: s.base,
+			SearchSystemIntakes: func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+				return &models.SystemIntakeSearchResult{}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("GET fails when the search fails", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/system_intakes/search?q=easi&first=10", nil)
+		s.NoError(err)
+
+		SystemIntakeSearchHandler{
+			HandlerBase: s.base,
+			SearchSystemIntakes: func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+				return nil, errors.New("failed to search")
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusInternalServerError, rr.Code)
+	})
+}
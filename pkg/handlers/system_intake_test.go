@@ -30,6 +30,7 @@ func newMockFetchSystemIntakeByID(err error) fetchSystemIntakeByID {
 		intake := models.SystemIntake{
 			ID:        id,
 			EUAUserID: null.StringFrom("FAKE"),
+			Version:   1,
 		}
 		return &intake, err
 	}
@@ -70,6 +71,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 			FetchSystemIntakeByID: newMockFetchSystemIntakeByID(nil),
 		}.Handle()(rr, req)
 		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(`"1"`, rr.Header().Get("ETag"))
 	})
 
 	s.Run("GET returns an error if the uuid is not valid", func() {
@@ -189,6 +191,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString("{}"))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		SystemIntakeHandler{
 			UpdateSystemIntake:    newMockUpdateSystemIntake(nil),
 			HandlerBase:           s.base,
@@ -198,6 +201,86 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.Equal(http.StatusOK, rr.Code)
 	})
 
+	s.Run("PUT updates the version named in If-Match and returns the new one", func() {
+		var updatedVersion int
+		update := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			updatedVersion = intake.Version
+			return &models.SystemIntake{Version: intake.Version + 1}, nil
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(`{"version": 1}`))
+		s.NoError(err)
+		req.Header.Set("If-Match", `"3"`)
+		SystemIntakeHandler{
+			UpdateSystemIntake: update,
+			HandlerBase:        s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(3, updatedVersion)
+		s.Equal(`"4"`, rr.Header().Get("ETag"))
+	})
+
+	s.Run("PUT updates the version in the body without If-Match", func() {
+		var updatedVersion int
+		update := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			updatedVersion = intake.Version
+			return &models.SystemIntake{Version: intake.Version + 1}, nil
+		}
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(`{"version": 2}`))
+		s.NoError(err)
+		SystemIntakeHandler{
+			UpdateSystemIntake: update,
+			HandlerBase:        s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(2, updatedVersion)
+	})
+
+	s.Run("PUT fails without the version it replaces", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString("{}"))
+		s.NoError(err)
+		SystemIntakeHandler{
+			UpdateSystemIntake: newMockUpdateSystemIntake(nil),
+			HandlerBase:        s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusPreconditionRequired, rr.Code)
+		responseErr := errorResponse{}
+		err = json.Unmarshal(rr.Body.Bytes(), &responseErr)
+		s.NoError(err)
+		s.Equal("Precondition required", responseErr.Message)
+	})
+
+	s.Run("PUT fails with an If-Match that isn't a version", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString("{}"))
+		s.NoError(err)
+		req.Header.Set("If-Match", `"abc"`)
+		SystemIntakeHandler{
+			UpdateSystemIntake: newMockUpdateSystemIntake(nil),
+			HandlerBase:        s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusBadRequest, rr.Code)
+	})
+
+	s.Run("PUT fails with a stale version", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString("{}"))
+		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
+		SystemIntakeHandler{
+			UpdateSystemIntake: newMockUpdateSystemIntake(&apperrors.QueryError{Err: &apperrors.ResourceConflictError{}}),
+			HandlerBase:        s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusConflict, rr.Code)
+	})
+
 	s.Run("PUT fails with bad request body", func() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString(""))
@@ -219,6 +302,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBufferString("{}"))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		SystemIntakeHandler{
 			UpdateSystemIntake:    newMockUpdateSystemIntake(fmt.Errorf("failed to save")),
 			HandlerBase:           s.base,
@@ -242,6 +326,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBuffer(body))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		expectedErrMessage := fmt.Errorf("failed to validate")
 		expectedErr := &apperrors.ValidationError{Err: expectedErrMessage, Model: models.SystemIntake{}, ModelID: id.String()}
 		SystemIntakeHandler{
@@ -266,6 +351,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBuffer(body))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		expectedErrMessage := fmt.Errorf("failed to validate")
 		expectedErr := &apperrors.ValidationError{Err: expectedErrMessage, Model: models.SystemIntake{}, ModelID: id.String()}
 		SystemIntakeHandler{
@@ -290,6 +376,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBuffer(body))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		expectedErrMessage := fmt.Errorf("failed to submit")
 		expectedErr := &apperrors.ExternalAPIError{Err: expectedErrMessage, Model: models.SystemIntake{}, ModelID: id.String(), Operation: apperrors.Submit, Source: "CEDAR"}
 		SystemIntakeHandler{
@@ -314,6 +401,7 @@ func (s HandlerTestSuite) TestSystemIntakeHandler() {
 		s.NoError(err)
 		req, err := http.NewRequestWithContext(requestContext, "PUT", "/system_intake/", bytes.NewBuffer(body))
 		s.NoError(err)
+		req.Header.Set("If-Match", `"1"`)
 		expectedErrMessage := fmt.Errorf("failed to send notification")
 		expectedErr := &apperrors.NotificationError{
 			Err:             expectedErrMessage,
@@ -458,3 +546,33 @@ func (s HandlerTestSuite) TestRejectionHandler() {
 		})
 	}
 }
+
+func (s HandlerTestSuite) TestRejectionHandlerRecipients() {
+	var recipients models.EmailRecipients
+	fnReject := func(c context.Context, i *models.SystemIntake, a *models.Action) (*models.SystemIntake, error) {
+		recipients = a.EmailRecipients
+		return i, nil
+	}
+	body := `{
+		"rejectionReason": "I don't like it",
+		"rejectionNextSteps": "Do better",
+		"feedback": "feedback",
+		"recipients": {
+			"notifyRequester": true,
+			"notifyGrt": true,
+			"contactEmails": ["owner@site.com"]
+		}
+	}`
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest("POST", "/system_intake/{intake_id}/reject", bytes.NewBufferString(body))
+	s.NoError(err)
+	req = mux.SetURLVars(req, map[string]string{
+		"intake_id": uuid.New().String(),
+	})
+	NewSystemIntakeRejectionHandler(s.base, fnReject).Handle().ServeHTTP(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	s.True(recipients.NotifyRequester)
+	s.True(recipients.NotifyGRT)
+	s.Equal([]string{"owner@site.com"}, []string(recipients.ContactEmails))
+}
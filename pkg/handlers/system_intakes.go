@@ -12,21 +12,26 @@ import (
 
 type fetchSystemIntakes func(context.Context, models.SystemIntakeStatusFilter) (models.SystemIntakes, error)
 
+type fetchSystemIntakesPage func(context.Context, models.SystemIntakeStatusFilter, models.PageRequest) (*models.SystemIntakesPage, error)
+
 // NewSystemIntakesHandler is a constructor for SystemIntakesHandler
-func NewSystemIntakesHandler(base HandlerBase, fetch fetchSystemIntakes) SystemIntakesHandler {
+func NewSystemIntakesHandler(base HandlerBase, fetch fetchSystemIntakes, fetchPage fetchSystemIntakesPage) SystemIntakesHandler {
 	return SystemIntakesHandler{
-		HandlerBase:        base,
-		FetchSystemIntakes: fetch,
+		HandlerBase:            base,
+		FetchSystemIntakes:     fetch,
+		FetchSystemIntakesPage: fetchPage,
 	}
 }
 
 // SystemIntakesHandler is the handler for CRUD operations on system intakes
 type SystemIntakesHandler struct {
 	HandlerBase
-	FetchSystemIntakes fetchSystemIntakes
+	FetchSystemIntakes     fetchSystemIntakes
+	FetchSystemIntakesPage fetchSystemIntakesPage
 }
 
-// Handle handles a request for System Intakes
+// Handle handles a request for System Intakes.
+// Requests with page parameters get a page of intakes, otherwise they get all of them.
 func (h SystemIntakesHandler) Handle() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
@@ -37,7 +42,18 @@ func (h SystemIntakesHandler) Handle() http.HandlerFunc {
 				filterValue = models.SystemIntakeStatusFilter(strings.ToUpper(statusFilters[0]))
 			}
 
-			systemIntakes, err := h.FetchSystemIntakes(r.Context(), filterValue)
+			pageRequest, paged, err := pageRequestFromQuery(r.URL.Query())
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			var systemIntakes interface{}
+			if paged {
+				systemIntakes, err = h.FetchSystemIntakesPage(r.Context(), filterValue, pageRequest)
+			} else {
+				systemIntakes, err = h.FetchSystemIntakes(r.Context(), filterValue)
+			}
 			if err != nil {
 				h.WriteErrorResponse(r.Context(), w, err)
 				return
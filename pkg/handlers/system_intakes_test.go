@@ -24,6 +24,12 @@ func newMockFetchSystemIntakes(systemIntakes models.SystemIntakes, err error) fe
 	}
 }
 
+func newMockFetchSystemIntakesPage(page *models.SystemIntakesPage, err error) fetchSystemIntakesPage {
+	return func(context context.Context, filter models.SystemIntakeStatusFilter, request models.PageRequest) (*models.SystemIntakesPage, error) {
+		return page, err
+	}
+}
+
 func (s HandlerTestSuite) TestSystemIntakesHandler() {
 	s.Run("golden path FETCH passes", func() {
 		rr := httptest.NewRecorder()
@@ -54,6 +60,50 @@ func (s HandlerTestSuite) TestSystemIntakesHandler() {
 		s.NoError(err)
 		s.Equal("Something went wrong", responseErr.Message)
 	})
+
+	s.Run("FETCH with page parameters returns a page", func() {
+		rr := httptest.NewRecorder()
+		requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "EUAID", JobCodeEASi: true})
+		req, err := http.NewRequestWithContext(requestContext, "GET", "/system_intakes/?first=1&sortBy=project_name", nil)
+		s.NoError(err)
+		var received models.PageRequest
+		endCursor := "cursor"
+		SystemIntakesHandler{
+			FetchSystemIntakes: newMockFetchSystemIntakes(nil, errors.New("should not fetch every intake")),
+			FetchSystemIntakesPage: func(ctx context.Context, filter models.SystemIntakeStatusFilter, request models.PageRequest) (*models.SystemIntakesPage, error) {
+				received = request
+				return &models.SystemIntakesPage{
+					TotalCount: 2,
+					PageInfo:   models.PageInfo{HasNextPage: true, EndCursor: &endCursor},
+					Results:    []models.SystemIntake{{ID: uuid.New()}},
+				}, nil
+			},
+			HandlerBase: s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal(models.PageRequest{First: 1, SortBy: models.SortFieldPROJECTNAME}, received)
+		page := models.SystemIntakesPage{}
+		err = json.Unmarshal(rr.Body.Bytes(), &page)
+		s.NoError(err)
+		s.Equal(2, page.TotalCount)
+		s.True(page.PageInfo.HasNextPage)
+		s.Equal(&endCursor, page.PageInfo.EndCursor)
+		s.Len(page.Results, 1)
+	})
+
+	s.Run("FETCH with a bad page size fails validation", func() {
+		rr := httptest.NewRecorder()
+		requestContext := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "EUAID", JobCodeEASi: true})
+		req, err := http.NewRequestWithContext(requestContext, "GET", "/system_intakes/?first=lots", nil)
+		s.NoError(err)
+		SystemIntakesHandler{
+			FetchSystemIntakesPage: newMockFetchSystemIntakesPage(nil, errors.New("should not fetch a page")),
+			HandlerBase:            s.base,
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
 }
 
 func (s HandlerTestSuite) TestLCIDHandler() {
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type searchUsers func(context.Context, string) ([]*models.UserInfo, error)
+
+// NewUserSearchHandler is a constructor for UserSearchHandler
+func NewUserSearchHandler(base HandlerBase, search searchUsers) UserSearchHandler {
+	return UserSearchHandler{
+		HandlerBase: base,
+		SearchUsers: search,
+	}
+}
+
+// UserSearchHandler is the handler for finding people by name
+type UserSearchHandler struct {
+	HandlerBase
+	SearchUsers searchUsers
+}
+
+// Handle handles a request to search for people
+//
+//	The query parameter is
+//		q - the part of a person's name to search for
+func (h UserSearchHandler) Handle() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			people, err := h.SearchUsers(r.Context(), r.URL.Query().Get("q"))
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			js, err := json.Marshal(people)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			_, err = w.Write(js)
+			if err != nil {
+				h.WriteErrorResponse(r.Context(), w, err)
+				return
+			}
+
+		default:
+			h.WriteErrorResponse(r.Context(), w, &apperrors.MethodNotAllowedError{Method: r.Method})
+			return
+		}
+	}
+}
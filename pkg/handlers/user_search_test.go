@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s HandlerTestSuite) TestUserSearchHandler() {
+	s.Run("golden path GET returns the people found", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/users/search?q=adeline+aarons", nil)
+		s.NoError(err)
+		searched := ""
+
+		UserSearchHandler{
+			HandlerBase: s.base,
+			SearchUsers: func(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+				searched = commonName
+				return []*models.UserInfo{{CommonName: "Adeline Aarons", Email: "adeline.aarons@local.fake", EuaUserID: "ABCD"}}, nil
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal("adeline aarons", searched)
+		var people []map[string]string
+		s.NoError(json.Unmarshal(rr.Body.Bytes(), &people))
+		s.Equal([]map[string]string{{
+			"commonName": "Adeline Aarons",
+			"email":      "adeline.aarons@local.fake",
+			"euaUserId":  "ABCD",
+		}}, people)
+	})
+
+	s.Run("GET fails with a search that's too short", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/users/search?q=a", nil)
+		s.NoError(err)
+
+		UserSearchHandler{
+			HandlerBase: s.base,
+			SearchUsers: func(context.Context, string) ([]*models.UserInfo, error) {
+				valErr := apperrors.NewValidationError(errors.New("user search failed validation"), models.UserInfo{}, "")
+				valErr.WithValidation("commonName", "must be at least 2 characters")
+				return nil, &valErr
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	s.Run("GET fails when the search fails", func() {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", "/users/search?q=adeline", nil)
+		s.NoError(err)
+
+		UserSearchHandler{
+			HandlerBase: s.base,
+			SearchUsers: func(context.Context, string) ([]*models.UserInfo, error) {
+				return nil, errors.New("failed to search")
+			},
+		}.Handle()(rr, req)
+
+		s.Equal(http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -108,6 +108,7 @@ func (s IntegrationTestSuite) TestBusinessCaseEndpoints() {
 		req, err := http.NewRequest(http.MethodPut, putURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.user.accessToken))
+		req.Header.Set("If-Match", fmt.Sprintf(`"%d"`, createdBizCase.Version))
 
 		resp, err := client.Do(req)
 
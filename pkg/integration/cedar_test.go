@@ -7,6 +7,7 @@ import (
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
 	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedartransport"
 )
 
 // Since we can't always hit the CEDAR API in tests
@@ -25,6 +26,7 @@ func (s *IntegrationTestSuite) TestCEDARConnection() {
 	cedarEasiClient := cedareasi.NewTranslatedClient(
 		s.config.GetString("CEDAR_API_URL"),
 		s.config.GetString("CEDAR_API_KEY"),
+		cedartransport.DefaultConfig,
 		ldClient,
 	)
 
@@ -46,6 +46,8 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 	})
 
 	var id uuid.UUID
+	// each PUT names the version it replaces, from the ETag of the last response
+	var etag string
 	s.Run("POST will succeed with a token", func() {
 		req, err := http.NewRequest(http.MethodPost, systemIntakeURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
@@ -55,6 +57,7 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 
 		s.NoError(err)
 		s.Equal(http.StatusCreated, resp.StatusCode)
+		etag = resp.Header.Get("ETag")
 		actualBody, err := ioutil.ReadAll(resp.Body)
 		s.NoError(err)
 		var actualIntake models.SystemIntake
@@ -74,11 +77,13 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 		req, err := http.NewRequest(http.MethodPut, systemIntakeURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.user.accessToken))
+		req.Header.Set("If-Match", etag)
 
 		resp, err := client.Do(req)
 
 		s.NoError(err)
 		s.Equal(http.StatusOK, resp.StatusCode)
+		etag = resp.Header.Get("ETag")
 	})
 
 	getURL.Path = path.Join(getURL.Path, id.String())
@@ -113,11 +118,13 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 		req, err := http.NewRequest(http.MethodPut, systemIntakeURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.user.accessToken))
+		req.Header.Set("If-Match", etag)
 
 		resp, err := client.Do(req)
 
 		s.NoError(err)
 		s.Equal(http.StatusOK, resp.StatusCode)
+		etag = resp.Header.Get("ETag")
 	})
 
 	// Since we can't always hit the CEDAR API in the following
@@ -155,11 +162,13 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 		req, err := http.NewRequest(http.MethodPut, systemIntakeURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.user.accessToken))
+		req.Header.Set("If-Match", etag)
 
 		resp, err := client.Do(req)
 
 		s.NoError(err)
 		s.Equal(http.StatusOK, resp.StatusCode)
+		etag = resp.Header.Get("ETag")
 	})
 
 	s.Run("PUT will fail if status is 'SUBMITTED', but it doesn't pass validation", func() {
@@ -176,6 +185,7 @@ func (s IntegrationTestSuite) TestSystemIntakeEndpoints() {
 		req, err := http.NewRequest(http.MethodPut, systemIntakeURL.String(), bytes.NewBuffer(body))
 		s.NoError(err)
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.user.accessToken))
+		req.Header.Set("If-Match", etag)
 
 		resp, err := client.Do(req)
 
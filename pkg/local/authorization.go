@@ -1,10 +1,13 @@
 package local
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/go-openapi/swag"
 	"go.uber.org/zap"
 
@@ -45,40 +48,53 @@ func authorizeMiddleware(logger *zap.Logger, next http.Handler, testEUAID string
 			return
 		}
 
-		config := DevUserConfig{}
-		if parseErr := json.Unmarshal([]byte(devUserConfigJSON), &config); parseErr != nil {
-			// Assume at this point that we've opted for Okta login on the frontend.
-			euaID := defaultTestEUAID
-			if testEUAID != "" {
-				euaID = testEUAID
-			}
-			logger.Info("Using local authorization middleware with Okta frontend login")
-			ctx := appcontext.WithPrincipal(r.Context(), &authn.EUAPrincipal{
-				EUAID:            euaID,
-				JobCodeEASi:      true,
-				JobCodeGRT:       true,
-				JobCode508Tester: true,
-				JobCode508User:   true,
-			})
-			next.ServeHTTP(w, r.WithContext(ctx))
-			return
-		}
-
-		logger.Info("Using local authorization middleware and populating EUA ID and job codes")
-		ctx := appcontext.WithPrincipal(r.Context(), &authn.EUAPrincipal{
-			EUAID:            config.EUA,
-			JobCodeEASi:      true,
-			JobCodeGRT:       swag.ContainsStrings(config.JobCodes, "EASI_D_GOVTEAM"),
-			JobCode508User:   swag.ContainsStrings(config.JobCodes, "EASI_D_508_USER"),
-			JobCode508Tester: swag.ContainsStrings(config.JobCodes, "EASI_D_508_TESTER"),
-		})
+		ctx := appcontext.WithPrincipal(r.Context(), devPrincipal(logger, devUserConfigJSON, testEUAID))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+func devPrincipal(logger *zap.Logger, devUserConfigJSON string, testEUAID string) *authn.EUAPrincipal {
+	config := DevUserConfig{}
+	if parseErr := json.Unmarshal([]byte(devUserConfigJSON), &config); parseErr != nil {
+		// Assume at this point that we've opted for Okta login on the frontend.
+		euaID := defaultTestEUAID
+		if testEUAID != "" {
+			euaID = testEUAID
+		}
+		logger.Info("Using local authorization middleware with Okta frontend login")
+		return &authn.EUAPrincipal{
+			EUAID:            euaID,
+			JobCodeEASi:      true,
+			JobCodeGRT:       true,
+			JobCode508Tester: true,
+			JobCode508User:   true,
+		}
+	}
+
+	logger.Info("Using local authorization middleware and populating EUA ID and job codes")
+	return &authn.EUAPrincipal{
+		EUAID:            config.EUA,
+		JobCodeEASi:      true,
+		JobCodeGRT:       swag.ContainsStrings(config.JobCodes, "EASI_D_GOVTEAM"),
+		JobCode508User:   swag.ContainsStrings(config.JobCodes, "EASI_D_508_USER"),
+		JobCode508Tester: swag.ContainsStrings(config.JobCodes, "EASI_D_508_TESTER"),
+	}
+}
+
 // NewLocalAuthorizeMiddleware stubs out context info while ignoring remote authorization
 func NewLocalAuthorizeMiddleware(logger *zap.Logger, testEUAID string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return authorizeMiddleware(logger, next, testEUAID)
 	}
 }
+
+// NewLocalWebsocketInitFunc stubs out context info for GraphQL websockets while ignoring remote authorization
+func NewLocalWebsocketInitFunc(logger *zap.Logger, testEUAID string) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+		tokenParts := strings.Split(initPayload.Authorization(), "Bearer ")
+		if len(tokenParts) < 2 || tokenParts[1] == "" {
+			return nil, errors.New("invalid Bearer in connection_init payload")
+		}
+		return appcontext.WithPrincipal(ctx, devPrincipal(logger, tokenParts[1], testEUAID)), nil
+	}
+}
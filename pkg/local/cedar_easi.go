@@ -31,3 +31,28 @@ func (c *CedarEasiClient) ValidateAndSubmitSystemIntake(ctx context.Context, int
 		zap.String("AlfabetID", fakeAlfabetID))
 	return fakeAlfabetID, nil
 }
+
+// ValidateAndSubmitBusinessCase submits a business case to CEDAR
+func (c *CedarEasiClient) ValidateAndSubmitBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+	fakeCedarID := "000-000-1"
+	if businessCase.CedarID.Valid {
+		fakeCedarID = businessCase.CedarID.String
+	}
+	appcontext.ZLogger(ctx).Info("Mock Submit Business Case to CEDAR",
+		zap.String("businessCaseID", businessCase.ID.String()),
+		zap.String("CedarID", fakeCedarID))
+	return fakeCedarID, nil
+}
+
+// UpdateSystemIntake sends the current status and decision of an intake to CEDAR
+func (c *CedarEasiClient) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) error {
+	appcontext.ZLogger(ctx).Info("Mock Update System Intake in CEDAR",
+		zap.String("intakeID", intake.ID.String()),
+		zap.String("status", string(intake.Status)))
+	return nil
+}
+
+// FetchSystemIntakeDrift compares an intake with what CEDAR has for it
+func (c *CedarEasiClient) FetchSystemIntakeDrift(ctx context.Context, intake *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error) {
+	return []models.SystemIntakeCedarDrift{}, nil
+}
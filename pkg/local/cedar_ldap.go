@@ -38,3 +38,26 @@ func (c CedarLdapClient) FetchUserInfo(_ context.Context, euaID string) (*models
 		EuaUserID:  euaID,
 	}, nil
 }
+
+// localPeople are who a person search can find locally
+var localPeople = []models.UserInfo{
+	{CommonName: "Adeline Aarons", Email: "adeline.aarons@local.fake", EuaUserID: "ABCD"},
+	{CommonName: "Brandon Bauer", Email: "brandon.bauer@local.fake", EuaUserID: "BTMN"},
+	{CommonName: "Carla Chavez", Email: "carla.chavez@local.fake", EuaUserID: "CCHV"},
+	{CommonName: "Dana Doyle", Email: "dana.doyle@local.fake", EuaUserID: "DDYL"},
+	{CommonName: "Terry Thompson", Email: "terry.thompson@local.fake", EuaUserID: "TEST"},
+}
+
+// SearchCommonNameContains searches for people whose name contains the query
+func (c CedarLdapClient) SearchCommonNameContains(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+	c.logger.Info("Mock SearchCommonNameContains from LDAP", zap.String("commonName", commonName))
+	query := strings.ToLower(strings.TrimSpace(commonName))
+	people := []*models.UserInfo{}
+	for _, person := range localPeople {
+		if query != "" && strings.Contains(strings.ToLower(person.CommonName), query) {
+			found := person
+			people = append(people, &found)
+		}
+	}
+	return people, nil
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
 )
 
 // NewSender returns a fake email sender
@@ -18,11 +19,15 @@ type Sender struct {
 }
 
 // Send logs an email
-func (s Sender) Send(ctx context.Context, toAddress string, subject string, body string) error {
+func (s Sender) Send(ctx context.Context, email models.Email) error {
 	appcontext.ZLogger(ctx).Info("Mock sending email",
-		zap.String("To", toAddress),
-		zap.String("Subject", subject),
-		zap.String("Body", body),
+		zap.Strings("To", email.ToAddresses),
+		zap.Strings("CC", email.CCAddresses),
+		zap.Strings("BCC", email.BCCAddresses),
+		zap.String("ReplyTo", email.ReplyTo),
+		zap.String("Subject", email.Subject),
+		zap.String("Body", email.Body),
+		zap.String("TextBody", email.TextBody),
 	)
 	return nil
 }
@@ -47,17 +47,40 @@ const (
 	ActionTypeGUIDERECEIVEDCLOSE ActionType = "GUIDE_RECEIVED_CLOSE"
 	// ActionTypeNOTRESPONDINGCLOSE captures enum value NOT_RESPONDING_CLOSE
 	ActionTypeNOTRESPONDINGCLOSE ActionType = "NOT_RESPONDING_CLOSE"
+	// ActionTypeLCIDEXPIRATIONREMINDER captures enum value LCID_EXPIRATION_REMINDER
+	ActionTypeLCIDEXPIRATIONREMINDER ActionType = "LCID_EXPIRATION_REMINDER"
+	// ActionTypeEXPIRELCID captures enum value EXPIRE_LCID
+	ActionTypeEXPIRELCID ActionType = "EXPIRE_LCID"
+	// ActionTypeEXTENDLCID captures enum value EXTEND_LCID
+	ActionTypeEXTENDLCID ActionType = "EXTEND_LCID"
+	// ActionTypeAMENDLCID captures enum value AMEND_LCID
+	ActionTypeAMENDLCID ActionType = "AMEND_LCID"
+	// ActionTypeRETIRELCID captures enum value RETIRE_LCID
+	ActionTypeRETIRELCID ActionType = "RETIRE_LCID"
 )
 
 // Action is the model for an action on a system intake
 type Action struct {
-	ID             uuid.UUID   `json:"id"`
-	IntakeID       *uuid.UUID  `db:"intake_id"`
-	BusinessCaseID *uuid.UUID  `db:"business_case_id"`
-	ActionType     ActionType  `json:"actionType" db:"action_type"`
-	ActorName      string      `json:"actorName" db:"actor_name"`
-	ActorEmail     string      `json:"actorEmail" db:"actor_email"`
-	ActorEUAUserID string      `json:"actorEuaUserId" db:"actor_eua_user_id"`
-	Feedback       null.String `json:"feedback"`
-	CreatedAt      *time.Time  `json:"createdAt" db:"created_at"`
+	ID              uuid.UUID   `json:"id"`
+	IntakeID        *uuid.UUID  `db:"intake_id"`
+	BusinessCaseID  *uuid.UUID  `db:"business_case_id"`
+	ActionType      ActionType  `json:"actionType" db:"action_type"`
+	ActorName       string      `json:"actorName" db:"actor_name"`
+	ActorEmail      string      `json:"actorEmail" db:"actor_email"`
+	ActorEUAUserID  string      `json:"actorEuaUserId" db:"actor_eua_user_id"`
+	Feedback        null.String `json:"feedback"`
+	CreatedAt       *time.Time  `json:"createdAt" db:"created_at"`
+	EmailRecipients `json:"recipients"`
+	LCIDChange      `json:"lcidChange"`
+}
+
+// LCIDChange records the values an LCID extension, amendment or retirement changed
+type LCIDChange struct {
+	PreviousExpiresAt *time.Time  `json:"previousExpiresAt" db:"lcid_previous_expires_at"`
+	NewExpiresAt      *time.Time  `json:"newExpiresAt" db:"lcid_new_expires_at"`
+	PreviousScope     null.String `json:"previousScope" db:"lcid_previous_scope"`
+	NewScope          null.String `json:"newScope" db:"lcid_new_scope"`
+	PreviousNextSteps null.String `json:"previousNextSteps" db:"lcid_previous_next_steps"`
+	NewNextSteps      null.String `json:"newNextSteps" db:"lcid_new_next_steps"`
+	RetirementReason  null.String `json:"retirementReason" db:"lcid_retirement_reason"`
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntity is the kind of record an audit entry tracks
+type AuditEntity string
+
+const (
+	// AuditEntitySystemIntake captures enum value "SYSTEM_INTAKE"
+	AuditEntitySystemIntake AuditEntity = "SYSTEM_INTAKE"
+	// AuditEntityBusinessCase captures enum value "BUSINESS_CASE"
+	AuditEntityBusinessCase AuditEntity = "BUSINESS_CASE"
+)
+
+// AuditChange is a field's value before and after an update
+type AuditChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// AuditChanges are the fields an update changed, keyed by their JSON names
+type AuditChanges map[string]AuditChange
+
+// NewAuditChanges returns the fields that differ between two versions of a record,
+// compared by their JSON
+func NewAuditChanges(before interface{}, after interface{}) (AuditChanges, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := AuditChanges{}
+	for name, afterValue := range afterFields {
+		beforeValue, ok := beforeFields[name]
+		if !ok {
+			beforeValue = json.RawMessage("null")
+		}
+		if bytes.Equal(beforeValue, afterValue) {
+			continue
+		}
+		changes[name] = AuditChange{Old: beforeValue, New: afterValue}
+	}
+	return changes, nil
+}
+
+func jsonFields(record interface{}) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(encoded, &fields)
+	return fields, err
+}
+
+// Scan implements the sql.Scanner interface
+func (c *AuditChanges) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), c)
+}
+
+// Value implements the driver.Valuer interface
+func (c AuditChanges) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// AuditEntry records who changed which fields of a system intake or business case, and when
+type AuditEntry struct {
+	ID        uuid.UUID    `json:"id"`
+	Entity    AuditEntity  `json:"entity"`
+	EntityID  uuid.UUID    `json:"entityId" db:"entity_id"`
+	EUAUserID string       `json:"euaUserId" db:"eua_user_id"`
+	TraceID   *uuid.UUID   `json:"traceId" db:"trace_id"`
+	CreatedAt *time.Time   `json:"createdAt" db:"created_at"`
+	Changes   AuditChanges `json:"changes"`
+}
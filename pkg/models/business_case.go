@@ -129,6 +129,8 @@ type BusinessCase struct {
 	LifecycleCostLines                  EstimatedLifecycleCosts `json:"lifecycleCostLines" db:"lifecycle_cost_lines"`
 	CreatedAt                           *time.Time              `json:"createdAt" db:"created_at"`
 	UpdatedAt                           *time.Time              `json:"updatedAt" db:"updated_at"`
+	Version                             int                     `json:"version" db:"version"`
+	CedarID                             null.String             `json:"cedarId" db:"cedar_id"`
 	SubmittedAt                         *time.Time              `json:"submittedAt" db:"submitted_at"`
 	ArchivedAt                          *time.Time              `db:"archived_at"`
 	InitialSubmittedAt                  *time.Time              `json:"initialSubmittedAt" db:"initial_submitted_at"`
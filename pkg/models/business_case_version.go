@@ -0,0 +1,51 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BusinessCaseSnapshot is a copy of a business case and its lifecycle cost lines
+type BusinessCaseSnapshot BusinessCase
+
+// Scan implements the sql.Scanner interface
+func (b *BusinessCaseSnapshot) Scan(src interface{}) error {
+	return json.Unmarshal(src.([]byte), b)
+}
+
+// Value implements the driver.Valuer interface
+func (b BusinessCaseSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(b)
+}
+
+// BusinessCaseVersion is a business case as it was when it was submitted.
+// Versions are numbered from 1 in the order they were submitted.
+type BusinessCaseVersion struct {
+	ID             uuid.UUID            `json:"id"`
+	BusinessCaseID uuid.UUID            `json:"businessCaseId" db:"business_case_id"`
+	Number         int                  `json:"number"`
+	IntakeStatus   SystemIntakeStatus   `json:"intakeStatus" db:"intake_status"`
+	EUAUserID      string               `json:"euaUserId" db:"eua_user_id"`
+	CreatedAt      *time.Time           `json:"createdAt" db:"created_at"`
+	BusinessCase   BusinessCaseSnapshot `json:"businessCase" db:"business_case"`
+}
+
+// LifecycleCostDelta is how the cost of one year of one phase of a solution changed between versions
+type LifecycleCostDelta struct {
+	Solution LifecycleCostSolution `json:"solution"`
+	Phase    *LifecycleCostPhase   `json:"phase"`
+	Year     LifecycleCostYear     `json:"year"`
+	Old      *int                  `json:"old"`
+	New      *int                  `json:"new"`
+}
+
+// BusinessCaseVersionDiff is what changed in a business case from one version to another
+type BusinessCaseVersionDiff struct {
+	From               int                  `json:"from"`
+	To                 int                  `json:"to"`
+	Changes            AuditChanges         `json:"changes"`
+	LifecycleCostLines []LifecycleCostDelta `json:"lifecycleCostLines"`
+}
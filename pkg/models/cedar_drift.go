@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+// SystemIntakeCedarDrift is a field of an intake that CEDAR has a different value for than EASi
+type SystemIntakeCedarDrift struct {
+	IntakeID   uuid.UUID   `json:"intakeId"`
+	AlfabetID  string      `json:"alfabetId"`
+	Field      string      `json:"field"`
+	EASiValue  interface{} `json:"easiValue"`
+	CEDARValue interface{} `json:"cedarValue"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/lib/pq"
+)
+
+// Email is a message for an email sender to deliver
+type Email struct {
+	ToAddresses  []string
+	CCAddresses  []string
+	BCCAddresses []string
+	ReplyTo      string
+	Subject      string
+	Body         string
+	TextBody     string
+}
+
+// EmailRecipients are the intake contacts a reviewer chose to notify of an action
+type EmailRecipients struct {
+	NotifyRequester bool           `json:"notifyRequester" db:"notify_requester"`
+	NotifyGRT       bool           `json:"notifyGrt" db:"notify_grt"`
+	ContactEmails   pq.StringArray `json:"contactEmails" db:"notify_contact_emails"`
+}
+
+// IsEmpty returns whether no one was chosen to be notified
+func (r EmailRecipients) IsEmpty() bool {
+	return !r.NotifyRequester && !r.NotifyGRT && len(r.ContactEmails) == 0
+}
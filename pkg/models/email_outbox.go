@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+)
+
+// OutboxEmail is a rendered email waiting to be delivered
+type OutboxEmail struct {
+	ID            uuid.UUID      `json:"id"`
+	ToAddresses   pq.StringArray `json:"toAddresses" db:"to_addresses"`
+	CCAddresses   pq.StringArray `json:"ccAddresses" db:"cc_addresses"`
+	BCCAddresses  pq.StringArray `json:"bccAddresses" db:"bcc_addresses"`
+	ReplyTo       null.String    `json:"replyTo" db:"reply_to"`
+	Subject       string         `json:"subject"`
+	Body          string         `json:"body"`
+	TextBody      string         `json:"textBody" db:"text_body"`
+	TemplateName  string         `json:"templateName" db:"template_name"`
+	IntakeID      *uuid.UUID     `json:"intakeId" db:"intake_id"`
+	Attempts      int            `json:"attempts"`
+	LastError     null.String    `json:"lastError" db:"last_error"`
+	NextAttemptAt *time.Time     `json:"nextAttemptAt" db:"next_attempt_at"`
+	SentAt        *time.Time     `json:"sentAt" db:"sent_at"`
+	CreatedAt     *time.Time     `json:"createdAt" db:"created_at"`
+	UpdatedAt     *time.Time     `json:"updatedAt" db:"updated_at"`
+}
+
+// OutboxEmails is a list of outbox emails
+type OutboxEmails []OutboxEmail
+
+// Email returns the message to hand to an email sender
+func (e OutboxEmail) Email() Email {
+	return Email{
+		ToAddresses:  e.ToAddresses,
+		CCAddresses:  e.CCAddresses,
+		BCCAddresses: e.BCCAddresses,
+		ReplyTo:      e.ReplyTo.String,
+		Subject:      e.Subject,
+		Body:         e.Body,
+		TextBody:     e.TextBody,
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/guregu/null"
 )
 
-//PreSignedURL is the model to return S3 pre-signed URLs
+// PreSignedURL is the model to return S3 pre-signed URLs
 type PreSignedURL struct {
 	URL      string `json:"URL"`
 	Filename string `json:"filename"`
@@ -0,0 +1,32 @@
+package models
+
+// LifecycleCostPhaseTotal is the cost of one phase of a solution over every year
+type LifecycleCostPhaseTotal struct {
+	Phase LifecycleCostPhase `json:"phase"`
+	Total int                `json:"total"`
+}
+
+// LifecycleCostYearTotal is the cost of one year of a solution over every phase
+type LifecycleCostYearTotal struct {
+	Year  LifecycleCostYear `json:"year"`
+	Total int               `json:"total"`
+}
+
+// LifecycleCostSolutionSummary totals the estimated lifecycle costs of one solution
+type LifecycleCostSolutionSummary struct {
+	Solution LifecycleCostSolution     `json:"solution"`
+	Total    int                       `json:"total"`
+	Phases   []LifecycleCostPhaseTotal `json:"phases"`
+	Years    []LifecycleCostYearTotal  `json:"years"`
+	// DeltaFromAsIs is how much more the solution costs than keeping things as they are.
+	// It's only set on alternatives to a business case with As Is costs.
+	DeltaFromAsIs *int `json:"deltaFromAsIs"`
+	// NetPresentValue is only set when costs are summarized with a discount rate
+	NetPresentValue *float64 `json:"netPresentValue"`
+}
+
+// LifecycleCostSummary totals the estimated lifecycle costs of a business case's solutions
+type LifecycleCostSummary struct {
+	DiscountRate *float64                       `json:"discountRate"`
+	Solutions    []LifecycleCostSolutionSummary `json:"solutions"`
+}
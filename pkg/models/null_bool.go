@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guregu/null"
+)
+
+// MarshalNullBool allows null.Bool to be marshalled by graphql
+func MarshalNullBool(b null.Bool) graphql.Marshaler {
+	if !b.Valid {
+		return graphql.Null
+	}
+	return graphql.MarshalBoolean(b.Bool)
+}
+
+// UnmarshalNullBool allows null.Bool to be unmarshalled by graphql
+func UnmarshalNullBool(v interface{}) (null.Bool, error) {
+	if v == nil {
+		return null.Bool{}, nil
+	}
+	b, err := graphql.UnmarshalBoolean(v)
+	if err != nil {
+		return null.Bool{}, err
+	}
+	return null.BoolFrom(b), nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guregu/null"
+)
+
+// MarshalNullString allows null.String to be marshalled by graphql
+func MarshalNullString(s null.String) graphql.Marshaler {
+	if !s.Valid {
+		return graphql.Null
+	}
+	return graphql.MarshalString(s.String)
+}
+
+// UnmarshalNullString allows null.String to be unmarshalled by graphql
+func UnmarshalNullString(v interface{}) (null.String, error) {
+	if v == nil {
+		return null.String{}, nil
+	}
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return null.String{}, err
+	}
+	return null.StringFrom(s), nil
+}
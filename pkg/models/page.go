@@ -0,0 +1,82 @@
+package models
+
+// SortField is a field a list can be sorted by
+type SortField string
+
+const (
+	// SortFieldSUBMITTED sorts by when the item was submitted
+	SortFieldSUBMITTED SortField = "SUBMITTED"
+	// SortFieldUPDATED sorts by when the item was last updated
+	SortFieldUPDATED SortField = "UPDATED"
+	// SortFieldPROJECTNAME sorts by the item's project name
+	SortFieldPROJECTNAME SortField = "PROJECT_NAME"
+	// SortFieldSTATUS sorts by the item's status
+	SortFieldSTATUS SortField = "STATUS"
+)
+
+// SortDirection is the order a list is sorted in
+type SortDirection string
+
+const (
+	// SortDirectionASC sorts smallest, earliest or alphabetically first items first
+	SortDirectionASC SortDirection = "ASC"
+	// SortDirectionDESC sorts largest, latest or alphabetically last items first
+	SortDirectionDESC SortDirection = "DESC"
+)
+
+// MaxPageSize is the most items a page can hold
+const MaxPageSize = 100
+
+// PageRequest asks for the page of a list that comes after a cursor.
+// Items with the same sort value are ordered by ID, so the order is stable.
+type PageRequest struct {
+	// First is how many items the page holds
+	First int
+	// After is the cursor of the last item on the previous page, or empty for the first page
+	After     string
+	SortBy    SortField
+	Direction SortDirection
+}
+
+// PageInfo describes where a page sits in its list
+type PageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+	// EndCursor is the cursor of the last item on the page, or nil if it is empty
+	EndCursor *string `json:"endCursor"`
+}
+
+// SystemIntakesPage is a page of system intakes
+type SystemIntakesPage struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	Results    []SystemIntake `json:"results"`
+	// Cursors holds the cursor of each result
+	Cursors []string `json:"-"`
+}
+
+// BusinessCasesPage is a page of business cases
+type BusinessCasesPage struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	Results    []BusinessCase `json:"results"`
+	// Cursors holds the cursor of each result
+	Cursors []string `json:"-"`
+}
+
+// AccessibilityRequestsPage is a page of accessibility requests
+type AccessibilityRequestsPage struct {
+	TotalCount int                    `json:"totalCount"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	Results    []AccessibilityRequest `json:"results"`
+	// Cursors holds the cursor of each result
+	Cursors []string `json:"-"`
+}
+
+// SystemsPage is a page of systems
+type SystemsPage struct {
+	TotalCount int       `json:"totalCount"`
+	PageInfo   PageInfo  `json:"pageInfo"`
+	Results    []*System `json:"results"`
+	// Cursors holds the cursor of each result
+	Cursors []string `json:"-"`
+}
@@ -40,6 +40,10 @@ const (
 	SystemIntakeStatusNOTITREQUEST SystemIntakeStatus = "NOT_IT_REQUEST"
 	// SystemIntakeStatusLCIDISSUED captures enum value "LCID_ISSUED"
 	SystemIntakeStatusLCIDISSUED SystemIntakeStatus = "LCID_ISSUED"
+	// SystemIntakeStatusLCIDEXPIRED captures enum value "LCID_EXPIRED"
+	SystemIntakeStatusLCIDEXPIRED SystemIntakeStatus = "LCID_EXPIRED"
+	// SystemIntakeStatusLCIDRETIRED captures enum value "LCID_RETIRED"
+	SystemIntakeStatusLCIDRETIRED SystemIntakeStatus = "LCID_RETIRED"
 	// SystemIntakeStatusBIZCASEDRAFT captures enum value "BIZ_CASE_DRAFT"
 	SystemIntakeStatusBIZCASEDRAFT SystemIntakeStatus = "BIZ_CASE_DRAFT"
 	// SystemIntakeStatusBIZCASEDRAFTSUBMITTED captures enum value "BIZ_CASE_DRAFT_SUBMITTED"
@@ -114,6 +118,7 @@ type SystemIntake struct {
 	ContractEndYear             null.String             `json:"contractEndYear" db:"contract_end_year"`
 	CreatedAt                   *time.Time              `json:"createdAt" db:"created_at"`
 	UpdatedAt                   *time.Time              `json:"updatedAt" db:"updated_at"`
+	Version                     int                     `json:"version" db:"version"`
 	SubmittedAt                 *time.Time              `json:"submittedAt" db:"submitted_at"`
 	DecidedAt                   *time.Time              `json:"decidedAt" db:"decided_at"`
 	ArchivedAt                  *time.Time              `json:"archivedAt" db:"archived_at"`
@@ -123,10 +128,13 @@ type SystemIntake struct {
 	GrtReviewEmailBody          null.String             `json:"grtReviewEmailBody" db:"grt_review_email_body"`
 	RequesterEmailAddress       null.String             `json:"requesterEmailAddress" db:"requester_email_address"`
 	BusinessCaseID              *uuid.UUID              `json:"businessCase" db:"business_case_id"`
-	LifecycleID                 null.String             `json:"lcid" db:"lcid"`
-	LifecycleExpiresAt          *time.Time              `json:"lcidExpiresAt" db:"lcid_expires_at"`
-	LifecycleScope              null.String             `json:"lcidScope" db:"lcid_scope"`
-	LifecycleNextSteps          null.String             `json:"lifecycleNextSteps" db:"lcid_next_steps"`
+	LifecycleID                 null.String             `json:"lcid" db:"lcid" gqlgen:"lcid"`
+	LifecycleExpiresAt          *time.Time              `json:"lcidExpiresAt" db:"lcid_expires_at" gqlgen:"lcidExpiresAt"`
+	LifecycleScope              null.String             `json:"lcidScope" db:"lcid_scope" gqlgen:"lcidScope"`
+	LifecycleNextSteps          null.String             `json:"lifecycleNextSteps" db:"lcid_next_steps" gqlgen:"lcidNextSteps"`
+	LifecycleReminderDays       null.Int                `json:"lcidReminderDays" db:"lcid_reminder_days"`
+	LifecycleRetiredAt          *time.Time              `json:"lcidRetiredAt" db:"lcid_retired_at"`
+	LifecycleRetirementReason   null.String             `json:"lcidRetirementReason" db:"lcid_retirement_reason"`
 	DecisionNextSteps           null.String             `json:"decisionNextSteps" db:"decision_next_steps"`
 	RejectionReason             null.String             `json:"rejectionReason" db:"rejection_reason"`
 }
@@ -163,6 +171,8 @@ func GetStatusesByFilter(filter SystemIntakeStatusFilter) ([]SystemIntakeStatus,
 	case SystemIntakeStatusFilterCLOSED:
 		return []SystemIntakeStatus{
 			SystemIntakeStatusLCIDISSUED,
+			SystemIntakeStatusLCIDEXPIRED,
+			SystemIntakeStatusLCIDRETIRED,
 			SystemIntakeStatusWITHDRAWN,
 			SystemIntakeStatusNOTITREQUEST,
 			SystemIntakeStatusNOTAPPROVED,
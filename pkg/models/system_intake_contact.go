@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// SystemIntakeContactRole is the part a contact plays on an intake
+type SystemIntakeContactRole string
+
+const (
+	// SystemIntakeContactRoleBUSINESSOWNER captures enum value "BUSINESS_OWNER"
+	SystemIntakeContactRoleBUSINESSOWNER SystemIntakeContactRole = "BUSINESS_OWNER"
+	// SystemIntakeContactRolePRODUCTMANAGER captures enum value "PRODUCT_MANAGER"
+	SystemIntakeContactRolePRODUCTMANAGER SystemIntakeContactRole = "PRODUCT_MANAGER"
+	// SystemIntakeContactRoleISSO captures enum value "ISSO"
+	SystemIntakeContactRoleISSO SystemIntakeContactRole = "ISSO"
+	// SystemIntakeContactRoleTRBCOLLABORATOR captures enum value "TRB_COLLABORATOR"
+	SystemIntakeContactRoleTRBCOLLABORATOR SystemIntakeContactRole = "TRB_COLLABORATOR"
+	// SystemIntakeContactRoleOITSECURITYCOLLABORATOR captures enum value "OIT_SECURITY_COLLABORATOR"
+	SystemIntakeContactRoleOITSECURITYCOLLABORATOR SystemIntakeContactRole = "OIT_SECURITY_COLLABORATOR"
+	// SystemIntakeContactRoleEACOLLABORATOR captures enum value "EA_COLLABORATOR"
+	SystemIntakeContactRoleEACOLLABORATOR SystemIntakeContactRole = "EA_COLLABORATOR"
+)
+
+// SystemIntakeContactRoles are every role a contact can have
+var SystemIntakeContactRoles = []SystemIntakeContactRole{
+	SystemIntakeContactRoleBUSINESSOWNER,
+	SystemIntakeContactRolePRODUCTMANAGER,
+	SystemIntakeContactRoleISSO,
+	SystemIntakeContactRoleTRBCOLLABORATOR,
+	SystemIntakeContactRoleOITSECURITYCOLLABORATOR,
+	SystemIntakeContactRoleEACOLLABORATOR,
+}
+
+// SystemIntakeContact is a person who holds a role on an intake.
+// Their name and email are resolved from CEDAR LDAP when they're added.
+type SystemIntakeContact struct {
+	ID             uuid.UUID               `json:"id"`
+	SystemIntakeID uuid.UUID               `json:"systemIntakeId" db:"system_intake_id"`
+	Role           SystemIntakeContactRole `json:"role"`
+	EUAUserID      string                  `json:"euaUserId" db:"eua_user_id"`
+	CommonName     string                  `json:"commonName" db:"common_name"`
+	Email          string                  `json:"email"`
+	Component      null.String             `json:"component"`
+	CreatedAt      *time.Time              `json:"createdAt" db:"created_at"`
+}
+
+// ContactName is the free text name an intake gives for the contact with the role
+func (si SystemIntake) ContactName(role SystemIntakeContactRole) null.String {
+	switch role {
+	case SystemIntakeContactRoleBUSINESSOWNER:
+		return si.BusinessOwner
+	case SystemIntakeContactRolePRODUCTMANAGER:
+		return si.ProductManager
+	case SystemIntakeContactRoleISSO:
+		return si.ISSOName
+	case SystemIntakeContactRoleTRBCOLLABORATOR:
+		return si.TRBCollaboratorName
+	case SystemIntakeContactRoleOITSECURITYCOLLABORATOR:
+		return si.OITSecurityCollaboratorName
+	case SystemIntakeContactRoleEACOLLABORATOR:
+		return si.EACollaboratorName
+	}
+	return null.String{}
+}
+
+// ContactComponent is the component an intake gives for the contact with the role, if it asks for one
+func (si SystemIntake) ContactComponent(role SystemIntakeContactRole) null.String {
+	switch role {
+	case SystemIntakeContactRoleBUSINESSOWNER:
+		return si.BusinessOwnerComponent
+	case SystemIntakeContactRolePRODUCTMANAGER:
+		return si.ProductManagerComponent
+	}
+	return null.String{}
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+)
+
+// SystemIntakeSearch is a full-text search over system intakes, narrowed by facet filters
+type SystemIntakeSearch struct {
+	// Query is matched against the project name, acronym, business need, solution,
+	// requester and business owner
+	Query        string                    `json:"query"`
+	Statuses     []SystemIntakeStatus      `json:"statuses"`
+	RequestTypes []SystemIntakeRequestType `json:"requestTypes"`
+	Components   []string                  `json:"components"`
+	// SubmittedAfter is inclusive and SubmittedBefore is exclusive
+	SubmittedAfter  *time.Time `json:"submittedAfter"`
+	SubmittedBefore *time.Time `json:"submittedBefore"`
+	HasLifecycleID  *bool      `json:"hasLcid"`
+	// First is how many results to return, up to MaxPageSize
+	First int `json:"first"`
+	// After is the end cursor of the previous page of results, or empty for the first page
+	After string `json:"after"`
+}
+
+// SystemIntakeSearchFacet names a facet the search results are counted by
+type SystemIntakeSearchFacet string
+
+const (
+	// SystemIntakeSearchFacetSTATUS counts results by status
+	SystemIntakeSearchFacetSTATUS SystemIntakeSearchFacet = "STATUS"
+	// SystemIntakeSearchFacetREQUESTTYPE counts results by request type
+	SystemIntakeSearchFacetREQUESTTYPE SystemIntakeSearchFacet = "REQUEST_TYPE"
+	// SystemIntakeSearchFacetCOMPONENT counts results by component
+	SystemIntakeSearchFacetCOMPONENT SystemIntakeSearchFacet = "COMPONENT"
+	// SystemIntakeSearchFacetSUBMITTEDMONTH counts results by the "YYYY-MM" they were submitted in
+	SystemIntakeSearchFacetSUBMITTEDMONTH SystemIntakeSearchFacet = "SUBMITTED_MONTH"
+	// SystemIntakeSearchFacetHASLCID counts results by whether they have an LCID, as "true" or "false"
+	SystemIntakeSearchFacetHASLCID SystemIntakeSearchFacet = "HAS_LCID"
+)
+
+// SystemIntakeSearchFacets lists every facet, in the order they are returned
+var SystemIntakeSearchFacets = []SystemIntakeSearchFacet{
+	SystemIntakeSearchFacetSTATUS,
+	SystemIntakeSearchFacetREQUESTTYPE,
+	SystemIntakeSearchFacetCOMPONENT,
+	SystemIntakeSearchFacetSUBMITTEDMONTH,
+	SystemIntakeSearchFacetHASLCID,
+}
+
+// SystemIntakeSearchFacetCount is how many results have one value of a facet
+type SystemIntakeSearchFacetCount struct {
+	Value string `json:"value" db:"value"`
+	Count int    `json:"count" db:"count"`
+}
+
+// SystemIntakeSearchFacetCounts are the counts for each value of a facet.
+// The counts ignore the search's own filter on that facet,
+// so they show how many results choosing each value would give.
+type SystemIntakeSearchFacetCounts struct {
+	Facet  SystemIntakeSearchFacet        `json:"facet"`
+	Counts []SystemIntakeSearchFacetCount `json:"counts"`
+}
+
+// SystemIntakeSearchResult holds a page of the intakes matching a search, best matches first
+type SystemIntakeSearchResult struct {
+	TotalCount int                             `json:"totalCount"`
+	PageInfo   PageInfo                        `json:"pageInfo"`
+	Results    []SystemIntake                  `json:"results"`
+	Facets     []SystemIntakeSearchFacetCounts `json:"facets"`
+}
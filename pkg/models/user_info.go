@@ -2,7 +2,7 @@ package models
 
 // UserInfo is the model for personal details of a user
 type UserInfo struct {
-	CommonName string
-	Email      string
-	EuaUserID  string
+	CommonName string `json:"commonName"`
+	Email      string `json:"email"`
+	EuaUserID  string `json:"euaUserId"`
 }
@@ -1,11 +1,13 @@
 package okta
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	jwtverifier "github.com/okta/okta-jwt-verifier-golang"
 	"go.uber.org/zap"
 
@@ -126,6 +128,31 @@ func (f oktaMiddlewareFactory) newAuthorizeMiddleware(next http.Handler) http.Ha
 	})
 }
 
+// newWebsocketInit authorizes a GraphQL websocket from the token in its connection_init payload,
+// since browsers can't set an Authorization header on the upgrade request
+func (f oktaMiddlewareFactory) newWebsocketInit(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+	logger := appcontext.ZLogger(ctx)
+	authHeader := initPayload.Authorization()
+	if authHeader == "" {
+		return nil, &apperrors.UnauthorizedError{Err: errors.New("empty authorization in connection_init payload")}
+	}
+
+	jwt, err := f.jwt(logger, authHeader)
+	if err != nil {
+		return nil, &apperrors.UnauthorizedError{Err: fmt.Errorf("unable to parse jwt: %w", err)}
+	}
+
+	principal, err := f.newPrincipal(jwt)
+	if err != nil {
+		return nil, &apperrors.UnauthorizedError{Err: fmt.Errorf("unable to get Principal from jwt: %w", err)}
+	}
+	logger = logger.With(zap.String("user", principal.ID())).With(zap.Bool("grt", principal.AllowGRT()))
+
+	ctx = appcontext.WithPrincipal(ctx, principal)
+	ctx = appcontext.WithLogger(ctx, logger)
+	return ctx, nil
+}
+
 func newJwtVerifier(clientID string, issuer string) *jwtverifier.JwtVerifier {
 	toValidate := map[string]string{}
 	toValidate["cid"] = clientID
@@ -147,8 +174,7 @@ type oktaMiddlewareFactory struct {
 	code508User   string
 }
 
-// NewOktaAuthorizeMiddleware returns a wrapper for HandlerFunc to authorize with Okta
-func NewOktaAuthorizeMiddleware(base handlers.HandlerBase, clientID string, issuer string, useTestJobCodes bool) func(http.Handler) http.Handler {
+func newOktaMiddlewareFactory(base handlers.HandlerBase, clientID string, issuer string, useTestJobCodes bool) oktaMiddlewareFactory {
 	verifier := newJwtVerifier(clientID, issuer)
 
 	// by default we want to use the PROD job codes, and only in
@@ -163,14 +189,24 @@ func NewOktaAuthorizeMiddleware(base handlers.HandlerBase, clientID string, issu
 		jobCode508User = test508UserJobCode
 	}
 
-	middlewareFactory := oktaMiddlewareFactory{
+	return oktaMiddlewareFactory{
 		HandlerBase:   base,
 		verifier:      verifier,
 		codeGRT:       jobCodeGRT,
 		code508Tester: jobCode508Tester,
 		code508User:   jobCode508User,
 	}
+}
+
+// NewOktaAuthorizeMiddleware returns a wrapper for HandlerFunc to authorize with Okta
+func NewOktaAuthorizeMiddleware(base handlers.HandlerBase, clientID string, issuer string, useTestJobCodes bool) func(http.Handler) http.Handler {
+	middlewareFactory := newOktaMiddlewareFactory(base, clientID, issuer, useTestJobCodes)
 	return func(next http.Handler) http.Handler {
 		return middlewareFactory.newAuthorizeMiddleware(next)
 	}
 }
+
+// NewOktaWebsocketInitFunc returns a GraphQL websocket init function to authorize with Okta
+func NewOktaWebsocketInitFunc(base handlers.HandlerBase, clientID string, issuer string, useTestJobCodes bool) transport.WebsocketInitFunc {
+	return newOktaMiddlewareFactory(base, clientID, issuer, useTestJobCodes).newWebsocketInit
+}
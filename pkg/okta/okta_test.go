@@ -1,12 +1,14 @@
 package okta
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	jwtverifier "github.com/okta/okta-jwt-verifier-golang"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/handlers"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
@@ -106,6 +109,45 @@ func (s OktaTestSuite) TestAuthorizeMiddleware() {
 
 }
 
+func (s OktaTestSuite) TestWebsocketInitFunc() {
+	accessToken, err := testhelpers.OktaAccessToken(s.config)
+	s.NoError(err, "couldn't get access token")
+	s.NotEmpty(accessToken, "empty access token")
+	websocketInit := NewOktaWebsocketInitFunc(
+		handlers.NewHandlerBase(s.logger),
+		s.config.GetString("OKTA_CLIENT_ID"),
+		s.config.GetString("OKTA_ISSUER"),
+		false,
+	)
+
+	s.Run("a valid token has an EUA ID in context", func() {
+		ctx, err := websocketInit(context.Background(), transport.InitPayload{
+			"authorization": fmt.Sprintf("Bearer %s", accessToken),
+		})
+
+		s.NoError(err)
+		principal := appcontext.Principal(ctx)
+		s.True(principal.AllowEASi(), "AllowEASi()")
+		s.Equal(s.config.GetString("OKTA_TEST_USERNAME"), principal.ID(), "ID()")
+	})
+
+	s.Run("an invalid token is rejected", func() {
+		_, err := websocketInit(context.Background(), transport.InitPayload{
+			"authorization": "Bearer isNotABear",
+		})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func TestWebsocketInitRequiresAuthorization(t *testing.T) {
+	websocketInit := NewOktaWebsocketInitFunc(handlers.NewHandlerBase(zap.NewNop()), "clientID", "https://issuer.example.com", false)
+
+	_, err := websocketInit(context.Background(), transport.InitPayload{})
+
+	assert.IsType(t, &apperrors.UnauthorizedError{}, err)
+}
+
 func TestJobCodes(t *testing.T) {
 	payload := `
 	{
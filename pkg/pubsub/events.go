@@ -0,0 +1,158 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// IntakeStatusChanged is published when an intake moves to a new status
+type IntakeStatusChanged struct {
+	IntakeID uuid.UUID                 `json:"intakeId"`
+	Status   models.SystemIntakeStatus `json:"status"`
+}
+
+// NoteAdded is published when a note is added to an intake
+type NoteAdded struct {
+	IntakeID uuid.UUID `json:"intakeId"`
+	NoteID   uuid.UUID `json:"noteId"`
+}
+
+// DocumentAdded is published when an accessibility request document is uploaded
+type DocumentAdded struct {
+	RequestID uuid.UUID `json:"requestId"`
+	FileID    uuid.UUID `json:"fileId"`
+}
+
+// Events publishes and subscribes to the events EASi sends in real time.
+// Events only carry IDs, so subscribers fetch what changed as themselves.
+type Events struct {
+	pubSub PubSub
+}
+
+// NewEvents returns Events sent through the given PubSub
+func NewEvents(pubSub PubSub) *Events {
+	return &Events{pubSub: pubSub}
+}
+
+func intakeStatusChannel(intakeID uuid.UUID) string {
+	return "intake_status_" + intakeID.String()
+}
+
+func noteAddedChannel(intakeID uuid.UUID) string {
+	return "note_added_" + intakeID.String()
+}
+
+func documentAddedChannel(requestID uuid.UUID) string {
+	return "document_added_" + requestID.String()
+}
+
+func (e *Events) publish(ctx context.Context, channel string, event interface{}) error {
+	// marshaling a struct of IDs and strings can't fail
+	message, _ := json.Marshal(event)
+	return e.pubSub.Publish(ctx, channel, message)
+}
+
+// PublishIntakeStatusChanged announces an intake's new status
+func (e *Events) PublishIntakeStatusChanged(ctx context.Context, intake *models.SystemIntake) error {
+	return e.publish(ctx, intakeStatusChannel(intake.ID), IntakeStatusChanged{IntakeID: intake.ID, Status: intake.Status})
+}
+
+// PublishNoteAdded announces a new note on an intake
+func (e *Events) PublishNoteAdded(ctx context.Context, note *models.Note) error {
+	return e.publish(ctx, noteAddedChannel(note.SystemIntakeID), NoteAdded{IntakeID: note.SystemIntakeID, NoteID: note.ID})
+}
+
+// PublishDocumentAdded announces a newly uploaded file
+func (e *Events) PublishDocumentAdded(ctx context.Context, file *models.UploadedFile) error {
+	return e.publish(ctx, documentAddedChannel(file.RequestID), DocumentAdded{RequestID: file.RequestID, FileID: file.ID})
+}
+
+// decode reads an event from a message, logging messages that can't be read
+func decode(ctx context.Context, channel string, message []byte, event interface{}) bool {
+	if err := json.Unmarshal(message, event); err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to decode event", zap.String("channel", channel), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// SubscribeIntakeStatusChanged returns an intake's status changes until ctx is done
+func (e *Events) SubscribeIntakeStatusChanged(ctx context.Context, intakeID uuid.UUID) (<-chan IntakeStatusChanged, error) {
+	channel := intakeStatusChannel(intakeID)
+	messages, err := e.pubSub.Subscribe(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan IntakeStatusChanged)
+	go func() {
+		defer close(events)
+		for message := range messages {
+			event := IntakeStatusChanged{}
+			if !decode(ctx, channel, message, &event) {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// SubscribeNoteAdded returns the notes added to an intake until ctx is done
+func (e *Events) SubscribeNoteAdded(ctx context.Context, intakeID uuid.UUID) (<-chan NoteAdded, error) {
+	channel := noteAddedChannel(intakeID)
+	messages, err := e.pubSub.Subscribe(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan NoteAdded)
+	go func() {
+		defer close(events)
+		for message := range messages {
+			event := NoteAdded{}
+			if !decode(ctx, channel, message, &event) {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// SubscribeDocumentAdded returns the documents uploaded to an accessibility request until ctx is done
+func (e *Events) SubscribeDocumentAdded(ctx context.Context, requestID uuid.UUID) (<-chan DocumentAdded, error) {
+	channel := documentAddedChannel(requestID)
+	messages, err := e.pubSub.Subscribe(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	events := make(chan DocumentAdded)
+	go func() {
+		defer close(events)
+		for message := range messages {
+			event := DocumentAdded{}
+			if !decode(ctx, channel, message, &event) {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,74 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+)
+
+// subscriberBuffer is how many messages a subscriber can fall behind by before messages to it are dropped
+const subscriberBuffer = 16
+
+// PubSub delivers the messages published on a channel to everyone subscribed to it.
+// Messages are bytes so a broker shared between instances, such as Postgres LISTEN/NOTIFY,
+// can stand in for the in-process one when EASi runs on more than one instance.
+type PubSub interface {
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe returns the messages published on a channel until ctx is done,
+	// when the returned channel is closed
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
+
+// InMemory is a PubSub that only delivers messages within this process
+type InMemory struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+// NewInMemory returns an in-process PubSub
+func NewInMemory() *InMemory {
+	return &InMemory{subscribers: map[string]map[chan []byte]struct{}{}}
+}
+
+// Publish sends a message to every current subscriber of a channel.
+// It never blocks: a subscriber too far behind misses the message.
+func (p *InMemory) Publish(ctx context.Context, channel string, message []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for subscriber := range p.subscribers[channel] {
+		select {
+		case subscriber <- message:
+		default:
+			appcontext.ZLogger(ctx).Warn("Dropped message for slow subscriber", zap.String("channel", channel))
+		}
+	}
+	return nil
+}
+
+// Subscribe returns the messages published on a channel until ctx is done
+func (p *InMemory) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	messages := make(chan []byte, subscriberBuffer)
+
+	p.mu.Lock()
+	if p.subscribers[channel] == nil {
+		p.subscribers[channel] = map[chan []byte]struct{}{}
+	}
+	p.subscribers[channel][messages] = struct{}{}
+	p.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subscribers[channel], messages)
+		if len(p.subscribers[channel]) == 0 {
+			delete(p.subscribers, channel)
+		}
+		close(messages)
+	}()
+
+	return messages, nil
+}
@@ -0,0 +1,128 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+type PubSubTestSuite struct {
+	suite.Suite
+}
+
+func TestPubSubTestSuite(t *testing.T) {
+	suite.Run(t, &PubSubTestSuite{Suite: suite.Suite{}})
+}
+
+// receive waits briefly for a message, failing if none comes
+func (s PubSubTestSuite) receive(messages <-chan []byte) []byte {
+	select {
+	case message := <-messages:
+		return message
+	case <-time.After(time.Second):
+		s.Fail("no message received")
+		return nil
+	}
+}
+
+func (s PubSubTestSuite) TestInMemory() {
+	s.Run("delivers messages to the channel's subscribers", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		pubSub := NewInMemory()
+
+		first, err := pubSub.Subscribe(ctx, "a")
+		s.NoError(err)
+		second, err := pubSub.Subscribe(ctx, "a")
+		s.NoError(err)
+		other, err := pubSub.Subscribe(ctx, "b")
+		s.NoError(err)
+
+		s.NoError(pubSub.Publish(ctx, "a", []byte("hello")))
+
+		s.Equal([]byte("hello"), s.receive(first))
+		s.Equal([]byte("hello"), s.receive(second))
+		s.Empty(other)
+	})
+
+	s.Run("stops delivering once the subscriber is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		pubSub := NewInMemory()
+
+		messages, err := pubSub.Subscribe(ctx, "a")
+		s.NoError(err)
+		cancel()
+
+		_, open := <-messages
+		s.False(open)
+		s.NoError(pubSub.Publish(context.Background(), "a", []byte("hello")))
+		s.Empty(pubSub.subscribers)
+	})
+
+	s.Run("drops messages for a subscriber that has fallen behind", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		pubSub := NewInMemory()
+
+		messages, err := pubSub.Subscribe(ctx, "a")
+		s.NoError(err)
+		for i := 0; i < subscriberBuffer+1; i++ {
+			s.NoError(pubSub.Publish(ctx, "a", []byte("hello")))
+		}
+		s.Len(messages, subscriberBuffer)
+	})
+}
+
+func (s PubSubTestSuite) TestEvents() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := NewEvents(NewInMemory())
+	intake := &models.SystemIntake{ID: uuid.New(), Status: models.SystemIntakeStatusREADYFORGRT}
+
+	s.Run("sends status changes to the intake's subscribers", func() {
+		changes, err := events.SubscribeIntakeStatusChanged(ctx, intake.ID)
+		s.NoError(err)
+		others, err := events.SubscribeIntakeStatusChanged(ctx, uuid.New())
+		s.NoError(err)
+
+		s.NoError(events.PublishIntakeStatusChanged(ctx, intake))
+
+		select {
+		case change := <-changes:
+			s.Equal(IntakeStatusChanged{IntakeID: intake.ID, Status: models.SystemIntakeStatusREADYFORGRT}, change)
+		case <-time.After(time.Second):
+			s.Fail("no status change received")
+		}
+		s.Empty(others)
+	})
+
+	s.Run("sends added notes to the intake's subscribers", func() {
+		notes, err := events.SubscribeNoteAdded(ctx, intake.ID)
+		s.NoError(err)
+
+		note := &models.Note{ID: uuid.New(), SystemIntakeID: intake.ID}
+		s.NoError(events.PublishNoteAdded(ctx, note))
+
+		select {
+		case added := <-notes:
+			s.Equal(NoteAdded{IntakeID: intake.ID, NoteID: note.ID}, added)
+		case <-time.After(time.Second):
+			s.Fail("no note received")
+		}
+	})
+
+	s.Run("closes subscriptions when the subscriber is done", func() {
+		subscriberCtx, subscriberCancel := context.WithCancel(ctx)
+		documents, err := events.SubscribeDocumentAdded(subscriberCtx, uuid.New())
+		s.NoError(err)
+		subscriberCancel()
+
+		_, open := <-documents
+		s.False(open)
+	})
+}
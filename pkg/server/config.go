@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"time"
 
+	"go.uber.org/zap"
+
 	"github.com/cmsgov/easi-app/pkg/appconfig"
 	"github.com/cmsgov/easi-app/pkg/appses"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/cedar/cedartransport"
 	"github.com/cmsgov/easi-app/pkg/email"
 	"github.com/cmsgov/easi-app/pkg/flags"
+	"github.com/cmsgov/easi-app/pkg/graph"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
 )
@@ -84,6 +89,33 @@ func (s Server) NewCEDARClientCheck() {
 	s.checkRequiredConfig(appconfig.CEDARAPIKey)
 }
 
+// NewCEDARTransportConfig returns how patiently the CEDAR clients call CEDAR.
+// Settings that aren't configured use the defaults.
+func (s Server) NewCEDARTransportConfig() cedartransport.Config {
+	config := cedartransport.DefaultConfig
+	if seconds := s.Config.GetInt(appconfig.CEDARTimeoutSecondsKey); seconds > 0 {
+		config.Timeout = time.Duration(seconds) * time.Second
+	}
+	if s.Config.IsSet(appconfig.CEDARMaxRetriesKey) {
+		config.MaxRetries = s.Config.GetInt(appconfig.CEDARMaxRetriesKey)
+	}
+	if s.Config.IsSet(appconfig.CEDARBreakerThresholdKey) {
+		config.BreakerThreshold = s.Config.GetInt(appconfig.CEDARBreakerThresholdKey)
+	}
+	if seconds := s.Config.GetInt(appconfig.CEDARBreakerCooldownSecondsKey); seconds > 0 {
+		config.BreakerCooldown = time.Duration(seconds) * time.Second
+	}
+	return config
+}
+
+// NewCEDARLDAPCacheTTL returns how long people looked up in CEDAR LDAP are kept
+func (s Server) NewCEDARLDAPCacheTTL() time.Duration {
+	if seconds := s.Config.GetInt(appconfig.CEDARLDAPCacheTTLSecondsKey); seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return cedarldap.DefaultCacheTTL
+}
+
 // LambdaConfig is the config to call a lambda func
 type LambdaConfig struct {
 	Endpoint     string
@@ -130,3 +162,27 @@ func (s Server) NewFlagConfig() flags.Config {
 		Timeout: timeout,
 	}
 }
+
+// NewGraphQLServerConfig returns the GraphQL server's limits.
+// When a file of persisted queries is configured, the server only runs those.
+func (s Server) NewGraphQLServerConfig() graph.ServerConfig {
+	config := graph.ServerConfig{
+		ComplexityLimit: graph.DefaultComplexityLimit,
+		DepthLimit:      graph.DefaultDepthLimit,
+	}
+	if limit := s.Config.GetInt(appconfig.GraphQLComplexityLimitKey); limit > 0 {
+		config.ComplexityLimit = limit
+	}
+	if limit := s.Config.GetInt(appconfig.GraphQLDepthLimitKey); limit > 0 {
+		config.DepthLimit = limit
+	}
+
+	if path := s.Config.GetString(appconfig.GraphQLPersistedQueriesPathKey); path != "" {
+		queries, err := graph.LoadPersistedQueries(path)
+		if err != nil {
+			s.logger.Fatal("Failed to load persisted GraphQL queries", zap.Error(err))
+		}
+		config.PersistedQueries = queries
+	}
+	return config
+}
@@ -10,11 +10,12 @@ import (
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/facebookgo/clock"
 	"github.com/gorilla/mux"
 	_ "github.com/lib/pq" // pq is required to get the postgres driver into sqlx
 	"go.uber.org/zap"
@@ -24,6 +25,7 @@ import (
 	"github.com/cmsgov/easi-app/pkg/appvalidation"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedareasi"
 	"github.com/cmsgov/easi-app/pkg/cedar/cedarldap"
+	"github.com/cmsgov/easi-app/pkg/dataloaders"
 	"github.com/cmsgov/easi-app/pkg/email"
 	"github.com/cmsgov/easi-app/pkg/flags"
 	"github.com/cmsgov/easi-app/pkg/graph"
@@ -32,6 +34,7 @@ import (
 	"github.com/cmsgov/easi-app/pkg/handlers"
 	"github.com/cmsgov/easi-app/pkg/local"
 	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pubsub"
 	"github.com/cmsgov/easi-app/pkg/services"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -39,6 +42,7 @@ import (
 
 func (s *Server) routes(
 	authorizationMiddleware func(handler http.Handler) http.Handler,
+	websocketInit transport.WebsocketInitFunc,
 	corsMiddleware func(handler http.Handler) http.Handler,
 	traceMiddleware func(handler http.Handler) http.Handler,
 	loggerMiddleware func(handler http.Handler) http.Handler) {
@@ -55,7 +59,9 @@ func (s *Server) routes(
 
 	// endpoints that dont require authorization go directly on the main router
 	s.router.HandleFunc("/api/v1/healthcheck", handlers.NewHealthCheckHandler(base, s.Config).Handle())
-	s.router.HandleFunc("/api/graph/playground", playground.Handler("GraphQL playground", "/api/graph/query"))
+	if s.environment.Local() || s.environment.Dev() {
+		s.router.HandleFunc("/api/graph/playground", playground.Handler("GraphQL playground", "/api/graph/query"))
+	}
 
 	// set up Feature Flagging utilities
 	ldClient, err := flags.NewLaunchDarklyClient(s.NewFlagConfig())
@@ -64,6 +70,7 @@ func (s *Server) routes(
 	}
 
 	// set up CEDAR client
+	cedarTransportConfig := s.NewCEDARTransportConfig()
 	var cedarEasiClient cedareasi.Client = local.NewCedarEasiClient()
 	if !(s.environment.Local() || s.environment.Test()) {
 		// check we have all of the configs for CEDAR clients
@@ -72,6 +79,7 @@ func (s *Server) routes(
 		cedarEasiClient = cedareasi.NewTranslatedClient(
 			s.Config.GetString(appconfig.CEDARAPIURL),
 			s.Config.GetString(appconfig.CEDARAPIKey),
+			cedarTransportConfig,
 			ldClient,
 		)
 		if s.environment.Deployed() {
@@ -83,10 +91,13 @@ func (s *Server) routes(
 	cedarLDAPClient = cedarldap.NewTranslatedClient(
 		s.Config.GetString(appconfig.CEDARAPIURL),
 		s.Config.GetString(appconfig.CEDARAPIKey),
+		cedarTransportConfig,
 	)
 	if s.environment.Local() || s.environment.Test() {
 		cedarLDAPClient = local.NewCedarLdapClient(s.logger)
 	}
+	// every action looks up its actor and requester, so keep who was looked up for a while
+	cedarLDAPClient = cedarldap.NewCachedClient(cedarLDAPClient, s.NewCEDARLDAPCacheTTL(), clock.New())
 
 	// set up Email Client
 	sesConfig := s.NewSESConfig()
@@ -140,57 +151,91 @@ func (s *Server) routes(
 		s.logger.Fatal("Failed to create store", zap.Error(storeErr))
 	}
 
+	// queue emails so they are saved with the change that sent them,
+	// and deliver them in the background
+	s.emailWorker = email.NewOutboxWorker(
+		email.NewOutboxConfig(),
+		s.logger,
+		clock.New(),
+		emailClient,
+		store.ClaimOutboxEmails,
+		store.UpdateOutboxEmail,
+	)
+	emailClient = emailClient.WithOutbox(store.CreateOutboxEmail)
+
 	serviceConfig := services.NewConfig(s.logger, ldClient)
 
-	// set up GraphQL routes
-	gql := s.router.PathPrefix("/api/graph").Subrouter()
-	gql.Use(authorizationMiddleware) // TODO: see comment at top-level router
-	resolver := graph.NewResolver(
-		store,
-		graph.ResolverService{
-			CreateTestDate: services.NewCreateTestDate(
-				serviceConfig,
-				services.NewAuthorizeHasEASiRole(),
-				store.CreateTestDate,
-			),
-		},
-		&s3Client,
+	// events are sent to GraphQL subscribers; the in-process pub/sub only reaches this instance
+	events := pubsub.NewEvents(pubsub.NewInMemory())
+
+	// remind requesters of expiring LCIDs on a schedule
+	s.checkLCIDExpirations = services.NewCheckLCIDExpirations(
+		serviceConfig,
+		store.FetchSystemIntakesWithLCIDExpiringBy,
+		store.LockSystemIntake,
+		store.UpdateSystemIntake,
+		store.CreateAction,
+		cedarLDAPClient.FetchUserInfo,
+		emailClient.SendLCIDExpirationReminderEmail,
+		store.WithTransaction,
+		events.PublishIntakeStatusChanged,
+		cedarEasiClient.UpdateSystemIntake,
+	)
+	s.reconcileCedarSubmissions = services.NewReconcileCedarSubmissions(
+		serviceConfig,
+		store.FetchSystemIntakesAwaitingCedar,
+		cedarEasiClient.ValidateAndSubmitSystemIntake,
+		store.UpdateSystemIntakeAlfabetID,
+		store.FetchBusinessCasesAwaitingCedar,
+		cedarEasiClient.ValidateAndSubmitBusinessCase,
+		store.UpdateBusinessCaseCedarID,
+	)
+	s.reconcileCedar = services.NewReconcileCedarIntakes(
+		serviceConfig,
+		store.FetchSystemIntakesSubmittedToCedar,
+		cedarEasiClient.FetchSystemIntakeDrift,
+		cedarEasiClient.UpdateSystemIntake,
+	)
+	s.backfillIntakeContacts = services.NewBackfillSystemIntakeContacts(
+		serviceConfig,
+		store.FetchSystemIntakes,
+		store.FetchSystemIntakeContacts,
+		cedarLDAPClient.SearchCommonNameContains,
+		cedarldap.SearchCountLimit,
+		store.CreateSystemIntakeContact,
 	)
-	gqlDirectives := generated.DirectiveRoot{HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error) {
-		hasRole, err := services.HasRole(ctx, role)
-		if err != nil {
-			return nil, err
-		}
-		if !hasRole {
-			return nil, errors.New("not authorized")
-		}
-		return next(ctx)
-	}}
-	gqlConfig := generated.Config{Resolvers: resolver, Directives: gqlDirectives}
-	graphqlServer := handler.NewDefaultServer(generated.NewExecutableSchema(gqlConfig))
-	gql.Handle("/query", graphqlServer)
 
 	// API base path is versioned
 	api := s.router.PathPrefix("/api/v1").Subrouter()
 	api.Use(authorizationMiddleware) // TODO: see comment at top-level router
 
+	// services shared by the REST and GraphQL APIs
+	createSystemIntake := services.NewCreateSystemIntake(
+		serviceConfig,
+		store.CreateSystemIntake,
+	)
+	updateSystemIntake := services.NewUpdateSystemIntake(
+		serviceConfig,
+		store.FetchSystemIntakeByID,
+		store.UpdateSystemIntake,
+		services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+	)
+	fetchSystemIntakeByID := services.NewFetchSystemIntakeByID(
+		serviceConfig,
+		store.FetchSystemIntakeByID,
+		services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+	)
+	fetchBusinessCaseByID := services.NewFetchBusinessCaseByID(
+		serviceConfig,
+		store.FetchBusinessCaseByID,
+		services.NewAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode(),
+	)
+
 	systemIntakeHandler := handlers.NewSystemIntakeHandler(
 		base,
-		services.NewCreateSystemIntake(
-			serviceConfig,
-			store.CreateSystemIntake,
-		),
-		services.NewUpdateSystemIntake(
-			serviceConfig,
-			store.FetchSystemIntakeByID,
-			store.UpdateSystemIntake,
-			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
-		),
-		services.NewFetchSystemIntakeByID(
-			serviceConfig,
-			store.FetchSystemIntakeByID,
-			services.NewAuthorizeHasEASiRole(),
-		),
+		createSystemIntake,
+		updateSystemIntake,
+		fetchSystemIntakeByID,
 		services.NewArchiveSystemIntake(
 			serviceConfig,
 			store.FetchSystemIntakeByID,
@@ -202,6 +247,8 @@ func (s *Server) routes(
 			),
 			services.NewAuthorizeUserIsIntakeRequester(),
 			emailClient.SendWithdrawRequestEmail,
+			events.PublishIntakeStatusChanged,
+			cedarEasiClient.UpdateSystemIntake,
 		),
 	)
 	api.Handle("/system_intake/{intake_id}", systemIntakeHandler.Handle())
@@ -216,16 +263,35 @@ func (s *Server) routes(
 			store.FetchSystemIntakesByStatuses,
 			services.NewAuthorizeHasEASiRole(),
 		),
+		services.NewFetchSystemIntakesPage(
+			serviceConfig,
+			store.FetchSystemIntakesPage,
+			services.NewAuthorizeHasEASiRole(),
+		),
 	)
 	api.Handle("/system_intakes", systemIntakesHandler.Handle())
 
-	businessCaseHandler := handlers.NewBusinessCaseHandler(
+	systemIntakeSearchHandler := handlers.NewSystemIntakeSearchHandler(
 		base,
-		services.NewFetchBusinessCaseByID(
+		services.NewSearchSystemIntakes(
 			serviceConfig,
-			store.FetchBusinessCaseByID,
-			services.NewAuthorizeHasEASiRole(),
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.SearchSystemIntakes,
 		),
+	)
+	api.Handle("/system_intakes/search", systemIntakeSearchHandler.Handle())
+
+	searchUsers := services.NewSearchUsers(
+		serviceConfig,
+		services.NewAuthorizeHasEASiRole(),
+		cedarLDAPClient.SearchCommonNameContains,
+	)
+	userSearchHandler := handlers.NewUserSearchHandler(base, searchUsers)
+	api.Handle("/users/search", userSearchHandler.Handle())
+
+	businessCaseHandler := handlers.NewBusinessCaseHandler(
+		base,
+		fetchBusinessCaseByID,
 		services.NewCreateBusinessCase(
 			serviceConfig,
 			store.FetchSystemIntakeByID,
@@ -234,6 +300,8 @@ func (s *Server) routes(
 			cedarLDAPClient.FetchUserInfo,
 			store.CreateBusinessCase,
 			store.UpdateSystemIntake,
+			store.WithTransaction,
+			events.PublishIntakeStatusChanged,
 		),
 		services.NewUpdateBusinessCase(
 			serviceConfig,
@@ -241,6 +309,7 @@ func (s *Server) routes(
 			services.NewAuthorizeUserIsBusinessCaseRequester(),
 			store.UpdateBusinessCase,
 		),
+		services.SummarizeLifecycleCosts,
 	)
 	api.Handle("/business_case/{business_case_id}", businessCaseHandler.Handle())
 	api.Handle("/business_case", businessCaseHandler.Handle())
@@ -252,6 +321,11 @@ func (s *Server) routes(
 			store.FetchBusinessCasesByEuaID,
 			services.NewAuthorizeHasEASiRole(),
 		),
+		services.NewFetchBusinessCasesPageByEuaID(
+			serviceConfig,
+			store.FetchBusinessCasesPageByEuaID,
+			services.NewAuthorizeHasEASiRole(),
+		),
 	)
 	api.Handle("/business_cases", businessCasesHandler.Handle())
 
@@ -265,273 +339,381 @@ func (s *Server) routes(
 		store.CreateAction,
 		cedarLDAPClient.FetchUserInfo,
 	)
-	actionHandler := handlers.NewActionHandler(
-		base,
-		services.NewTakeAction(
-			store.FetchSystemIntakeByID,
-			map[models.ActionType]services.ActionExecuter{
-				models.ActionTypeSUBMITINTAKE: services.NewSubmitSystemIntake(
+	closeBusinessCase := services.NewCloseBusinessCase(
+		serviceConfig,
+		store.FetchBusinessCaseByID,
+		store.UpdateBusinessCase,
+	)
+	governanceWorkflow, err := services.NewGovernanceWorkflow(
+		services.GovernanceTransitions(),
+		map[services.GovernanceEffect]func(services.GovernanceTransition) services.ActionExecuter{
+			services.GovernanceEffectSubmitIntake: func(transition services.GovernanceTransition) services.ActionExecuter {
+				return services.NewSubmitSystemIntake(
 					serviceConfig,
 					services.NewAuthorizeUserIsIntakeRequester(),
 					store.UpdateSystemIntake,
+					cedareasi.ValidateSystemIntakeForCedar,
 					cedarEasiClient.ValidateAndSubmitSystemIntake,
+					store.UpdateSystemIntakeAlfabetID,
 					saveAction,
 					emailClient.SendSystemIntakeSubmissionEmail,
-				),
-				models.ActionTypeNOTITREQUEST: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusNOTITREQUEST,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeNEEDBIZCASE: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusNEEDBIZCASE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeREADYFORGRT: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusREADYFORGRT,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypePROVIDEFEEDBACKNEEDBIZCASE: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusNEEDBIZCASE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeREADYFORGRB: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusREADYFORGRB,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeSUBMITBIZCASE: services.NewSubmitBusinessCase(
-					serviceConfig,
-					services.NewAuthorizeUserIsIntakeRequester(),
-					store.FetchOpenBusinessCaseByIntakeID,
-					appvalidation.BusinessCaseForSubmit,
-					saveAction,
-					store.UpdateSystemIntake,
-					store.UpdateBusinessCase,
-					emailClient.SendBusinessCaseSubmissionEmail,
-					models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
-				),
-				models.ActionTypeSUBMITFINALBIZCASE: services.NewSubmitBusinessCase(
+				)
+			},
+			services.GovernanceEffectSubmitBusinessCase: func(transition services.GovernanceTransition) services.ActionExecuter {
+				return services.NewSubmitBusinessCase(
 					serviceConfig,
 					services.NewAuthorizeUserIsIntakeRequester(),
 					store.FetchOpenBusinessCaseByIntakeID,
-					appvalidation.BusinessCaseForSubmit,
+					map[models.ActionType]func(*models.BusinessCase) error{
+						models.ActionTypeSUBMITBIZCASE:      appvalidation.BusinessCaseForDraftSubmission,
+						models.ActionTypeSUBMITFINALBIZCASE: appvalidation.BusinessCaseForFinalSubmission,
+					},
+					cedarEasiClient.ValidateAndSubmitBusinessCase,
+					store.UpdateBusinessCaseCedarID,
 					saveAction,
 					store.UpdateSystemIntake,
 					store.UpdateBusinessCase,
+					store.CreateBusinessCaseVersion,
 					emailClient.SendBusinessCaseSubmissionEmail,
-					models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
-				),
-				models.ActionTypeBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusBIZCASECHANGESNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypePROVIDEFEEDBACKBIZCASENEEDSCHANGES: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusBIZCASECHANGESNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypePROVIDEFEEDBACKBIZCASEFINAL: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusBIZCASEFINALNEEDED,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeNOGOVERNANCENEEDED: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusNOGOVERNANCE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeSENDEMAIL: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusSHUTDOWNINPROGRESS,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					false,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeGUIDERECEIVEDCLOSE: services.NewTakeActionUpdateStatus(
-					serviceConfig,
-					models.SystemIntakeStatusSHUTDOWNCOMPLETE,
-					store.UpdateSystemIntake,
-					services.NewAuthorizeRequireGRTJobCode(),
-					saveAction,
-					cedarLDAPClient.FetchUserInfo,
-					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
-				models.ActionTypeNOTRESPONDINGCLOSE: services.NewTakeActionUpdateStatus(
+					transition.To,
+				)
+			},
+			services.GovernanceEffectReview: func(transition services.GovernanceTransition) services.ActionExecuter {
+				return services.NewTakeActionUpdateStatus(
 					serviceConfig,
-					models.SystemIntakeStatusNOGOVERNANCE,
+					transition.To,
 					store.UpdateSystemIntake,
 					services.NewAuthorizeRequireGRTJobCode(),
 					saveAction,
 					cedarLDAPClient.FetchUserInfo,
+					store.FetchSystemIntakeContacts,
 					emailClient.SendSystemIntakeReviewEmail,
-					true,
-					services.NewCloseBusinessCase(
-						serviceConfig,
-						store.FetchBusinessCaseByID,
-						store.UpdateBusinessCase,
-					),
-				),
+					transition.CloseBusinessCase,
+					closeBusinessCase,
+				)
 			},
-		),
-		services.NewFetchActionsByRequestID(
-			services.NewAuthorizeRequireGRTJobCode(),
-			store.GetActionsByRequestID,
-		),
+		},
+	)
+	if err != nil {
+		s.logger.Fatal("Failed to create governance workflow", zap.Error(err))
+	}
+	takeAction := services.NewTakeAction(
+		store.FetchSystemIntakeByID,
+		services.NewAuthorizeGovernanceRole(),
+		governanceWorkflow,
+		store.WithTransaction,
+		events.PublishIntakeStatusChanged,
+		cedarEasiClient.UpdateSystemIntake,
+	)
+	fetchActions := services.NewFetchActionsByRequestID(
+		services.NewAuthorizeRequireGRTJobCode(),
+		store.GetActionsByRequestID,
+	)
+	actionHandler := handlers.NewActionHandler(
+		base,
+		takeAction,
+		fetchActions,
 	)
 	api.Handle("/system_intake/{intake_id}/actions", actionHandler.Handle())
 
+	issueLifecycleID := services.NewUpdateLifecycleFields(
+		serviceConfig,
+		services.NewAuthorizeRequireGRTJobCode(),
+		store.FetchSystemIntakeByID,
+		store.UpdateSystemIntake,
+		saveAction,
+		cedarLDAPClient.FetchUserInfo,
+		store.FetchSystemIntakeContacts,
+		emailClient.SendIssueLCIDEmail,
+		store.GenerateLifecycleID,
+		store.WithTransaction,
+		events.PublishIntakeStatusChanged,
+		cedarEasiClient.UpdateSystemIntake,
+	)
 	systemIntakeLifecycleIDHandler := handlers.NewSystemIntakeLifecycleIDHandler(
 		base,
-		services.NewUpdateLifecycleFields(
+		issueLifecycleID,
+	)
+	api.Handle("/system_intake/{intake_id}/lcid", systemIntakeLifecycleIDHandler.Handle())
+
+	extendLifecycleIDHandler := handlers.NewSystemIntakeLCIDChangeHandler(
+		base,
+		services.NewExtendLifecycleID(
 			serviceConfig,
 			services.NewAuthorizeRequireGRTJobCode(),
 			store.FetchSystemIntakeByID,
 			store.UpdateSystemIntake,
 			saveAction,
 			cedarLDAPClient.FetchUserInfo,
-			emailClient.SendIssueLCIDEmail,
-			store.GenerateLifecycleID,
+			store.FetchSystemIntakeContacts,
+			emailClient.SendExtendLCIDEmail,
+			store.WithTransaction,
+			events.PublishIntakeStatusChanged,
+			cedarEasiClient.UpdateSystemIntake,
 		),
 	)
-	api.Handle("/system_intake/{intake_id}/lcid", systemIntakeLifecycleIDHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/lcid/extend", extendLifecycleIDHandler.Handle())
 
-	systemIntakeRejectionHandler := handlers.NewSystemIntakeRejectionHandler(
+	amendLifecycleIDHandler := handlers.NewSystemIntakeLCIDChangeHandler(
+		base,
+		services.NewAmendLifecycleID(
+			serviceConfig,
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchSystemIntakeByID,
+			store.UpdateSystemIntake,
+			saveAction,
+			cedarLDAPClient.FetchUserInfo,
+			store.FetchSystemIntakeContacts,
+			emailClient.SendAmendLCIDEmail,
+			store.WithTransaction,
+			events.PublishIntakeStatusChanged,
+			cedarEasiClient.UpdateSystemIntake,
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/lcid/amend", amendLifecycleIDHandler.Handle())
+
+	retireLifecycleIDHandler := handlers.NewSystemIntakeLCIDChangeHandler(
 		base,
-		services.NewUpdateRejectionFields(
+		services.NewRetireLifecycleID(
 			serviceConfig,
 			services.NewAuthorizeRequireGRTJobCode(),
 			store.FetchSystemIntakeByID,
 			store.UpdateSystemIntake,
 			saveAction,
 			cedarLDAPClient.FetchUserInfo,
-			emailClient.SendRejectRequestEmail,
+			store.FetchSystemIntakeContacts,
+			emailClient.SendRetireLCIDEmail,
+			store.WithTransaction,
+			events.PublishIntakeStatusChanged,
+			cedarEasiClient.UpdateSystemIntake,
 		),
 	)
+	api.Handle("/system_intake/{intake_id}/lcid/retire", retireLifecycleIDHandler.Handle())
+
+	rejectIntake := services.NewUpdateRejectionFields(
+		serviceConfig,
+		services.NewAuthorizeRequireGRTJobCode(),
+		store.FetchSystemIntakeByID,
+		store.UpdateSystemIntake,
+		saveAction,
+		cedarLDAPClient.FetchUserInfo,
+		store.FetchSystemIntakeContacts,
+		emailClient.SendRejectRequestEmail,
+		store.WithTransaction,
+		events.PublishIntakeStatusChanged,
+		cedarEasiClient.UpdateSystemIntake,
+	)
+	systemIntakeRejectionHandler := handlers.NewSystemIntakeRejectionHandler(
+		base,
+		rejectIntake,
+	)
 	api.Handle("/system_intake/{intake_id}/reject", systemIntakeRejectionHandler.Handle())
 
+	fetchNotes := services.NewFetchNotes(
+		serviceConfig,
+		store.FetchNotesBySystemIntakeID,
+		services.NewAuthorizeRequireGRTJobCode(),
+	)
+	createNote := services.NewCreateNote(
+		serviceConfig,
+		store.CreateNote,
+		services.NewAuthorizeRequireGRTJobCode(),
+		events.PublishNoteAdded,
+	)
 	notesHandler := handlers.NewNotesHandler(
 		base,
-		services.NewFetchNotes(
+		fetchNotes,
+		createNote,
+	)
+	api.Handle("/system_intake/{intake_id}/notes", notesHandler.Handle())
+
+	fetchRelatedIntakes := services.NewFetchRelatedSystemIntakes(
+		serviceConfig,
+		services.NewAuthorizeHasEASiRole(),
+		store.FetchSystemIntakesByContactEUAID,
+	)
+	systemIntakeContactsHandler := handlers.NewSystemIntakeContactsHandler(
+		base,
+		services.NewFetchSystemIntakeContacts(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.FetchSystemIntakeContacts,
+		),
+		services.NewCreateSystemIntakeContact(
+			serviceConfig,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.FetchSystemIntakeContacts,
+			cedarLDAPClient.FetchUserInfo,
+			store.CreateSystemIntakeContact,
+		),
+		services.NewDeleteSystemIntakeContact(
 			serviceConfig,
-			store.FetchNotesBySystemIntakeID,
+			store.FetchSystemIntakeContactByID,
+			store.FetchSystemIntakeByID,
+			services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+			store.DeleteSystemIntakeContact,
+		),
+		fetchRelatedIntakes,
+	)
+	api.Handle("/system_intake/{intake_id}/contacts", systemIntakeContactsHandler.Handle())
+	api.Handle("/system_intake/{intake_id}/contacts/{contact_id}", systemIntakeContactsHandler.Handle())
+	api.Handle("/system_intakes/related", systemIntakeContactsHandler.HandleRelated())
+
+	auditEntriesHandler := handlers.NewAuditEntriesHandler(
+		base,
+		services.NewFetchAuditEntries(
+			serviceConfig,
+			store.FetchAuditEntries,
+			services.NewAuthorizeRequireGRTJobCode(),
+		),
+	)
+	api.Handle("/system_intake/{intake_id}/history", auditEntriesHandler.Handle(models.AuditEntitySystemIntake, "intake_id"))
+	api.Handle("/business_case/{business_case_id}/history", auditEntriesHandler.Handle(models.AuditEntityBusinessCase, "business_case_id"))
+
+	businessCaseVersionsHandler := handlers.NewBusinessCaseVersionsHandler(
+		base,
+		services.NewFetchBusinessCaseVersions(
+			serviceConfig,
+			store.FetchBusinessCaseVersions,
 			services.NewAuthorizeRequireGRTJobCode(),
 		),
-		services.NewCreateNote(
+		services.NewFetchBusinessCaseVersionDiff(
 			serviceConfig,
-			store.CreateNote,
+			store.FetchBusinessCaseVersion,
 			services.NewAuthorizeRequireGRTJobCode(),
 		),
 	)
-	api.Handle("/system_intake/{intake_id}/notes", notesHandler.Handle())
+	api.Handle("/business_case/{business_case_id}/versions", businessCaseVersionsHandler.Handle())
+	api.Handle("/business_case/{business_case_id}/versions/diff", businessCaseVersionsHandler.HandleDiff())
+
+	// set up GraphQL routes
+	gql := s.router.PathPrefix("/api/graph").Subrouter()
+	gql.Use(func(next http.Handler) http.Handler {
+		authorized := authorizationMiddleware(next) // TODO: see comment at top-level router
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// browsers can't set headers on a websocket upgrade, so subscriptions
+			// are authorized by websocketInit from their connection_init payload
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			authorized.ServeHTTP(w, r)
+		})
+	})
+	gql.Use(dataloaders.NewMiddleware(dataloaders.Fetchers{
+		FetchSystemsByIntakeIDs:             store.FetchSystemsByIntakeIDs,
+		FetchFilesByAccessibilityRequestIDs: store.FetchFilesByAccessibilityRequestIDs,
+		FetchBusinessCasesByIDs: services.NewFetchBusinessCasesByIDs(
+			serviceConfig,
+			store.FetchBusinessCasesByIDs,
+			services.NewAuthorizeHasEASiRole(),
+		),
+		FetchNotesBySystemIntakeIDs: services.NewFetchNotesBySystemIntakeIDs(
+			serviceConfig,
+			store.FetchNotesBySystemIntakeIDs,
+			services.NewAuthorizeRequireGRTJobCode(),
+		),
+		FetchActionsBySystemIntakeIDs: services.NewFetchActionsByRequestIDs(
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.GetActionsByRequestIDs,
+		),
+	}))
+	resolver := graph.NewResolver(
+		store,
+		graph.ResolverService{
+			CreateTestDate: services.NewCreateTestDate(
+				serviceConfig,
+				services.NewAuthorizeHasEASiRole(),
+				store.CreateTestDate,
+			),
+			SearchSystemIntakes: services.NewSearchSystemIntakes(
+				serviceConfig,
+				services.NewAuthorizeRequireGRTJobCode(),
+				store.SearchSystemIntakes,
+			),
+			FetchSystemIntakeByID:   fetchSystemIntakeByID,
+			CreateSystemIntake:      createSystemIntake,
+			UpdateSystemIntake:      updateSystemIntake,
+			TakeAction:              takeAction,
+			IssueLifecycleID:        issueLifecycleID,
+			RejectIntake:            rejectIntake,
+			CreateNote:              createNote,
+			FetchBusinessCaseByID:   fetchBusinessCaseByID,
+			FetchRelatedIntakes:     fetchRelatedIntakes,
+			SummarizeLifecycleCosts: services.SummarizeLifecycleCosts,
+			SearchUsers:             searchUsers,
+			SubscribeIntakeStatusChanged: services.NewSubscribeIntakeStatusChanged(
+				serviceConfig,
+				store.FetchSystemIntakeByID,
+				services.NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode(),
+				events.SubscribeIntakeStatusChanged,
+			),
+			SubscribeNoteAdded: services.NewSubscribeNoteAdded(
+				serviceConfig,
+				store.FetchNoteByID,
+				services.NewAuthorizeRequireGRTJobCode(),
+				events.SubscribeNoteAdded,
+			),
+			SubscribeDocumentAdded: services.NewSubscribeDocumentAdded(
+				serviceConfig,
+				store.FetchUploadedFileByID,
+				services.NewAuthorizeRequireGRTJobCode(),
+				events.SubscribeDocumentAdded,
+			),
+		},
+		&s3Client,
+	)
+	gqlDirectives := generated.DirectiveRoot{
+		HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error) {
+			hasRole, err := services.HasRole(ctx, role)
+			if err != nil {
+				return nil, err
+			}
+			if !hasRole {
+				return nil, errors.New("not authorized")
+			}
+			return next(ctx)
+		},
+		IsRequesterOrRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (res interface{}, err error) {
+			ok, err := services.IsRequesterOrHasRole(ctx, obj, role)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, errors.New("not authorized")
+			}
+			return next(ctx)
+		},
+	}
+	gqlConfig := generated.Config{Resolvers: resolver, Directives: gqlDirectives}
+	gqlServerConfig := s.NewGraphQLServerConfig()
+	gqlServerConfig.WebsocketInit = websocketInit
+	graphqlServer := graph.NewServer(generated.NewExecutableSchema(gqlConfig), gqlServerConfig)
+	graphqlServer.Use(dataloaders.SubscriptionExtension{})
+	gql.Handle("/query", graphqlServer)
+
+	emailOutboxHandler := handlers.NewEmailOutboxHandler(
+		base,
+		services.NewFetchFailedOutboxEmails(
+			serviceConfig,
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchFailedOutboxEmails,
+		),
+	)
+	api.Handle("/email_outbox", emailOutboxHandler.Handle())
+
+	emailOutboxRedriveHandler := handlers.NewEmailOutboxRedriveHandler(
+		base,
+		services.NewRedriveOutboxEmail(
+			serviceConfig,
+			services.NewAuthorizeRequireGRTJobCode(),
+			store.FetchOutboxEmailByID,
+			store.UpdateOutboxEmail,
+		),
+	)
+	api.Handle("/email_outbox/{email_id}/redrive", emailOutboxRedriveHandler.Handle())
 
 	// File Upload Handlers
 	fileUploadHandler := handlers.NewFileUploadHandler(
@@ -539,7 +721,8 @@ func (s *Server) routes(
 		services.NewCreateUploadedFile(
 			serviceConfig,
 			services.NewAuthorizeRequireGRTJobCode(),
-			store.CreateUploadedFile),
+			store.CreateUploadedFile,
+			events.PublishDocumentAdded),
 		services.NewFetchUploadedFile(
 			serviceConfig,
 			services.NewAuthorizeRequireGRTJobCode(),
@@ -2,9 +2,11 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/oklog/run"
@@ -12,8 +14,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/email"
 	"github.com/cmsgov/easi-app/pkg/handlers"
 	"github.com/cmsgov/easi-app/pkg/local"
+	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/okta"
 )
 
@@ -23,8 +28,17 @@ type Server struct {
 	Config      *viper.Viper
 	logger      *zap.Logger
 	environment appconfig.Environment
+	emailWorker email.OutboxWorker
+
+	checkLCIDExpirations      func(context.Context) error
+	reconcileCedarSubmissions func(context.Context, bool) ([]models.SystemIntakeCedarDrift, error)
+	reconcileCedar            func(context.Context, bool) ([]models.SystemIntakeCedarDrift, error)
+	backfillIntakeContacts    func(context.Context) (int, error)
 }
 
+// lcidExpirationCheckInterval is how often the server looks for expiring LCIDs
+const lcidExpirationCheckInterval = time.Hour
+
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.router.ServeHTTP(w, r)
 }
@@ -60,9 +74,17 @@ func NewServer(config *viper.Viper) *Server {
 		config.GetBool("ALT_JOB_CODES"),
 	)
 
+	websocketInit := okta.NewOktaWebsocketInitFunc(
+		handlers.NewHandlerBase(zapLogger),
+		config.GetString("OKTA_CLIENT_ID"),
+		config.GetString("OKTA_ISSUER"),
+		config.GetBool("ALT_JOB_CODES"),
+	)
+
 	// If we're local use override with local auth middleware
 	if environment.Local() {
 		authMiddleware = local.NewLocalAuthorizeMiddleware(zapLogger, config.GetString("LOCAL_TEST_EUAID"))
+		websocketInit = local.NewLocalWebsocketInitFunc(zapLogger, config.GetString("LOCAL_TEST_EUAID"))
 	}
 
 	// set up server dependencies
@@ -78,6 +100,7 @@ func NewServer(config *viper.Viper) *Server {
 	// set up routes
 	s.routes(
 		authMiddleware,
+		websocketInit,
 		newCORSMiddleware(clientAddress),
 		NewTraceMiddleware(zapLogger),
 		NewLoggerMiddleware(zapLogger))
@@ -121,5 +144,69 @@ func Serve(config *viper.Viper) {
 		s.logger.Info("Entered https server interrupt function")
 	})
 
+	workerCtx, cancelWorker := context.WithCancel(context.Background())
+	g.Add(func() error {
+		s.logger.Info("Delivering queued emails")
+		s.emailWorker.Run(workerCtx)
+		return nil
+	}, func(error) {
+		s.logger.Info("Entered email worker interrupt function")
+		cancelWorker()
+	})
+
+	lcidCtx, cancelLCID := context.WithCancel(appcontext.WithLogger(context.Background(), s.logger))
+	g.Add(func() error {
+		s.logger.Info("Checking LCID expirations")
+		ticker := time.NewTicker(lcidExpirationCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lcidCtx.Done():
+				return nil
+			case <-ticker.C:
+				if err := s.checkLCIDExpirations(lcidCtx); err != nil {
+					s.logger.Error("Failed to check LCID expirations", zap.Error(err))
+				}
+			}
+		}
+	}, func(error) {
+		s.logger.Info("Entered LCID expiration interrupt function")
+		cancelLCID()
+	})
+
 	log.Fatal(g.Run())
 }
+
+// CheckLCIDExpirations runs the LCID expiration check once,
+// then delivers the reminder emails it queued
+func CheckLCIDExpirations(config *viper.Viper) error {
+	s := NewServer(config)
+	ctx := appcontext.WithLogger(context.Background(), s.logger)
+	if err := s.checkLCIDExpirations(ctx); err != nil {
+		return err
+	}
+	return s.emailWorker.DeliverDue(ctx)
+}
+
+// ReconcileCedar finds the submitted intakes and business cases CEDAR never got, then compares
+// every intake submitted to CEDAR with what CEDAR has for it. When repairing, it submits
+// what's missing and sends EASi's version of the intakes that differ.
+func ReconcileCedar(config *viper.Viper, repair bool) ([]models.SystemIntakeCedarDrift, error) {
+	s := NewServer(config)
+	ctx := appcontext.WithLogger(context.Background(), s.logger)
+	missing, submitErr := s.reconcileCedarSubmissions(ctx, repair)
+	drift, err := s.reconcileCedar(ctx, repair)
+	drift = append(missing, drift...)
+	if submitErr != nil {
+		return drift, submitErr
+	}
+	return drift, err
+}
+
+// BackfillIntakeContacts adds the contacts that intakes only name in free text,
+// where CEDAR LDAP has exactly one person by that name
+func BackfillIntakeContacts(config *viper.Viper) (int, error) {
+	s := NewServer(config)
+	ctx := appcontext.WithLogger(context.Background(), s.logger)
+	return s.backfillIntakeContacts(ctx)
+}
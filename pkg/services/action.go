@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
 
 	"github.com/google/uuid"
-	"github.com/guregu/null"
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
@@ -19,7 +21,11 @@ type ActionExecuter func(context.Context, *models.SystemIntake, *models.Action)
 // NewTakeAction is a service to create and execute an action
 func NewTakeAction(
 	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
-	actionTypeMap map[models.ActionType]ActionExecuter,
+	authorize func(context.Context, GovernanceRole, *models.SystemIntake) (bool, error),
+	workflow GovernanceWorkflow,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
 ) func(context.Context, *models.Action) error {
 	return func(ctx context.Context, action *models.Action) error {
 		intake, fetchErr := fetch(ctx, *action.IntakeID)
@@ -31,14 +37,87 @@ func NewTakeAction(
 			}
 		}
 
-		if executeAction, ok := actionTypeMap[action.ActionType]; ok {
-			return executeAction(ctx, intake, action)
+		step, ok := workflow[action.ActionType]
+		if !ok {
+			return &apperrors.ResourceConflictError{
+				Err:        errors.New("invalid action type"),
+				Resource:   intake,
+				ResourceID: intake.ID.String(),
+			}
+		}
+
+		ok, err := authorize(ctx, step.Transition.Role, intake)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: fmt.Errorf("failed to authorize action %s", action.ActionType)}
+		}
+
+		if !step.Transition.AllowedFrom(intake.Status) {
+			return &apperrors.ResourceConflictError{
+				Err: fmt.Errorf(
+					"action %s is not allowed on an intake with status %s",
+					action.ActionType,
+					intake.Status,
+				),
+				Resource:   intake,
+				ResourceID: intake.ID.String(),
+			}
+		}
+
+		// the action, intake and business case are saved together,
+		// and emails are only sent once they have been
+		previousStatus := intake.Status
+		// an intake is only updated in CEDAR once it's been submitted there
+		submittedToCedar := intake.AlfabetID.ValueOrZero() != ""
+		var changed *models.SystemIntake
+		err = withTransaction(ctx, func(ctx context.Context) error {
+			if err := step.Execute(ctx, intake, action); err != nil {
+				return err
+			}
+			updated, err := fetch(ctx, intake.ID)
+			if err != nil {
+				return err
+			}
+			if updated.Status != previousStatus {
+				changed = updated
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
-		return &apperrors.ResourceConflictError{
-			Err:        errors.New("invalid action type"),
-			Resource:   intake,
-			ResourceID: intake.ID.String(),
+
+		if changed != nil {
+			publishIntakeStatusChanged(ctx, publishStatusChanged, changed)
+			if submittedToCedar {
+				syncSystemIntakeToCedar(ctx, updateCedar, changed)
+			}
 		}
+		return nil
+	}
+}
+
+// publishIntakeStatusChanged announces an intake's new status once the transaction on the context commits,
+// or right away if there is no transaction.
+// The status has already changed, so failing to announce it is logged rather than returned.
+func publishIntakeStatusChanged(
+	ctx context.Context,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	intake *models.SystemIntake,
+) {
+	publish := func(ctx context.Context) {
+		if err := publishStatusChanged(ctx, intake); err != nil {
+			appcontext.ZLogger(ctx).Error(
+				"Failed to publish intake status change",
+				zap.Error(err),
+				zap.String("intakeID", intake.ID.String()),
+			)
+		}
+	}
+	if !appcontext.AfterCommit(ctx, publish) {
+		publish(ctx)
 	}
 }
 
@@ -77,12 +156,17 @@ func NewSaveAction(
 }
 
 // NewSubmitSystemIntake returns a function that
-// executes submit of a system intake
+// executes submit of a system intake.
+// The intake is sent to CEDAR once the submission commits, so a rolled back
+// submission never leaves an intake behind in CEDAR to be duplicated on resubmit.
+// If CEDAR can't be reached, reconcile-cedar submits the intake later.
 func NewSubmitSystemIntake(
 	config Config,
 	authorize func(context.Context, *models.SystemIntake) (bool, error),
 	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
-	validateAndSubmit func(context.Context, *models.SystemIntake) (string, error),
+	validate func(context.Context, *models.SystemIntake) error,
+	submitToCedar func(context.Context, *models.SystemIntake) (string, error),
+	saveAlfabetID func(context.Context, uuid.UUID, string) (*models.SystemIntake, error),
 	saveAction func(context.Context, *models.Action) error,
 	emailReviewer func(ctx context.Context, requester string, intakeID uuid.UUID) error,
 ) ActionExecuter {
@@ -92,7 +176,7 @@ func NewSubmitSystemIntake(
 			return err
 		}
 		if !ok {
-			return &apperrors.UnauthorizedError{Err: err}
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize submit system intake")}
 		}
 
 		updatedTime := config.clock.Now()
@@ -109,11 +193,9 @@ func NewSubmitSystemIntake(
 		}
 
 		intake.SubmittedAt = &updatedTime
-		alfabetID, validateAndSubmitErr := validateAndSubmit(ctx, intake)
-		if validateAndSubmitErr != nil {
-			return validateAndSubmitErr
+		if err = validate(ctx, intake); err != nil {
+			return err
 		}
-		intake.AlfabetID = null.StringFrom(alfabetID)
 
 		err = saveAction(ctx, action)
 		if err != nil {
@@ -132,6 +214,21 @@ func NewSubmitSystemIntake(
 				Operation: apperrors.QuerySave,
 			}
 		}
+
+		submitted := intake
+		submit := func(ctx context.Context) {
+			if _, err := submitSystemIntakeToCedar(ctx, submitToCedar, saveAlfabetID, submitted); err != nil {
+				appcontext.ZLogger(ctx).Error(
+					"Failed to submit intake to CEDAR, leaving it for reconcile-cedar",
+					zap.Error(err),
+					zap.String("intakeID", submitted.ID.String()),
+				)
+			}
+		}
+		if !appcontext.AfterCommit(ctx, submit) {
+			submit(ctx)
+		}
+
 		// only send an email when everything went ok
 		err = emailReviewer(ctx, intake.Requester, intake.ID)
 		if err != nil {
@@ -148,10 +245,13 @@ func NewSubmitBusinessCase(
 	config Config,
 	authorize func(context.Context, *models.SystemIntake) (bool, error),
 	fetchOpenBusinessCase func(context.Context, uuid.UUID) (*models.BusinessCase, error),
-	validateForSubmit func(businessCase *models.BusinessCase) error,
+	validateForSubmit map[models.ActionType]func(*models.BusinessCase) error,
+	validateAndSubmit func(context.Context, *models.BusinessCase) (string, error),
+	saveCedarID func(context.Context, uuid.UUID, string) (*models.BusinessCase, error),
 	saveAction func(context.Context, *models.Action) error,
 	updateIntake func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
 	updateBusinessCase func(context.Context, *models.BusinessCase) (*models.BusinessCase, error),
+	createVersion func(context.Context, *models.BusinessCaseVersion) (*models.BusinessCaseVersion, error),
 	sendEmail func(ctx context.Context, requester string, intakeID uuid.UUID) error,
 	newIntakeStatus models.SystemIntakeStatus,
 ) ActionExecuter {
@@ -161,7 +261,7 @@ func NewSubmitBusinessCase(
 			return err
 		}
 		if !ok {
-			return &apperrors.UnauthorizedError{Err: err}
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize submit business case")}
 		}
 
 		businessCase, err := fetchOpenBusinessCase(ctx, intake.ID)
@@ -184,12 +284,20 @@ func NewSubmitBusinessCase(
 			businessCase.InitialSubmittedAt = &updatedAt
 		}
 		businessCase.LastSubmittedAt = &updatedAt
-		if businessCase.SystemIntakeStatus == models.SystemIntakeStatusBIZCASEFINALNEEDED {
-			err = validateForSubmit(businessCase)
-			if err != nil {
-				return err
+
+		// drafts and final business cases have different rules, so each submit action has its own
+		validate, ok := validateForSubmit[action.ActionType]
+		if !ok {
+			return &apperrors.ResourceConflictError{
+				Err:        fmt.Errorf("business case can't be submitted with action %s", action.ActionType),
+				Resource:   businessCase,
+				ResourceID: businessCase.ID.String(),
 			}
 		}
+		err = validate(businessCase)
+		if err != nil {
+			return err
+		}
 
 		err = saveAction(ctx, action)
 		if err != nil {
@@ -209,6 +317,17 @@ func NewSubmitBusinessCase(
 			}
 		}
 
+		// keep a copy of what was submitted, so reviewers can see what changes between submissions
+		_, err = createVersion(ctx, &models.BusinessCaseVersion{
+			BusinessCaseID: businessCase.ID,
+			IntakeStatus:   newIntakeStatus,
+			EUAUserID:      appcontext.Principal(ctx).ID(),
+			BusinessCase:   models.BusinessCaseSnapshot(*businessCase),
+		})
+		if err != nil {
+			return err
+		}
+
 		intake.Status = newIntakeStatus
 		intake.UpdatedAt = &updatedAt
 		intake, err = updateIntake(ctx, intake)
@@ -220,6 +339,24 @@ func NewSubmitBusinessCase(
 			}
 		}
 
+		// CEDAR only hears about the submission once it's saved,
+		// so a rollback can't lose the ID CEDAR gives the business case
+		submitted := businessCase
+		submit := func(ctx context.Context) {
+			logger := appcontext.ZLogger(ctx).With(zap.String("businessCaseID", submitted.ID.String()))
+			if _, err := submitBusinessCaseToCedar(ctx, validateAndSubmit, saveCedarID, submitted); err != nil {
+				// reconcile-cedar only picks up the business cases CEDAR has never given an ID
+				if submitted.CedarID.ValueOrZero() == "" {
+					logger.Error("Failed to submit business case to CEDAR, leaving it for reconcile-cedar", zap.Error(err))
+					return
+				}
+				logger.Error("Failed to resubmit business case to CEDAR", zap.Error(err))
+			}
+		}
+		if !appcontext.AfterCommit(ctx, submit) {
+			submit(ctx)
+		}
+
 		err = sendEmail(ctx, businessCase.Requester.String, businessCase.SystemIntakeID)
 		if err != nil {
 			appcontext.ZLogger(ctx).Error("Submit Business Case email failed to send: ", zap.Error(err))
@@ -238,7 +375,8 @@ func NewTakeActionUpdateStatus(
 	authorize func(context.Context) (bool, error),
 	saveAction func(context.Context, *models.Action) error,
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
-	sendReviewEmail func(ctx context.Context, emailText string, recipientAddress string) error,
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendReviewEmail func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, emailText string) error,
 	shouldCloseBusinessCase bool,
 	closeBusinessCase func(context.Context, uuid.UUID) error,
 ) ActionExecuter {
@@ -248,7 +386,7 @@ func NewTakeActionUpdateStatus(
 			return err
 		}
 		if !ok {
-			return &apperrors.UnauthorizedError{}
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize review system intake")}
 		}
 
 		requesterInfo, err := fetchUserInfo(ctx, intake.EUAUserID.ValueOrZero())
@@ -265,6 +403,14 @@ func NewTakeActionUpdateStatus(
 			}
 		}
 
+		contacts, err := fetchContacts(ctx, intake.ID)
+		if err != nil {
+			return err
+		}
+		if err = chooseRecipients(action, requesterInfo.Email, contacts); err != nil {
+			return err
+		}
+
 		err = saveAction(ctx, action)
 		if err != nil {
 			return err
@@ -289,7 +435,7 @@ func NewTakeActionUpdateStatus(
 			}
 		}
 
-		err = sendReviewEmail(ctx, action.Feedback.String, requesterInfo.Email)
+		err = sendReviewEmail(ctx, intake.ID, requesterInfo.Email, action.EmailRecipients, action.Feedback.String)
 		if err != nil {
 			return err
 		}
@@ -298,6 +444,50 @@ func NewTakeActionUpdateStatus(
 	}
 }
 
+// recipientContactRoles are the roles on an intake whose contacts a reviewer can notify of an action
+var recipientContactRoles = map[models.SystemIntakeContactRole]bool{
+	models.SystemIntakeContactRoleBUSINESSOWNER:  true,
+	models.SystemIntakeContactRolePRODUCTMANAGER: true,
+	models.SystemIntakeContactRoleISSO:           true,
+}
+
+// chooseRecipients checks the contacts a reviewer chose to notify of an action,
+// notifying just the requester if no one was chosen.
+// Only the requester and the intake's own contacts can be chosen.
+func chooseRecipients(action *models.Action, requesterEmail string, contacts []models.SystemIntakeContact) error {
+	if action.EmailRecipients.IsEmpty() {
+		action.NotifyRequester = true
+		return nil
+	}
+	allowed := map[string]bool{strings.ToLower(requesterEmail): true}
+	for _, contact := range contacts {
+		if recipientContactRoles[contact.Role] && contact.Email != "" {
+			allowed[strings.ToLower(contact.Email)] = true
+		}
+	}
+	valErr := apperrors.NewValidationError(
+		errors.New("action recipients failed validation"),
+		models.Action{},
+		"",
+	)
+	for i, address := range action.ContactEmails {
+		parsed, err := mail.ParseAddress(address)
+		if err != nil {
+			valErr.WithValidation(fmt.Sprintf("recipients.contactEmails.%d", i), "must be an email address")
+			continue
+		}
+		if !allowed[strings.ToLower(parsed.Address)] {
+			valErr.WithValidation(fmt.Sprintf("recipients.contactEmails.%d", i), "must be a contact of the request")
+			continue
+		}
+		action.ContactEmails[i] = parsed.Address
+	}
+	if len(valErr.Validations) > 0 {
+		return &valErr
+	}
+	return nil
+}
+
 // NewFetchActionsByRequestID returns a function that fetches actions for a specific request
 func NewFetchActionsByRequestID(
 	authorize func(context.Context) (bool, error),
@@ -318,3 +508,20 @@ func NewFetchActionsByRequestID(
 		return fetchedActions, nil
 	}
 }
+
+// NewFetchActionsByRequestIDs is a service to fetch the actions of many requests at once
+func NewFetchActionsByRequestIDs(
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, []uuid.UUID) ([]models.Action, error),
+) func(context.Context, []uuid.UUID) ([]models.Action, error) {
+	return func(ctx context.Context, intakeIDs []uuid.UUID) ([]models.Action, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch actions")}
+		}
+		return fetch(ctx, intakeIDs)
+	}
+}
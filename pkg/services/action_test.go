@@ -9,6 +9,7 @@ import (
 	"github.com/guregu/null"
 	"go.uber.org/zap"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
@@ -17,14 +18,203 @@ import (
 func (s ServicesTestSuite) TestNewTakeAction() {
 	ctx := context.Background()
 	fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
-		return &models.SystemIntake{ID: id}, nil
+		return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusINTAKEDRAFT}, nil
 	}
+	authorize := func(ctx context.Context, role GovernanceRole, intake *models.SystemIntake) (bool, error) {
+		return true, nil
+	}
+	submitTransition := GovernanceTransition{
+		ActionType: models.ActionTypeSUBMITINTAKE,
+		From:       []models.SystemIntakeStatus{models.SystemIntakeStatusINTAKEDRAFT},
+		To:         models.SystemIntakeStatusINTAKESUBMITTED,
+		Role:       GovernanceRoleRequester,
+		Effect:     GovernanceEffectSubmitIntake,
+	}
+	submitCount := 0
+	submit := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+		submitCount++
+		return nil
+	}
+	workflow := GovernanceWorkflow{
+		models.ActionTypeSUBMITINTAKE: {Transition: submitTransition, Execute: submit},
+	}
+	published := []*models.SystemIntake{}
+	publish := func(ctx context.Context, intake *models.SystemIntake) error {
+		published = append(published, intake)
+		return nil
+	}
+
+	cedarUpdates := []*models.SystemIntake{}
+	updateCedar := func(ctx context.Context, intake *models.SystemIntake) error {
+		cedarUpdates = append(cedarUpdates, intake)
+		return nil
+	}
+
+	s.Run("golden path executes the action", func() {
+		createAction := NewTakeAction(fetch, authorize, workflow, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.NoError(err)
+		s.Equal(1, submitCount)
+
+		submitCount = 0
+	})
+
+	s.Run("publishes the intake's new status once the action is taken", func() {
+		status := models.SystemIntakeStatusINTAKEDRAFT
+		statusFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, Status: status}, nil
+		}
+		statusSubmit := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+			status = models.SystemIntakeStatusINTAKESUBMITTED
+			return nil
+		}
+		createAction := NewTakeAction(statusFetch, authorize, GovernanceWorkflow{
+			models.ActionTypeSUBMITINTAKE: {Transition: submitTransition, Execute: statusSubmit},
+		}, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.NoError(err)
+		s.Len(published, 1)
+		s.Equal(models.SystemIntakeStatusINTAKESUBMITTED, published[0].Status)
+
+		published = []*models.SystemIntake{}
+	})
+
+	s.Run("updates CEDAR when an intake submitted there changes status", func() {
+		status := models.SystemIntakeStatusINTAKESUBMITTED
+		submittedFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, Status: status, AlfabetID: null.StringFrom("000-000-0")}, nil
+		}
+		review := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+			status = models.SystemIntakeStatusNEEDBIZCASE
+			return nil
+		}
+		failUpdateCedar := func(ctx context.Context, intake *models.SystemIntake) error {
+			cedarUpdates = append(cedarUpdates, intake)
+			return errors.New("CEDAR is down")
+		}
+		createAction := NewTakeAction(submittedFetch, authorize, GovernanceWorkflow{
+			models.ActionTypeNEEDBIZCASE: {
+				Transition: GovernanceTransition{
+					ActionType: models.ActionTypeNEEDBIZCASE,
+					From:       []models.SystemIntakeStatus{models.SystemIntakeStatusINTAKESUBMITTED},
+					To:         models.SystemIntakeStatusNEEDBIZCASE,
+					Role:       GovernanceRoleGRT,
+					Effect:     GovernanceEffectReview,
+				},
+				Execute: review,
+			},
+		}, noTransaction, publish, failUpdateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeNEEDBIZCASE,
+		}
+		err := createAction(ctx, &action)
+
+		// the action was taken, so CEDAR failing doesn't fail it
+		s.NoError(err)
+		s.Len(cedarUpdates, 1)
+		s.Equal(models.SystemIntakeStatusNEEDBIZCASE, cedarUpdates[0].Status)
+
+		published = []*models.SystemIntake{}
+		cedarUpdates = []*models.SystemIntake{}
+	})
+
+	s.Run("doesn't update CEDAR for an intake that wasn't submitted there", func() {
+		status := models.SystemIntakeStatusINTAKEDRAFT
+		statusFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, Status: status}, nil
+		}
+		statusSubmit := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+			status = models.SystemIntakeStatusINTAKESUBMITTED
+			return nil
+		}
+		createAction := NewTakeAction(statusFetch, authorize, GovernanceWorkflow{
+			models.ActionTypeSUBMITINTAKE: {Transition: submitTransition, Execute: statusSubmit},
+		}, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.NoError(err)
+		s.Empty(cedarUpdates)
+
+		published = []*models.SystemIntake{}
+	})
+
+	s.Run("doesn't publish when the status stays the same", func() {
+		createAction := NewTakeAction(fetch, authorize, workflow, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.NoError(err)
+		s.Empty(published)
+
+		submitCount = 0
+	})
+
+	s.Run("executes the action in a transaction", func() {
+		transactionCount := 0
+		withTransaction := func(ctx context.Context, f func(context.Context) error) error {
+			transactionCount++
+			s.Equal(0, submitCount)
+			err := f(ctx)
+			s.Equal(1, submitCount)
+			return err
+		}
+		createAction := NewTakeAction(fetch, authorize, workflow, withTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.NoError(err)
+		s.Equal(1, transactionCount)
+
+		submitCount = 0
+	})
+
+	s.Run("returns error if the transaction fails", func() {
+		commitErr := errors.New("commit failed")
+		failTransaction := func(ctx context.Context, f func(context.Context) error) error {
+			if err := f(ctx); err != nil {
+				return err
+			}
+			return commitErr
+		}
+		createAction := NewTakeAction(fetch, authorize, workflow, failTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.Equal(commitErr, err)
+
+		submitCount = 0
+	})
 
 	s.Run("returns QueryError if fetch fails", func() {
 		failFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
 			return nil, errors.New("error")
 		}
-		createAction := NewTakeAction(failFetch, map[models.ActionType]ActionExecuter{})
+		createAction := NewTakeAction(failFetch, authorize, GovernanceWorkflow{}, noTransaction, publish, updateCedar)
 		id := uuid.New()
 		action := models.Action{
 			IntakeID:   &id,
@@ -39,7 +229,9 @@ func (s ServicesTestSuite) TestNewTakeAction() {
 		failSubmit := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
 			return submitError
 		}
-		createAction := NewTakeAction(fetch, map[models.ActionType]ActionExecuter{models.ActionTypeSUBMITINTAKE: failSubmit})
+		createAction := NewTakeAction(fetch, authorize, GovernanceWorkflow{
+			models.ActionTypeSUBMITINTAKE: {Transition: submitTransition, Execute: failSubmit},
+		}, noTransaction, publish, updateCedar)
 		id := uuid.New()
 		action := models.Action{
 			IntakeID:   &id,
@@ -50,7 +242,7 @@ func (s ServicesTestSuite) TestNewTakeAction() {
 	})
 
 	s.Run("returns ResourceConflictError if invalid action type", func() {
-		createAction := NewTakeAction(fetch, map[models.ActionType]ActionExecuter{})
+		createAction := NewTakeAction(fetch, authorize, GovernanceWorkflow{}, noTransaction, publish, updateCedar)
 		id := uuid.New()
 		action := models.Action{
 			IntakeID:   &id,
@@ -59,6 +251,55 @@ func (s ServicesTestSuite) TestNewTakeAction() {
 		err := createAction(ctx, &action)
 		s.IsType(&apperrors.ResourceConflictError{}, err)
 	})
+
+	s.Run("returns ResourceConflictError naming the status if transition is not allowed", func() {
+		withdrawnFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusWITHDRAWN}, nil
+		}
+		createAction := NewTakeAction(withdrawnFetch, authorize, workflow, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Contains(err.Error(), string(models.SystemIntakeStatusWITHDRAWN))
+		s.Equal(0, submitCount)
+	})
+
+	s.Run("returns error from authorization if authorization fails", func() {
+		authorizationError := errors.New("authorization failed")
+		failAuthorize := func(ctx context.Context, role GovernanceRole, intake *models.SystemIntake) (bool, error) {
+			return false, authorizationError
+		}
+		createAction := NewTakeAction(fetch, failAuthorize, workflow, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.Equal(authorizationError, err)
+	})
+
+	s.Run("returns unauthorized error if the role is not held", func() {
+		var authorizedRole GovernanceRole
+		unauthorize := func(ctx context.Context, role GovernanceRole, intake *models.SystemIntake) (bool, error) {
+			authorizedRole = role
+			return false, nil
+		}
+		createAction := NewTakeAction(fetch, unauthorize, workflow, noTransaction, publish, updateCedar)
+		id := uuid.New()
+		action := models.Action{
+			IntakeID:   &id,
+			ActionType: models.ActionTypeSUBMITINTAKE,
+		}
+		err := createAction(ctx, &action)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+		s.Equal(GovernanceRoleRequester, authorizedRole)
+		s.Equal(0, submitCount)
+	})
 }
 
 func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
@@ -75,9 +316,15 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		return nil
 	}
 
+	validate := func(context.Context, *models.SystemIntake) error { return nil }
 	submit := func(c context.Context, intake *models.SystemIntake) (string, error) {
 		return "ALFABET-ID", nil
 	}
+	savedAlfabetID := ""
+	saveAlfabetID := func(_ context.Context, id uuid.UUID, alfabetID string) (*models.SystemIntake, error) {
+		savedAlfabetID = alfabetID
+		return &models.SystemIntake{ID: id, AlfabetID: null.StringFrom(alfabetID)}, nil
+	}
 	submitEmailCount := 0
 	sendSubmitEmail := func(ctx context.Context, requester string, intakeID uuid.UUID) error {
 		submitEmailCount++
@@ -87,7 +334,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 	s.Run("golden path submit intake", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, validate, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		s.Equal(0, submitEmailCount)
 
 		err := submitSystemIntake(ctx, &intake, &action)
@@ -106,7 +353,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, authorizationError
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, failAuthorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, failAuthorize, update, validate, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.Equal(authorizationError, err)
@@ -118,7 +365,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		unauthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, unauthorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, unauthorize, update, validate, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.UnauthorizedError{}, err)
@@ -130,7 +377,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failCreateAction := func(ctx context.Context, action *models.Action) error {
 			return errors.New("error")
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, failCreateAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, validate, submit, saveAlfabetID, failCreateAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -139,24 +386,48 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 	s.Run("returns error when validation fails", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		failValidationSubmit := func(_ context.Context, intake *models.SystemIntake) (string, error) {
-			return "", &apperrors.ValidationError{
+		failValidation := func(_ context.Context, intake *models.SystemIntake) error {
+			return &apperrors.ValidationError{
 				Err:     errors.New("validation failed on these fields: ID"),
 				ModelID: intake.ID.String(),
 				Model:   intake,
 			}
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, failValidationSubmit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, failValidation, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.ValidationError{}, err)
 		s.Equal(0, submitEmailCount)
 	})
 
-	s.Run("returns error when submission fails", func() {
+	s.Run("submits to CEDAR only once the submission commits", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		failValidationSubmit := func(_ context.Context, intake *models.SystemIntake) (string, error) {
+		submitCount := 0
+		countSubmit := func(ctx context.Context, intake *models.SystemIntake) (string, error) {
+			submitCount++
+			return submit(ctx, intake)
+		}
+		txCtx, hooks := appcontext.WithCommitHooks(ctx)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, validate, countSubmit, saveAlfabetID, saveAction, sendSubmitEmail)
+
+		err := submitSystemIntake(txCtx, &intake, &action)
+		s.NoError(err)
+		s.Equal(0, submitCount)
+		s.False(intake.AlfabetID.Valid)
+
+		hooks.Run(ctx)
+		s.Equal(1, submitCount)
+		s.Equal("ALFABET-ID", intake.AlfabetID.String)
+		s.Equal("ALFABET-ID", savedAlfabetID)
+
+		submitEmailCount = 0
+	})
+
+	s.Run("keeps the submission when CEDAR fails", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
+		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
+		failSubmit := func(_ context.Context, intake *models.SystemIntake) (string, error) {
 			return "", &apperrors.ExternalAPIError{
 				Err:       errors.New("CEDAR return result: unexpected failure"),
 				ModelID:   intake.ID.String(),
@@ -165,11 +436,14 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 				Source:    "CEDAR",
 			}
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, failValidationSubmit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, validate, failSubmit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
-		s.IsType(&apperrors.ExternalAPIError{}, err)
-		s.Equal(0, submitEmailCount)
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusINTAKESUBMITTED, intake.Status)
+		s.False(intake.AlfabetID.Valid)
+
+		submitEmailCount = 0
 	})
 
 	s.Run("returns error when intake has already been submitted", func() {
@@ -178,7 +452,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 			AlfabetID: null.StringFrom("394-141-0"),
 		}
 		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, update, validate, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &alreadySubmittedIntake, &action)
 
 		s.IsType(&apperrors.ResourceConflictError{}, err)
@@ -191,7 +465,7 @@ func (s ServicesTestSuite) TestNewSubmitSystemIntake() {
 		failUpdate := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update error")
 		}
-		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, failUpdate, submit, saveAction, sendSubmitEmail)
+		submitSystemIntake := NewSubmitSystemIntake(serviceConfig, authorize, failUpdate, validate, submit, saveAlfabetID, saveAction, sendSubmitEmail)
 		err := submitSystemIntake(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -216,9 +490,35 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		return &models.BusinessCase{}, nil
 	}
 
-	validateForSubmit := func(businessCase *models.BusinessCase) error {
+	validate := func(businessCase *models.BusinessCase) error {
 		return nil
 	}
+	validateForSubmit := map[models.ActionType]func(*models.BusinessCase) error{
+		models.ActionTypeSUBMITBIZCASE:      validate,
+		models.ActionTypeSUBMITFINALBIZCASE: validate,
+	}
+	failValidation := func(businessCase *models.BusinessCase) error {
+		return &apperrors.ValidationError{
+			Err:     errors.New("validation failed on these fields: ID"),
+			ModelID: businessCase.ID.String(),
+			Model:   businessCase,
+		}
+	}
+
+	validateAndSubmit := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+		return "", nil
+	}
+	savedCedarID := ""
+	saveCedarID := func(_ context.Context, id uuid.UUID, cedarID string) (*models.BusinessCase, error) {
+		savedCedarID = cedarID
+		return &models.BusinessCase{ID: id, CedarID: null.StringFrom(cedarID)}, nil
+	}
+
+	var versions []*models.BusinessCaseVersion
+	createVersion := func(ctx context.Context, version *models.BusinessCaseVersion) (*models.BusinessCaseVersion, error) {
+		versions = append(versions, version)
+		return version, nil
+	}
 
 	saveAction := func(ctx context.Context, action *models.Action) error {
 		return nil
@@ -233,7 +533,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
 		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		s.Equal(0, submitEmailCount)
 
 		err := submitBusinessCase(ctx, &intake, &action)
@@ -248,7 +548,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
 		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		s.Equal(0, submitEmailCount)
 
 		err := submitBusinessCase(ctx, &intake, &action)
@@ -256,6 +556,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		s.NoError(err)
 		s.Equal(1, submitEmailCount)
 		s.Equal(intake.Status, status)
+		s.Equal(status, versions[len(versions)-1].IntakeStatus)
 
 		submitEmailCount = 0
 	})
@@ -268,7 +569,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, authorizationError
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, failAuthorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, failAuthorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.Equal(authorizationError, err)
@@ -281,7 +582,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		unauthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, unauthorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, unauthorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.UnauthorizedError{}, err)
@@ -294,51 +595,118 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failCreateAction := func(ctx context.Context, action *models.Action) error {
 			return errors.New("error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, failCreateAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, failCreateAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
 	})
 
-	s.Run("does not return error for validation if status is not biz case final", func() {
+	s.Run("validates a draft with the draft rules", func() {
 		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
 		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
-		failValidation := func(businessCase *models.BusinessCase) error {
-			return &apperrors.ValidationError{
-				Err:     errors.New("validation failed on these fields: ID"),
-				ModelID: businessCase.ID.String(),
-				Model:   businessCase,
-			}
+		validateDraft := map[models.ActionType]func(*models.BusinessCase) error{
+			models.ActionTypeSUBMITBIZCASE:      failValidation,
+			models.ActionTypeSUBMITFINALBIZCASE: validate,
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateDraft, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(0, submitEmailCount)
+	})
+
+	s.Run("validates a final business case with the final rules", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEFINALNEEDED}
+		action := models.Action{ActionType: models.ActionTypeSUBMITFINALBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
+		validateFinal := map[models.ActionType]func(*models.BusinessCase) error{
+			models.ActionTypeSUBMITBIZCASE:      validate,
+			models.ActionTypeSUBMITFINALBIZCASE: failValidation,
+		}
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateFinal, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(0, submitEmailCount)
+	})
+
+	s.Run("returns conflict error for an action that doesn't submit a business case", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEDRAFT}
+		action := models.Action{ActionType: models.ActionTypeSUBMITINTAKE}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Equal(0, submitEmailCount)
+	})
+
+	s.Run("stores the identifier CEDAR returns on the business case", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEDRAFT}
+		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		submitToCedar := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+			return "CEDAR-1", nil
+		}
+		savedCedarID = ""
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, saveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
+		txCtx, hooks := appcontext.WithCommitHooks(ctx)
+		err := submitBusinessCase(txCtx, &intake, &action)
+
 		s.NoError(err)
-		s.Equal(1, submitEmailCount)
+		s.Equal("", savedCedarID, "submitted to CEDAR before the transaction committed")
+
+		hooks.Run(ctx)
+		s.Equal("CEDAR-1", savedCedarID)
 
 		submitEmailCount = 0
 	})
 
-	s.Run("returns error when status is biz case final and validation fails", func() {
-		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEFINALNEEDED}
+	s.Run("submits the business case even if CEDAR submission fails", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEDRAFT}
 		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
-		status := models.SystemIntakeStatusBIZCASEFINALSUBMITTED
-		failValidation := func(businessCase *models.BusinessCase) error {
-			return &apperrors.ValidationError{
-				Err:     errors.New("validation failed on these fields: ID"),
-				ModelID: businessCase.ID.String(),
-				Model:   businessCase,
-			}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		failSubmitToCedar := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+			return "", &apperrors.ExternalAPIError{Err: errors.New("CEDAR is down"), Source: "CEDAR"}
 		}
-		fetchOpenBusinessCase = func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
-			return &models.BusinessCase{SystemIntakeStatus: intake.Status}, nil
+		actionSaved := false
+		recordSaveAction := func(ctx context.Context, action *models.Action) error {
+			actionSaved = true
+			return nil
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, failValidation, saveAction, updateIntake, updateBusinessCase, sendSubmitEmail, status)
+		savedCedarID = ""
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, failSubmitToCedar, saveCedarID, recordSaveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
-		s.IsType(&apperrors.ValidationError{}, err)
-		s.Equal(0, submitEmailCount)
+		s.NoError(err)
+		s.True(actionSaved)
+		s.Equal(1, submitEmailCount)
+		s.Equal("", savedCedarID)
+
+		submitEmailCount = 0
+	})
+
+	s.Run("submits the business case even if saving the CEDAR ID fails", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusBIZCASEDRAFT}
+		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		submitToCedar := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+			return "CEDAR-1", nil
+		}
+		failSaveCedarID := func(_ context.Context, id uuid.UUID, cedarID string) (*models.BusinessCase, error) {
+			return nil, errors.New("failed to save")
+		}
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, submitToCedar, failSaveCedarID, saveAction, updateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
+		txCtx, hooks := appcontext.WithCommitHooks(ctx)
+		err := submitBusinessCase(txCtx, &intake, &action)
+		hooks.Run(ctx)
+
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED, intake.Status)
+		s.Equal(1, submitEmailCount)
+
+		submitEmailCount = 0
 	})
 
 	s.Run("returns query error if update intake fails", func() {
@@ -348,10 +716,24 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failUpdateIntake := func(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, failUpdateIntake, updateBusinessCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, failUpdateIntake, updateBusinessCase, createVersion, sendSubmitEmail, status)
+		err := submitBusinessCase(ctx, &intake, &action)
+
+		s.IsType(&apperrors.QueryError{}, err)
+	})
+
+	s.Run("returns error if the submitted version can't be saved", func() {
+		intake := models.SystemIntake{Status: models.SystemIntakeStatusINTAKEDRAFT}
+		action := models.Action{ActionType: models.ActionTypeSUBMITBIZCASE}
+		status := models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED
+		failCreateVersion := func(ctx context.Context, version *models.BusinessCaseVersion) (*models.BusinessCaseVersion, error) {
+			return nil, &apperrors.QueryError{Err: errors.New("create error")}
+		}
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, updateBusinessCase, failCreateVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
+		s.Equal(0, submitEmailCount)
 	})
 
 	s.Run("returns query error if update biz case fails", func() {
@@ -361,7 +743,7 @@ func (s ServicesTestSuite) TestNewSubmitBizCase() {
 		failUpdateBizCase := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 			return &models.BusinessCase{}, errors.New("update error")
 		}
-		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, saveAction, updateIntake, failUpdateBizCase, sendSubmitEmail, status)
+		submitBusinessCase := NewSubmitBusinessCase(serviceConfig, authorize, fetchOpenBusinessCase, validateForSubmit, validateAndSubmit, saveCedarID, saveAction, updateIntake, failUpdateBizCase, createVersion, sendSubmitEmail, status)
 		err := submitBusinessCase(ctx, &intake, &action)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -394,8 +776,10 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 	}
 	reviewEmailCount := 0
 	feedbackForEmailText := ""
-	sendReviewEmail := func(ctx context.Context, emailText string, recipientAddress string) error {
+	var emailRecipients models.EmailRecipients
+	sendReviewEmail := func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, emailText string) error {
 		feedbackForEmailText = emailText
+		emailRecipients = recipients
 		reviewEmailCount++
 		return nil
 	}
@@ -409,6 +793,12 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 		closeBusinessCaseCount++
 		return nil
 	}
+	fetchContacts := func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+		return []models.SystemIntakeContact{
+			{Role: models.SystemIntakeContactRoleBUSINESSOWNER, Email: "Owner@Site.com"},
+			{Role: models.SystemIntakeContactRoleEACOLLABORATOR, Email: "someone@elsewhere.com"},
+		}, nil
+	}
 
 	s.Run("golden path review system intake", func() {
 		ctx := context.Background()
@@ -419,6 +809,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			saveAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			true,
 			closeBusinessCase,
@@ -431,6 +822,95 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 		s.Equal(1, reviewEmailCount)
 		s.Equal(1, closeBusinessCaseCount)
 		s.Equal("feedback", feedbackForEmailText)
+		s.Equal(models.EmailRecipients{NotifyRequester: true}, emailRecipients)
+		s.True(action.NotifyRequester)
+		reviewEmailCount = 0
+		closeBusinessCaseCount = 0
+	})
+
+	s.Run("review notifies the contacts the reviewer chose", func() {
+		ctx := context.Background()
+		reviewSystemIntake := NewTakeActionUpdateStatus(
+			serviceConfig,
+			models.SystemIntakeStatusNEEDBIZCASE,
+			save,
+			authorize,
+			saveAction,
+			fetchUserInfo,
+			fetchContacts,
+			sendReviewEmail,
+			false,
+			closeBusinessCase,
+		)
+		intake := &models.SystemIntake{Status: models.SystemIntakeStatusINTAKESUBMITTED}
+		recipients := models.EmailRecipients{
+			NotifyGRT:     true,
+			ContactEmails: []string{"owner@site.com"},
+		}
+		action := &models.Action{Feedback: null.StringFrom("feedback"), EmailRecipients: recipients}
+		err := reviewSystemIntake(ctx, intake, action)
+
+		s.NoError(err)
+		s.Equal(1, reviewEmailCount)
+		s.Equal(recipients, emailRecipients)
+		s.False(action.NotifyRequester)
+		reviewEmailCount = 0
+	})
+
+	s.Run("returns validation error for an invalid contact email", func() {
+		ctx := context.Background()
+		reviewSystemIntake := NewTakeActionUpdateStatus(
+			serviceConfig,
+			models.SystemIntakeStatusNEEDBIZCASE,
+			save,
+			authorize,
+			saveAction,
+			fetchUserInfo,
+			noIntakeContacts,
+			sendReviewEmail,
+			false,
+			closeBusinessCase,
+		)
+		intake := &models.SystemIntake{Status: models.SystemIntakeStatusINTAKESUBMITTED}
+		action := &models.Action{
+			Feedback:        null.StringFrom("feedback"),
+			EmailRecipients: models.EmailRecipients{ContactEmails: []string{"not an email"}},
+		}
+		err := reviewSystemIntake(ctx, intake, action)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(0, reviewEmailCount)
+	})
+
+	s.Run("returns validation error for an address that isn't a contact of the request", func() {
+		ctx := context.Background()
+		reviewSystemIntake := NewTakeActionUpdateStatus(
+			serviceConfig,
+			models.SystemIntakeStatusNEEDBIZCASE,
+			save,
+			authorize,
+			saveAction,
+			fetchUserInfo,
+			fetchContacts,
+			sendReviewEmail,
+			false,
+			closeBusinessCase,
+		)
+		intake := &models.SystemIntake{Status: models.SystemIntakeStatusINTAKESUBMITTED}
+		action := &models.Action{
+			Feedback: null.StringFrom("feedback"),
+			EmailRecipients: models.EmailRecipients{
+				ContactEmails: []string{"owner@site.com", "someone@elsewhere.com"},
+			},
+		}
+		err := reviewSystemIntake(ctx, intake, action)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Equal(
+			map[string]string{"recipients.contactEmails.1": "must be a contact of the request"},
+			err.(*apperrors.ValidationError).Validations.Map(),
+		)
+		s.Equal(0, reviewEmailCount)
 	})
 
 	s.Run("returns error when authorization errors", func() {
@@ -446,6 +926,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			failAuthorize,
 			saveAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -470,6 +951,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			notOKAuthorize,
 			saveAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -493,6 +975,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			failCreateAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -523,6 +1006,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			saveAction,
 			failFetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -547,6 +1031,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			saveAction,
 			failFetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -571,6 +1056,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			saveAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			sendReviewEmail,
 			true,
 			failCloseBusinessCase,
@@ -585,7 +1071,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 
 	s.Run("returns notification error when review email fails", func() {
 		ctx := context.Background()
-		failSendReviewEmail := func(ctx context.Context, emailText string, recipientAddress string) error {
+		failSendReviewEmail := func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, emailText string) error {
 			return &apperrors.NotificationError{
 				Err:             errors.New("failed to send Email"),
 				DestinationType: apperrors.DestinationTypeEmail,
@@ -598,6 +1084,7 @@ func (s ServicesTestSuite) TestNewTakeActionUpdateStatus() {
 			authorize,
 			saveAction,
 			fetchUserInfo,
+			noIntakeContacts,
 			failSendReviewEmail,
 			false,
 			closeBusinessCase,
@@ -672,6 +1159,34 @@ func (s ServicesTestSuite) TestFetchActions() {
 
 }
 
+func (s ServicesTestSuite) TestFetchActionsByRequestIDs() {
+	fetch := func(_ context.Context, ids []uuid.UUID) ([]models.Action, error) {
+		actions := []models.Action{}
+		for ix := range ids {
+			actions = append(actions, models.Action{IntakeID: &ids[ix]})
+		}
+		return actions, nil
+	}
+	authorize := func(_ context.Context) (bool, error) {
+		return true, nil
+	}
+	unauthorize := func(_ context.Context) (bool, error) {
+		return false, nil
+	}
+
+	s.Run("fetches the actions of every request", func() {
+		actions, err := NewFetchActionsByRequestIDs(authorize, fetch)(context.Background(), []uuid.UUID{uuid.New(), uuid.New()})
+		s.NoError(err)
+		s.Len(actions, 2)
+	})
+
+	s.Run("unauthorized", func() {
+		actions, err := NewFetchActionsByRequestIDs(unauthorize, fetch)(context.Background(), []uuid.UUID{uuid.New()})
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+		s.Nil(actions)
+	})
+}
+
 func (s ServicesTestSuite) TestNewSaveAction() {
 	createAction := func(_ context.Context, action *models.Action) (*models.Action, error) {
 		return action, nil
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchAuditEntries is a service to fetch the history of changes to a system intake or business case.
+// Only the GRT can see the history.
+func NewFetchAuditEntries(
+	config Config,
+	fetch func(context.Context, models.AuditEntity, uuid.UUID) ([]models.AuditEntry, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, models.AuditEntity, uuid.UUID) ([]models.AuditEntry, error) {
+	return func(ctx context.Context, entity models.AuditEntity, entityID uuid.UUID) ([]models.AuditEntry, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch audit entries")}
+		}
+		return fetch(ctx, entity, entityID)
+	}
+}
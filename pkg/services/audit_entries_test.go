@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestFetchAuditEntries() {
+	cfg := NewConfig(nil, nil)
+	intakeID := uuid.New()
+	fetch := func(_ context.Context, entity models.AuditEntity, id uuid.UUID) ([]models.AuditEntry, error) {
+		return []models.AuditEntry{{ID: uuid.New(), Entity: entity, EntityID: id}}, nil
+	}
+	fetchAuditEntries := NewFetchAuditEntries(cfg, fetch, NewAuthorizeRequireGRTJobCode())
+
+	s.Run("reviewer can see an intake's history", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+		entries, err := fetchAuditEntries(ctx, models.AuditEntitySystemIntake, intakeID)
+
+		s.NoError(err)
+		s.Len(entries, 1)
+		s.Equal(intakeID, entries[0].EntityID)
+	})
+
+	s.Run("requester can't see the history", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchAuditEntries(ctx, models.AuditEntitySystemIntake, intakeID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error if the history can't be fetched", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+		failFetch := func(context.Context, models.AuditEntity, uuid.UUID) ([]models.AuditEntry, error) {
+			return nil, errors.New("failed to fetch")
+		}
+
+		_, err := NewFetchAuditEntries(cfg, failFetch, NewAuthorizeRequireGRTJobCode())(ctx, models.AuditEntityBusinessCase, uuid.New())
+
+		s.Error(err)
+	})
+}
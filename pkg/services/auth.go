@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"go.uber.org/zap"
 
@@ -49,6 +50,25 @@ func HasRole(ctx context.Context, role model.Role) (bool, error) {
 	}
 }
 
+// IsRequesterOrHasRole authorizes a user as either the requester of the given
+// System Intake or Business Case, or as having a given role
+func IsRequesterOrHasRole(ctx context.Context, obj interface{}, role model.Role) (bool, error) {
+	var isRequester bool
+	var err error
+	switch owned := obj.(type) {
+	case *models.SystemIntake:
+		isRequester, err = NewAuthorizeUserIsIntakeRequester()(ctx, owned)
+	case *models.BusinessCase:
+		isRequester, err = NewAuthorizeUserIsBusinessCaseRequester()(ctx, owned)
+	default:
+		return false, fmt.Errorf("can't tell who requested %T", obj)
+	}
+	if err != nil || isRequester {
+		return isRequester, err
+	}
+	return HasRole(ctx, role)
+}
+
 // NewAuthorizeUserIsIntakeRequester returns a function
 // that authorizes a user as being the requester of the given System Intake
 func NewAuthorizeUserIsIntakeRequester() func(
@@ -153,3 +173,42 @@ func NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode() func(context.Context, *m
 		return true, nil
 	}
 }
+
+// NewAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode returns a function
+// that authorizes a user as being the requester of the given Business Case
+// or a member of the GRT (Governance Review Team)
+func NewAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode() func(context.Context, *models.BusinessCase) (bool, error) {
+	return func(ctx context.Context, bizCase *models.BusinessCase) (bool, error) {
+		authorIsAuthed, errAuthor := NewAuthorizeUserIsBusinessCaseRequester()(ctx, bizCase)
+		if errAuthor != nil {
+			return false, errAuthor
+		}
+
+		reviewerIsAuthed, errReviewer := NewAuthorizeRequireGRTJobCode()(ctx)
+		if errReviewer != nil {
+			return false, errReviewer
+		}
+
+		if !authorIsAuthed && !reviewerIsAuthed {
+			return false, errAuthor
+		}
+
+		return true, nil
+	}
+}
+
+// NewAuthorizeGovernanceRole returns a function
+// that authorizes a user as holding the given governance role for a System Intake
+func NewAuthorizeGovernanceRole() func(context.Context, GovernanceRole, *models.SystemIntake) (bool, error) {
+	return func(ctx context.Context, role GovernanceRole, intake *models.SystemIntake) (bool, error) {
+		switch role {
+		case GovernanceRoleRequester:
+			return NewAuthorizeUserIsIntakeRequester()(ctx, intake)
+		case GovernanceRoleGRT:
+			return NewAuthorizeRequireGRTJobCode()(ctx)
+		default:
+			appcontext.ZLogger(ctx).With(zap.String("Role", string(role))).Info("Unrecognized governance role")
+			return false, nil
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/authn"
+	"github.com/cmsgov/easi-app/pkg/graph/model"
 	"github.com/cmsgov/easi-app/pkg/models"
 )
 
@@ -172,3 +173,139 @@ func (s ServicesTestSuite) NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode() {
 		})
 	}
 }
+
+func (s ServicesTestSuite) TestAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode() {
+	fnAuth := NewAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode()
+	nonEASI := authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: false, JobCodeGRT: false}
+	nonGRT := authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true, JobCodeGRT: false}
+	yesGRT := authn.EUAPrincipal{EUAID: "FAKE", JobCodeEASi: true, JobCodeGRT: true}
+
+	testCases := map[string]struct {
+		ctx          context.Context
+		businessCase *models.BusinessCase
+		allowed      bool
+	}{
+		"anonymous": {
+			ctx:          context.Background(),
+			businessCase: &models.BusinessCase{},
+			allowed:      false,
+		},
+		"non easi": {
+			ctx:          appcontext.WithPrincipal(context.Background(), &nonEASI),
+			businessCase: &models.BusinessCase{},
+			allowed:      false,
+		},
+		"is not grt, is not author": {
+			ctx:          appcontext.WithPrincipal(context.Background(), &nonGRT),
+			businessCase: &models.BusinessCase{EUAUserID: "NOPE"},
+			allowed:      false,
+		},
+		"is author, is not grt": {
+			ctx:          appcontext.WithPrincipal(context.Background(), &nonGRT),
+			businessCase: &models.BusinessCase{EUAUserID: "FAKE"},
+			allowed:      true,
+		},
+		"is grt, is not author": {
+			ctx:          appcontext.WithPrincipal(context.Background(), &yesGRT),
+			businessCase: &models.BusinessCase{EUAUserID: "NOPE"},
+			allowed:      true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ok, err := fnAuth(tc.ctx, tc.businessCase)
+			s.NoError(err)
+			s.Equal(tc.allowed, ok)
+		})
+	}
+}
+
+func (s ServicesTestSuite) TestAuthorizeGovernanceRole() {
+	authorize := NewAuthorizeGovernanceRole()
+	intake := models.SystemIntake{
+		EUAUserID: null.StringFrom("ABCD"),
+	}
+
+	s.Run("Requester role passes auth for the intake owner", func() {
+		ctx := context.Background()
+		ctx = appcontext.WithPrincipal(ctx, &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true})
+
+		ok, err := authorize(ctx, GovernanceRoleRequester, &intake)
+
+		s.True(ok)
+		s.NoError(err)
+	})
+
+	s.Run("GRT role fails auth for the intake owner", func() {
+		ctx := context.Background()
+		ctx = appcontext.WithPrincipal(ctx, &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true})
+
+		ok, err := authorize(ctx, GovernanceRoleGRT, &intake)
+
+		s.False(ok)
+		s.NoError(err)
+	})
+
+	s.Run("GRT role passes auth for a reviewer", func() {
+		ctx := context.Background()
+		ctx = appcontext.WithPrincipal(ctx, &authn.EUAPrincipal{EUAID: "ZYXW", JobCodeEASi: true, JobCodeGRT: true})
+
+		ok, err := authorize(ctx, GovernanceRoleGRT, &intake)
+
+		s.True(ok)
+		s.NoError(err)
+	})
+}
+
+func (s ServicesTestSuite) TestIsRequesterOrHasRole() {
+	intake := &models.SystemIntake{EUAUserID: null.StringFrom("ABCD")}
+	businessCase := &models.BusinessCase{EUAUserID: "ABCD"}
+
+	testCases := map[string]struct {
+		principal *authn.EUAPrincipal
+		obj       interface{}
+		allowed   bool
+	}{
+		"intake requester passes auth": {
+			principal: &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true},
+			obj:       intake,
+			allowed:   true,
+		},
+		"business case requester passes auth": {
+			principal: &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true},
+			obj:       businessCase,
+			allowed:   true,
+		},
+		"reviewer passes auth with the role": {
+			principal: &authn.EUAPrincipal{EUAID: "ZYXW", JobCodeEASi: true, JobCodeGRT: true},
+			obj:       intake,
+			allowed:   true,
+		},
+		"someone else fails auth": {
+			principal: &authn.EUAPrincipal{EUAID: "ZYXW", JobCodeEASi: true},
+			obj:       businessCase,
+			allowed:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx := appcontext.WithPrincipal(context.Background(), tc.principal)
+
+			ok, err := IsRequesterOrHasRole(ctx, tc.obj, model.RoleEasiGovteam)
+
+			s.NoError(err)
+			s.Equal(tc.allowed, ok)
+		})
+	}
+
+	s.Run("fails for something without a requester", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), &authn.EUAPrincipal{EUAID: "ABCD", JobCodeEASi: true, JobCodeGRT: true})
+
+		ok, err := IsRequesterOrHasRole(ctx, &models.Note{}, model.RoleEasiGovteam)
+
+		s.False(ok)
+		s.Error(err)
+	})
+}
@@ -17,7 +17,7 @@ import (
 func NewFetchBusinessCaseByID(
 	config Config,
 	fetch func(c context.Context, id uuid.UUID) (*models.BusinessCase, error),
-	authorize func(context.Context) (bool, error),
+	authorize func(context.Context, *models.BusinessCase) (bool, error),
 ) func(c context.Context, id uuid.UUID) (*models.BusinessCase, error) {
 	return func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
 		logger := appcontext.ZLogger(ctx)
@@ -30,7 +30,7 @@ func NewFetchBusinessCaseByID(
 				Operation: apperrors.QueryFetch,
 			}
 		}
-		ok, err := authorize(ctx)
+		ok, err := authorize(ctx, businessCase)
 		if err != nil {
 			logger.Error("failed to authorize fetch business case")
 			return &models.BusinessCase{}, err
@@ -42,6 +42,34 @@ func NewFetchBusinessCaseByID(
 	}
 }
 
+// NewFetchBusinessCasesByIDs is a service to fetch many business cases at once
+func NewFetchBusinessCasesByIDs(
+	config Config,
+	fetch func(context.Context, []uuid.UUID) ([]*models.BusinessCase, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, []uuid.UUID) ([]*models.BusinessCase, error) {
+	return func(ctx context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("failed to authorize fetch business cases")
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch business cases")}
+		}
+		businessCases, err := fetch(ctx, ids)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("failed to fetch business cases")
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     models.BusinessCase{},
+				Operation: apperrors.QueryFetch,
+			}
+		}
+		return businessCases, nil
+	}
+}
+
 // NewCreateBusinessCase is a service to create a business case
 func NewCreateBusinessCase(
 	config Config,
@@ -51,6 +79,8 @@ func NewCreateBusinessCase(
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
 	createBizCase func(context.Context, *models.BusinessCase) (*models.BusinessCase, error),
 	updateIntake func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
 ) func(c context.Context, b *models.BusinessCase) (*models.BusinessCase, error) {
 	return func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 		intake, err := fetchIntake(ctx, businessCase.SystemIntakeID)
@@ -95,33 +125,38 @@ func NewCreateBusinessCase(
 			ActorEmail:     userInfo.Email,
 			ActorEUAUserID: userInfo.EuaUserID,
 		}
-		_, err = createAction(ctx, &action)
-		if err != nil {
-			return &models.BusinessCase{}, &apperrors.QueryError{
-				Err:       err,
-				Model:     action,
-				Operation: apperrors.QueryPost,
+		err = withTransaction(ctx, func(ctx context.Context) error {
+			_, err = createAction(ctx, &action)
+			if err != nil {
+				return &apperrors.QueryError{
+					Err:       err,
+					Model:     action,
+					Operation: apperrors.QueryPost,
+				}
 			}
-		}
 
-		// Autofill time and intake data
-		now := config.clock.Now()
-		businessCase.CreatedAt = &now
-		businessCase.UpdatedAt = &now
-		businessCase.Requester = null.StringFrom(intake.Requester)
-		businessCase.BusinessOwner = intake.BusinessOwner
-		businessCase.ProjectName = intake.ProjectName
-		businessCase.BusinessNeed = intake.BusinessNeed
-		businessCase.Status = models.BusinessCaseStatusOPEN
-		if businessCase, err = createBizCase(ctx, businessCase); err != nil {
-			return &models.BusinessCase{}, err
-		}
+			// Autofill time and intake data
+			now := config.clock.Now()
+			businessCase.CreatedAt = &now
+			businessCase.UpdatedAt = &now
+			businessCase.Requester = null.StringFrom(intake.Requester)
+			businessCase.BusinessOwner = intake.BusinessOwner
+			businessCase.ProjectName = intake.ProjectName
+			businessCase.BusinessNeed = intake.BusinessNeed
+			businessCase.Status = models.BusinessCaseStatusOPEN
+			if businessCase, err = createBizCase(ctx, businessCase); err != nil {
+				return err
+			}
 
-		intake.Status = models.SystemIntakeStatusBIZCASEDRAFT
-		intake.UpdatedAt = &now
-		if intake, err = updateIntake(ctx, intake); err != nil {
+			intake.Status = models.SystemIntakeStatusBIZCASEDRAFT
+			intake.UpdatedAt = &now
+			_, err = updateIntake(ctx, intake)
+			return err
+		})
+		if err != nil {
 			return &models.BusinessCase{}, err
 		}
+		publishIntakeStatusChanged(ctx, publishStatusChanged, intake)
 
 		return businessCase, nil
 	}
@@ -155,6 +190,37 @@ func NewFetchBusinessCasesByEuaID(
 	}
 }
 
+// NewFetchBusinessCasesPageByEuaID is a service to fetch a page of the business cases belonging to a requester
+func NewFetchBusinessCasesPageByEuaID(
+	config Config,
+	fetchPage func(context.Context, string, models.PageRequest) (*models.BusinessCasesPage, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, string, models.PageRequest) (*models.BusinessCasesPage, error) {
+	return func(ctx context.Context, euaID string, request models.PageRequest) (*models.BusinessCasesPage, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			appcontext.ZLogger(ctx).Error("failed to authorize fetch business cases")
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: err}
+		}
+		page, err := fetchPage(ctx, euaID, request)
+		if err != nil {
+			if _, ok := err.(*apperrors.ValidationError); ok {
+				return nil, err
+			}
+			appcontext.ZLogger(ctx).Error("failed to fetch business cases page")
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     "business cases",
+				Operation: "fetch",
+			}
+		}
+		return page, nil
+	}
+}
+
 // NewUpdateBusinessCase is a service to create a business case
 func NewUpdateBusinessCase(
 	config Config,
@@ -165,6 +231,10 @@ func NewUpdateBusinessCase(
 	return func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 		logger := appcontext.ZLogger(ctx)
 		existingBusinessCase, err := fetchBusinessCase(ctx, businessCase.ID)
+		var notFoundErr *apperrors.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return &models.BusinessCase{}, err
+		}
 		if err != nil {
 			return &models.BusinessCase{}, &apperrors.ResourceConflictError{
 				Err:        errors.New("business case does not exist"),
@@ -188,6 +258,10 @@ func NewUpdateBusinessCase(
 		businessCase.UpdatedAt = &updatedAt
 
 		businessCase, err = update(ctx, businessCase)
+		var conflictErr *apperrors.ResourceConflictError
+		if errors.As(err, &notFoundErr) || errors.As(err, &conflictErr) {
+			return &models.BusinessCase{}, err
+		}
 		if err != nil {
 			logger.Error("failed to update business case")
 			return &models.BusinessCase{}, &apperrors.QueryError{
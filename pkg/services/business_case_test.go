@@ -9,6 +9,7 @@ import (
 	"github.com/guregu/null"
 	"go.uber.org/zap"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
@@ -19,7 +20,7 @@ func (s ServicesTestSuite) TestBusinessCaseByIDFetcher() {
 	fakeID := uuid.New()
 	serviceConfig := NewConfig(logger, nil)
 	serviceConfig.clock = clock.NewMock()
-	authorize := func(context context.Context) (bool, error) { return true, nil }
+	authorize := func(context.Context, *models.BusinessCase) (bool, error) { return true, nil }
 
 	s.Run("successfully fetches Business Case by ID without an error", func() {
 		fetch := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
@@ -45,6 +46,60 @@ func (s ServicesTestSuite) TestBusinessCaseByIDFetcher() {
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(&models.BusinessCase{}, businessCase)
 	})
+
+	s.Run("returns unauthorized error for someone else's business case", func() {
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+			return &models.BusinessCase{ID: fakeID, EUAUserID: "NOPE"}, nil
+		}
+		fetchBusinessCaseByID := NewFetchBusinessCaseByID(serviceConfig, fetch, NewAuthorizeUserIsBusinessCaseRequesterOrHasGRTJobCode())
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchBusinessCaseByID(ctx, fakeID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestBusinessCasesByIDsFetcher() {
+	serviceConfig := NewConfig(zap.NewNop(), nil)
+	serviceConfig.clock = clock.NewMock()
+	authorize := func(context context.Context) (bool, error) { return true, nil }
+	fetch := func(ctx context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+		businessCases := []*models.BusinessCase{}
+		for _, id := range ids {
+			businessCases = append(businessCases, &models.BusinessCase{ID: id})
+		}
+		return businessCases, nil
+	}
+
+	s.Run("successfully fetches Business Cases by ID without an error", func() {
+		fetchBusinessCasesByIDs := NewFetchBusinessCasesByIDs(serviceConfig, fetch, authorize)
+		businessCases, err := fetchBusinessCasesByIDs(context.Background(), []uuid.UUID{uuid.New(), uuid.New()})
+		s.NoError(err)
+
+		s.Len(businessCases, 2)
+	})
+
+	s.Run("returns unauthorized error when authorization fails", func() {
+		unauthorize := func(context context.Context) (bool, error) { return false, nil }
+		fetchBusinessCasesByIDs := NewFetchBusinessCasesByIDs(serviceConfig, fetch, unauthorize)
+
+		businessCases, err := fetchBusinessCasesByIDs(context.Background(), []uuid.UUID{uuid.New()})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+		s.Nil(businessCases)
+	})
+
+	s.Run("returns query error when fetch fails", func() {
+		failFetch := func(ctx context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+			return nil, errors.New("fetch failed")
+		}
+		fetchBusinessCasesByIDs := NewFetchBusinessCasesByIDs(serviceConfig, failFetch, authorize)
+
+		_, err := fetchBusinessCasesByIDs(context.Background(), []uuid.UUID{uuid.New()})
+
+		s.IsType(&apperrors.QueryError{}, err)
+	})
 }
 
 func (s ServicesTestSuite) TestBusinessCasesByEuaIDFetcher() {
@@ -124,18 +179,24 @@ func (s ServicesTestSuite) TestBusinessCaseCreator() {
 	}
 
 	s.Run("successfully creates a Business Case without an error", func() {
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, create, updateIntake)
+		var published *models.SystemIntake
+		publish := func(_ context.Context, intake *models.SystemIntake) error {
+			published = intake
+			return nil
+		}
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, create, updateIntake, noTransaction, publish)
 		businessCase, err := createBusinessCase(ctx, &input)
 		s.NoError(err)
 
 		s.Equal(euaID.ValueOrZero(), businessCase.EUAUserID)
+		s.Equal(models.SystemIntakeStatusBIZCASEDRAFT, published.Status)
 	})
 
 	s.Run("returns query error when create fails", func() {
 		failCreate := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 			return &models.BusinessCase{}, errors.New("creation failed")
 		}
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, failCreate, updateIntake)
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, failCreate, updateIntake, noTransaction, noPublish)
 		businessCase, err := createBusinessCase(ctx, &input)
 
 		s.Error(err)
@@ -158,7 +219,7 @@ func (s ServicesTestSuite) TestBusinessCaseCreator() {
 		_, err := s.store.UpdateSystemIntake(ctx, intake)
 		s.NoError(err)
 
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, create, updateIntake)
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, fetchUserInfo, create, updateIntake, noTransaction, noPublish)
 
 		businessCase, err := createBusinessCase(ctx, &input)
 		s.NoError(err)
@@ -173,7 +234,7 @@ func (s ServicesTestSuite) TestBusinessCaseCreator() {
 			return nil, errors.New("error")
 		}
 
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, failCreateAction, fetchUserInfo, create, updateIntake)
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, failCreateAction, fetchUserInfo, create, updateIntake, noTransaction, noPublish)
 		businessCase, err := createBusinessCase(ctx, &input)
 
 		s.IsType(&apperrors.QueryError{}, err)
@@ -185,7 +246,7 @@ func (s ServicesTestSuite) TestBusinessCaseCreator() {
 		failFetchUserInfo := func(_ context.Context, EUAUserID string) (*models.UserInfo, error) {
 			return nil, fetchUserInfoError
 		}
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, failFetchUserInfo, create, updateIntake)
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, failFetchUserInfo, create, updateIntake, noTransaction, noPublish)
 		businessCase, err := createBusinessCase(ctx, &input)
 		s.Equal(fetchUserInfoError, err)
 		s.Equal(&models.BusinessCase{}, businessCase)
@@ -195,7 +256,7 @@ func (s ServicesTestSuite) TestBusinessCaseCreator() {
 		failFetchUserInfo := func(_ context.Context, EUAUserID string) (*models.UserInfo, error) {
 			return &models.UserInfo{}, nil
 		}
-		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, failFetchUserInfo, create, updateIntake)
+		createBusinessCase := NewCreateBusinessCase(serviceConfig, fetch, authorize, createAction, failFetchUserInfo, create, updateIntake, noTransaction, noPublish)
 		businessCase, err := createBusinessCase(ctx, &input)
 
 		s.IsType(&apperrors.ExternalAPIError{}, err)
@@ -261,6 +322,26 @@ func (s ServicesTestSuite) TestBusinessCaseUpdater() {
 		s.Equal(&models.BusinessCase{}, businessCase)
 	})
 
+	s.Run("returns not found error when the business case doesn't exist", func() {
+		notFound := func(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+			return nil, &apperrors.ResourceNotFoundError{Err: errors.New("no rows"), Resource: models.BusinessCase{}}
+		}
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, notFound, authorize, update)
+		_, err := updateBusinessCase(ctx, &existingBusinessCase)
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("returns not found error when the business case is deleted before it's updated", func() {
+		notFoundUpdate := func(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
+			return businessCase, &apperrors.ResourceNotFoundError{Err: errors.New("no rows"), Resource: models.BusinessCase{}}
+		}
+		updateBusinessCase := NewUpdateBusinessCase(serviceConfig, fetch, authorize, notFoundUpdate)
+		_, err := updateBusinessCase(ctx, &existingBusinessCase)
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
 	// Uncomment below when UI has changed for unique lifecycle costs
 	//s.Run("returns validation error when lifecycle cost phases are duplicated", func() {
 	//	existingBusinessCase.LifecycleCostLines = models.EstimatedLifecycleCosts{
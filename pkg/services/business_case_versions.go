@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchBusinessCaseVersions is a service to fetch every submitted version of a business case.
+// Only the GRT can see them.
+func NewFetchBusinessCaseVersions(
+	config Config,
+	fetch func(context.Context, uuid.UUID) ([]models.BusinessCaseVersion, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, uuid.UUID) ([]models.BusinessCaseVersion, error) {
+	return func(ctx context.Context, businessCaseID uuid.UUID) ([]models.BusinessCaseVersion, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch business case versions")}
+		}
+		return fetch(ctx, businessCaseID)
+	}
+}
+
+// NewFetchBusinessCaseVersionDiff is a service to compare two submitted versions of a business case.
+// Only the GRT can compare them.
+func NewFetchBusinessCaseVersionDiff(
+	config Config,
+	fetch func(context.Context, uuid.UUID, int) (*models.BusinessCaseVersion, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, uuid.UUID, int, int) (*models.BusinessCaseVersionDiff, error) {
+	return func(ctx context.Context, businessCaseID uuid.UUID, from int, to int) (*models.BusinessCaseVersionDiff, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch business case version diff")}
+		}
+
+		fromVersion, err := fetch(ctx, businessCaseID, from)
+		if err != nil {
+			return nil, err
+		}
+		toVersion, err := fetch(ctx, businessCaseID, to)
+		if err != nil {
+			return nil, err
+		}
+		return diffBusinessCaseVersions(fromVersion, toVersion)
+	}
+}
+
+// versionDiffIgnoredFields change on every submission, or are compared on their own
+var versionDiffIgnoredFields = []string{
+	"updatedAt",
+	"version",
+	"systemIntakeStatus",
+	"lifecycleCostLines",
+}
+
+// diffBusinessCaseVersions returns the fields that changed from one version to the other,
+// and the lifecycle costs that changed
+func diffBusinessCaseVersions(from *models.BusinessCaseVersion, to *models.BusinessCaseVersion) (*models.BusinessCaseVersionDiff, error) {
+	changes, err := models.NewAuditChanges(from.BusinessCase, to.BusinessCase)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range versionDiffIgnoredFields {
+		delete(changes, name)
+	}
+
+	return &models.BusinessCaseVersionDiff{
+		From:               from.Number,
+		To:                 to.Number,
+		Changes:            changes,
+		LifecycleCostLines: lifecycleCostDeltas(from.BusinessCase.LifecycleCostLines, to.BusinessCase.LifecycleCostLines),
+	}, nil
+}
+
+type lifecycleCostKey struct {
+	solution models.LifecycleCostSolution
+	phase    models.LifecycleCostPhase
+	year     models.LifecycleCostYear
+}
+
+func newLifecycleCostKey(line models.EstimatedLifecycleCost) lifecycleCostKey {
+	key := lifecycleCostKey{solution: line.Solution, year: line.Year}
+	if line.Phase != nil {
+		key.phase = *line.Phase
+	}
+	return key
+}
+
+// lifecycleCostTotals adds up the cost of each year of each phase of each solution.
+// A year with no costs entered has no total.
+func lifecycleCostTotals(lines models.EstimatedLifecycleCosts) map[lifecycleCostKey]*int {
+	totals := map[lifecycleCostKey]*int{}
+	for _, line := range lines {
+		key := newLifecycleCostKey(line)
+		total := totals[key]
+		if line.Cost != nil {
+			sum := *line.Cost
+			if total != nil {
+				sum += *total
+			}
+			total = &sum
+		}
+		totals[key] = total
+	}
+	return totals
+}
+
+// lifecycleCostDeltas returns the lifecycle costs that differ between two sets of cost lines,
+// ordered by solution, phase and year
+func lifecycleCostDeltas(from models.EstimatedLifecycleCosts, to models.EstimatedLifecycleCosts) []models.LifecycleCostDelta {
+	fromTotals := lifecycleCostTotals(from)
+	toTotals := lifecycleCostTotals(to)
+
+	keys := []lifecycleCostKey{}
+	for key := range fromTotals {
+		keys = append(keys, key)
+	}
+	for key := range toTotals {
+		if _, ok := fromTotals[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].solution != keys[j].solution {
+			return keys[i].solution < keys[j].solution
+		}
+		if keys[i].phase != keys[j].phase {
+			return keys[i].phase < keys[j].phase
+		}
+		return keys[i].year < keys[j].year
+	})
+
+	deltas := []models.LifecycleCostDelta{}
+	for _, key := range keys {
+		old, new := fromTotals[key], toTotals[key]
+		if old == nil && new == nil || old != nil && new != nil && *old == *new {
+			continue
+		}
+		delta := models.LifecycleCostDelta{
+			Solution: key.solution,
+			Year:     key.year,
+			Old:      old,
+			New:      new,
+		}
+		if key.phase != "" {
+			phase := key.phase
+			delta.Phase = &phase
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestFetchBusinessCaseVersions() {
+	cfg := NewConfig(nil, nil)
+	businessCaseID := uuid.New()
+	fetch := func(_ context.Context, id uuid.UUID) ([]models.BusinessCaseVersion, error) {
+		return []models.BusinessCaseVersion{{BusinessCaseID: id, Number: 1}}, nil
+	}
+	fetchVersions := NewFetchBusinessCaseVersions(cfg, fetch, NewAuthorizeRequireGRTJobCode())
+
+	s.Run("reviewer can see the submitted versions", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+
+		versions, err := fetchVersions(ctx, businessCaseID)
+
+		s.NoError(err)
+		s.Len(versions, 1)
+		s.Equal(businessCaseID, versions[0].BusinessCaseID)
+	})
+
+	s.Run("requester can't see the submitted versions", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchVersions(ctx, businessCaseID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestFetchBusinessCaseVersionDiff() {
+	cfg := NewConfig(nil, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+	businessCaseID := uuid.New()
+	development := models.LifecycleCostPhaseDEVELOPMENT
+	cost := func(c int) *int { return &c }
+	line := func(solution models.LifecycleCostSolution, year models.LifecycleCostYear, c *int) models.EstimatedLifecycleCost {
+		return models.EstimatedLifecycleCost{ID: uuid.New(), Solution: solution, Phase: &development, Year: year, Cost: c}
+	}
+
+	versions := map[int]*models.BusinessCaseVersion{
+		1: {
+			BusinessCaseID: businessCaseID,
+			Number:         1,
+			BusinessCase: models.BusinessCaseSnapshot{
+				ProjectName:  null.StringFrom("Draft name"),
+				BusinessNeed: null.StringFrom("The same need"),
+				Version:      3,
+				LifecycleCostLines: models.EstimatedLifecycleCosts{
+					line(models.LifecycleCostSolutionPREFERRED, models.LifecycleCostYear1, cost(100)),
+					line(models.LifecycleCostSolutionPREFERRED, models.LifecycleCostYear2, cost(200)),
+					line(models.LifecycleCostSolutionA, models.LifecycleCostYear1, cost(50)),
+				},
+			},
+		},
+		2: {
+			BusinessCaseID: businessCaseID,
+			Number:         2,
+			BusinessCase: models.BusinessCaseSnapshot{
+				ProjectName:  null.StringFrom("Final name"),
+				BusinessNeed: null.StringFrom("The same need"),
+				Version:      7,
+				LifecycleCostLines: models.EstimatedLifecycleCosts{
+					line(models.LifecycleCostSolutionA, models.LifecycleCostYear1, cost(50)),
+					line(models.LifecycleCostSolutionPREFERRED, models.LifecycleCostYear1, cost(150)),
+					line(models.LifecycleCostSolutionPREFERRED, models.LifecycleCostYear3, cost(300)),
+				},
+			},
+		},
+	}
+	fetch := func(_ context.Context, id uuid.UUID, number int) (*models.BusinessCaseVersion, error) {
+		version, ok := versions[number]
+		if !ok {
+			return nil, &apperrors.ResourceNotFoundError{Resource: models.BusinessCaseVersion{}}
+		}
+		return version, nil
+	}
+	fetchDiff := NewFetchBusinessCaseVersionDiff(cfg, fetch, NewAuthorizeRequireGRTJobCode())
+
+	s.Run("returns the fields and lifecycle costs that changed", func() {
+		diff, err := fetchDiff(ctx, businessCaseID, 1, 2)
+		s.NoError(err)
+
+		s.Equal(1, diff.From)
+		s.Equal(2, diff.To)
+		s.Equal(models.AuditChanges{
+			"projectName": {Old: json.RawMessage(`"Draft name"`), New: json.RawMessage(`"Final name"`)},
+		}, diff.Changes)
+		s.Equal([]models.LifecycleCostDelta{
+			{Solution: models.LifecycleCostSolutionPREFERRED, Phase: &development, Year: models.LifecycleCostYear1, Old: cost(100), New: cost(150)},
+			{Solution: models.LifecycleCostSolutionPREFERRED, Phase: &development, Year: models.LifecycleCostYear2, Old: cost(200), New: nil},
+			{Solution: models.LifecycleCostSolutionPREFERRED, Phase: &development, Year: models.LifecycleCostYear3, Old: nil, New: cost(300)},
+		}, diff.LifecycleCostLines)
+	})
+
+	s.Run("a version doesn't differ from itself", func() {
+		diff, err := fetchDiff(ctx, businessCaseID, 2, 2)
+		s.NoError(err)
+
+		s.Empty(diff.Changes)
+		s.Empty(diff.LifecycleCostLines)
+	})
+
+	s.Run("returns the error if a version doesn't exist", func() {
+		_, err := fetchDiff(ctx, businessCaseID, 1, 3)
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("requester can't compare versions", func() {
+		requesterCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchDiff(requesterCtx, businessCaseID, 1, 2)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
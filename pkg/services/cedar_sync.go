@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// submitSystemIntakeToCedar sends a submitted intake to CEDAR and saves the Alfabet ID it's given.
+// It returns whether CEDAR gave the intake an ID.
+func submitSystemIntakeToCedar(
+	ctx context.Context,
+	submitToCedar func(context.Context, *models.SystemIntake) (string, error),
+	saveAlfabetID func(context.Context, uuid.UUID, string) (*models.SystemIntake, error),
+	intake *models.SystemIntake,
+) (bool, error) {
+	alfabetID, err := submitToCedar(ctx, intake)
+	if err != nil {
+		return false, err
+	}
+	// nothing comes back while we aren't sending intakes to CEDAR
+	if alfabetID == "" {
+		return false, nil
+	}
+	intake.AlfabetID = null.StringFrom(alfabetID)
+	if _, err = saveAlfabetID(ctx, intake.ID, alfabetID); err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to save Alfabet ID from CEDAR",
+			zap.Error(err),
+			zap.String("intakeID", intake.ID.String()),
+			zap.String("alfabetID", alfabetID),
+		)
+		return false, err
+	}
+	return true, nil
+}
+
+// submitBusinessCaseToCedar sends a submitted business case to CEDAR and saves the ID it's given.
+// It returns whether CEDAR gave the business case a new ID.
+func submitBusinessCaseToCedar(
+	ctx context.Context,
+	submitToCedar func(context.Context, *models.BusinessCase) (string, error),
+	saveCedarID func(context.Context, uuid.UUID, string) (*models.BusinessCase, error),
+	businessCase *models.BusinessCase,
+) (bool, error) {
+	cedarID, err := submitToCedar(ctx, businessCase)
+	if err != nil {
+		return false, err
+	}
+	// nothing comes back while we aren't sending business cases to CEDAR,
+	// and updates come back with the ID the business case already has
+	if cedarID == "" || cedarID == businessCase.CedarID.ValueOrZero() {
+		return false, nil
+	}
+	businessCase.CedarID = null.StringFrom(cedarID)
+	if _, err = saveCedarID(ctx, businessCase.ID, cedarID); err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to save CEDAR ID",
+			zap.Error(err),
+			zap.String("businessCaseID", businessCase.ID.String()),
+			zap.String("cedarID", cedarID),
+		)
+		return false, err
+	}
+	return true, nil
+}
+
+// syncSystemIntakeToCedar sends the status and decision of an intake to CEDAR if it's been submitted there.
+// The change has already been made in EASi, so failing to send it is logged rather than returned,
+// and left for reconciliation to repair.
+func syncSystemIntakeToCedar(
+	ctx context.Context,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+	intake *models.SystemIntake,
+) {
+	if intake.AlfabetID.ValueOrZero() == "" {
+		return
+	}
+	if err := updateCedar(ctx, intake); err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to update intake in CEDAR",
+			zap.Error(err),
+			zap.String("intakeID", intake.ID.String()),
+			zap.String("alfabetID", intake.AlfabetID.String),
+		)
+	}
+}
+
+// NewReconcileCedarSubmissions returns a function that finds the intakes and business cases submitted
+// in EASi that CEDAR never got, and reports them as drift. When repairing, they're submitted to CEDAR.
+func NewReconcileCedarSubmissions(
+	config Config,
+	fetchAwaitingIntakes func(context.Context) (models.SystemIntakes, error),
+	submitIntake func(context.Context, *models.SystemIntake) (string, error),
+	saveAlfabetID func(context.Context, uuid.UUID, string) (*models.SystemIntake, error),
+	fetchAwaitingBusinessCases func(context.Context) (models.BusinessCases, error),
+	submitBusinessCase func(context.Context, *models.BusinessCase) (string, error),
+	saveCedarID func(context.Context, uuid.UUID, string) (*models.BusinessCase, error),
+) func(context.Context, bool) ([]models.SystemIntakeCedarDrift, error) {
+	return func(ctx context.Context, repair bool) ([]models.SystemIntakeCedarDrift, error) {
+		intakes, err := fetchAwaitingIntakes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		businessCases, err := fetchAwaitingBusinessCases(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		drift := []models.SystemIntakeCedarDrift{}
+		failed := 0
+		// intakes go first, since CEDAR needs an intake before its business case
+		for i := range intakes {
+			intake := &intakes[i]
+			drift = append(drift, models.SystemIntakeCedarDrift{
+				IntakeID:   intake.ID,
+				Field:      "submitted",
+				EASiValue:  true,
+				CEDARValue: false,
+			})
+			if !repair {
+				continue
+			}
+			logger := appcontext.ZLogger(ctx).With(zap.String("intakeID", intake.ID.String()))
+			submitted, err := submitSystemIntakeToCedar(ctx, submitIntake, saveAlfabetID, intake)
+			if err != nil {
+				logger.Error("Failed to submit intake to CEDAR", zap.Error(err))
+				failed++
+				continue
+			}
+			if submitted {
+				logger.Info("Submitted intake to CEDAR", zap.String("alfabetID", intake.AlfabetID.String))
+			}
+		}
+		for i := range businessCases {
+			businessCase := &businessCases[i]
+			drift = append(drift, models.SystemIntakeCedarDrift{
+				IntakeID:   businessCase.SystemIntakeID,
+				Field:      "businessCaseSubmitted",
+				EASiValue:  true,
+				CEDARValue: false,
+			})
+			if !repair {
+				continue
+			}
+			logger := appcontext.ZLogger(ctx).With(zap.String("businessCaseID", businessCase.ID.String()))
+			submitted, err := submitBusinessCaseToCedar(ctx, submitBusinessCase, saveCedarID, businessCase)
+			if err != nil {
+				logger.Error("Failed to submit business case to CEDAR", zap.Error(err))
+				failed++
+				continue
+			}
+			if submitted {
+				logger.Info("Submitted business case to CEDAR", zap.String("cedarID", businessCase.CedarID.String))
+			}
+		}
+
+		if failed > 0 {
+			return drift, fmt.Errorf(
+				"failed to submit %d of %d intakes and business cases to CEDAR",
+				failed,
+				len(intakes)+len(businessCases),
+			)
+		}
+		return drift, nil
+	}
+}
+
+// NewReconcileCedarIntakes returns a function that compares every intake submitted to CEDAR
+// with what CEDAR has for it. When repairing, EASi's version of an intake that differs is sent to CEDAR.
+func NewReconcileCedarIntakes(
+	config Config,
+	fetchSubmitted func(context.Context) (models.SystemIntakes, error),
+	fetchDrift func(context.Context, *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error),
+	updateCedar func(context.Context, *models.SystemIntake) error,
+) func(context.Context, bool) ([]models.SystemIntakeCedarDrift, error) {
+	return func(ctx context.Context, repair bool) ([]models.SystemIntakeCedarDrift, error) {
+		intakes, err := fetchSubmitted(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// one intake failing shouldn't stop the rest from being checked
+		drift := []models.SystemIntakeCedarDrift{}
+		failed := 0
+		for i := range intakes {
+			intake := &intakes[i]
+			logger := appcontext.ZLogger(ctx).With(
+				zap.String("intakeID", intake.ID.String()),
+				zap.String("alfabetID", intake.AlfabetID.String),
+			)
+			intakeDrift, err := fetchDrift(ctx, intake)
+			if err != nil {
+				logger.Error("Failed to compare intake with CEDAR", zap.Error(err))
+				failed++
+				continue
+			}
+			drift = append(drift, intakeDrift...)
+			if len(intakeDrift) == 0 || !repair {
+				continue
+			}
+			if err := updateCedar(ctx, intake); err != nil {
+				logger.Error("Failed to repair intake in CEDAR", zap.Error(err))
+				failed++
+				continue
+			}
+			logger.Info("Repaired intake in CEDAR", zap.Int("fields", len(intakeDrift)))
+		}
+
+		if failed > 0 {
+			return drift, fmt.Errorf("failed to reconcile %d of %d intakes with CEDAR", failed, len(intakes))
+		}
+		return drift, nil
+	}
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestReconcileCedarIntakes() {
+	ctx := context.Background()
+	serviceConfig := NewConfig(zap.NewNop(), nil)
+
+	inSync := models.SystemIntake{ID: uuid.New(), AlfabetID: null.StringFrom("000-000-1")}
+	drifted := models.SystemIntake{ID: uuid.New(), AlfabetID: null.StringFrom("000-000-2")}
+	fetchSubmitted := func(ctx context.Context) (models.SystemIntakes, error) {
+		return models.SystemIntakes{inSync, drifted}, nil
+	}
+	fetchDrift := func(ctx context.Context, intake *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error) {
+		if intake.ID != drifted.ID {
+			return []models.SystemIntakeCedarDrift{}, nil
+		}
+		return []models.SystemIntakeCedarDrift{{
+			IntakeID:   intake.ID,
+			AlfabetID:  intake.AlfabetID.String,
+			Field:      "status",
+			EASiValue:  "WITHDRAWN",
+			CEDARValue: "INTAKE_SUBMITTED",
+		}}, nil
+	}
+	var repaired []uuid.UUID
+	updateCedar := func(ctx context.Context, intake *models.SystemIntake) error {
+		repaired = append(repaired, intake.ID)
+		return nil
+	}
+
+	s.Run("reports drift without repairing it", func() {
+		repaired = nil
+		reconcile := NewReconcileCedarIntakes(serviceConfig, fetchSubmitted, fetchDrift, updateCedar)
+
+		drift, err := reconcile(ctx, false)
+
+		s.NoError(err)
+		s.Len(drift, 1)
+		s.Equal(drifted.ID, drift[0].IntakeID)
+		s.Empty(repaired)
+	})
+
+	s.Run("repairs only the intakes that drifted", func() {
+		repaired = nil
+		reconcile := NewReconcileCedarIntakes(serviceConfig, fetchSubmitted, fetchDrift, updateCedar)
+
+		drift, err := reconcile(ctx, true)
+
+		s.NoError(err)
+		s.Len(drift, 1)
+		s.Equal([]uuid.UUID{drifted.ID}, repaired)
+	})
+
+	s.Run("keeps checking after an intake fails and returns an error", func() {
+		repaired = nil
+		failFirst := func(ctx context.Context, intake *models.SystemIntake) ([]models.SystemIntakeCedarDrift, error) {
+			if intake.ID == inSync.ID {
+				return nil, errors.New("CEDAR is down")
+			}
+			return fetchDrift(ctx, intake)
+		}
+		reconcile := NewReconcileCedarIntakes(serviceConfig, fetchSubmitted, failFirst, updateCedar)
+
+		drift, err := reconcile(ctx, true)
+
+		s.Error(err)
+		s.Len(drift, 1)
+		s.Equal([]uuid.UUID{drifted.ID}, repaired)
+	})
+
+	s.Run("returns error if the intakes can't be fetched", func() {
+		failFetch := func(ctx context.Context) (models.SystemIntakes, error) {
+			return nil, errors.New("fetch failed")
+		}
+		reconcile := NewReconcileCedarIntakes(serviceConfig, failFetch, fetchDrift, updateCedar)
+
+		_, err := reconcile(ctx, false)
+
+		s.Error(err)
+	})
+}
+
+func (s ServicesTestSuite) TestReconcileCedarSubmissions() {
+	ctx := context.Background()
+	serviceConfig := NewConfig(zap.NewNop(), nil)
+
+	first := models.SystemIntake{ID: uuid.New()}
+	second := models.SystemIntake{ID: uuid.New()}
+	fetchAwaiting := func(ctx context.Context) (models.SystemIntakes, error) {
+		return models.SystemIntakes{first, second}, nil
+	}
+	var submitted []uuid.UUID
+	submit := func(ctx context.Context, intake *models.SystemIntake) (string, error) {
+		submitted = append(submitted, intake.ID)
+		return "000-000-" + intake.ID.String()[:1], nil
+	}
+	saved := map[uuid.UUID]string{}
+	saveAlfabetID := func(ctx context.Context, id uuid.UUID, alfabetID string) (*models.SystemIntake, error) {
+		saved[id] = alfabetID
+		return &models.SystemIntake{ID: id, AlfabetID: null.StringFrom(alfabetID)}, nil
+	}
+	noBusinessCases := func(ctx context.Context) (models.BusinessCases, error) {
+		return models.BusinessCases{}, nil
+	}
+	businessCase := models.BusinessCase{ID: uuid.New(), SystemIntakeID: first.ID}
+	fetchAwaitingBusinessCases := func(ctx context.Context) (models.BusinessCases, error) {
+		return models.BusinessCases{businessCase}, nil
+	}
+	var submittedBusinessCases []uuid.UUID
+	submitBusinessCase := func(ctx context.Context, businessCase *models.BusinessCase) (string, error) {
+		submittedBusinessCases = append(submittedBusinessCases, businessCase.ID)
+		return "CEDAR-1", nil
+	}
+	savedCedarIDs := map[uuid.UUID]string{}
+	saveCedarID := func(ctx context.Context, id uuid.UUID, cedarID string) (*models.BusinessCase, error) {
+		savedCedarIDs[id] = cedarID
+		return &models.BusinessCase{ID: id, CedarID: null.StringFrom(cedarID)}, nil
+	}
+
+	s.Run("reports the intakes CEDAR is missing without submitting them", func() {
+		submitted = nil
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		drift, err := reconcile(ctx, false)
+
+		s.NoError(err)
+		s.Len(drift, 2)
+		s.Equal(first.ID, drift[0].IntakeID)
+		s.Equal("submitted", drift[0].Field)
+		s.Empty(submitted)
+	})
+
+	s.Run("submits the missing intakes and saves their Alfabet IDs", func() {
+		submitted = nil
+		saved = map[uuid.UUID]string{}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		drift, err := reconcile(ctx, true)
+
+		s.NoError(err)
+		s.Len(drift, 2)
+		s.Equal([]uuid.UUID{first.ID, second.ID}, submitted)
+		s.Equal("000-000-"+first.ID.String()[:1], saved[first.ID])
+		s.Contains(saved, second.ID)
+	})
+
+	s.Run("doesn't save an ID while intakes aren't sent to CEDAR", func() {
+		saved = map[uuid.UUID]string{}
+		notSent := func(context.Context, *models.SystemIntake) (string, error) { return "", nil }
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, notSent, saveAlfabetID, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, true)
+
+		s.NoError(err)
+		s.Empty(saved)
+	})
+
+	s.Run("keeps submitting after an intake fails and returns an error", func() {
+		submitted = nil
+		saved = map[uuid.UUID]string{}
+		failFirst := func(ctx context.Context, intake *models.SystemIntake) (string, error) {
+			if intake.ID == first.ID {
+				return "", errors.New("CEDAR is down")
+			}
+			return submit(ctx, intake)
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, failFirst, saveAlfabetID, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, true)
+
+		s.Error(err)
+		s.Equal([]uuid.UUID{second.ID}, submitted)
+		s.NotContains(saved, first.ID)
+		s.Contains(saved, second.ID)
+	})
+
+	s.Run("returns error if saving the Alfabet ID fails", func() {
+		failSave := func(context.Context, uuid.UUID, string) (*models.SystemIntake, error) {
+			return nil, errors.New("save failed")
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, failSave, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, true)
+
+		s.Error(err)
+	})
+
+	s.Run("returns error if the intakes can't be fetched", func() {
+		failFetch := func(ctx context.Context) (models.SystemIntakes, error) {
+			return nil, errors.New("fetch failed")
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, failFetch, submit, saveAlfabetID, noBusinessCases, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, false)
+
+		s.Error(err)
+	})
+
+	s.Run("reports the business cases CEDAR is missing after the intakes", func() {
+		submittedBusinessCases = nil
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, fetchAwaitingBusinessCases, submitBusinessCase, saveCedarID)
+
+		drift, err := reconcile(ctx, false)
+
+		s.NoError(err)
+		s.Len(drift, 3)
+		s.Equal(first.ID, drift[2].IntakeID)
+		s.Equal("businessCaseSubmitted", drift[2].Field)
+		s.Empty(submittedBusinessCases)
+	})
+
+	s.Run("submits the missing business cases and saves their CEDAR IDs", func() {
+		submittedBusinessCases = nil
+		savedCedarIDs = map[uuid.UUID]string{}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, fetchAwaitingBusinessCases, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, true)
+
+		s.NoError(err)
+		s.Equal([]uuid.UUID{businessCase.ID}, submittedBusinessCases)
+		s.Equal("CEDAR-1", savedCedarIDs[businessCase.ID])
+	})
+
+	s.Run("returns error if a business case fails to submit", func() {
+		savedCedarIDs = map[uuid.UUID]string{}
+		failSubmit := func(context.Context, *models.BusinessCase) (string, error) {
+			return "", errors.New("CEDAR is down")
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, fetchAwaitingBusinessCases, failSubmit, saveCedarID)
+
+		drift, err := reconcile(ctx, true)
+
+		s.Error(err)
+		s.Len(drift, 3)
+		s.Empty(savedCedarIDs)
+	})
+
+	s.Run("returns error if saving the CEDAR ID fails", func() {
+		failSave := func(context.Context, uuid.UUID, string) (*models.BusinessCase, error) {
+			return nil, errors.New("save failed")
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, fetchAwaitingBusinessCases, submitBusinessCase, failSave)
+
+		_, err := reconcile(ctx, true)
+
+		s.Error(err)
+	})
+
+	s.Run("returns error if the business cases can't be fetched", func() {
+		failFetch := func(ctx context.Context) (models.BusinessCases, error) {
+			return nil, errors.New("fetch failed")
+		}
+		reconcile := NewReconcileCedarSubmissions(serviceConfig, fetchAwaiting, submit, saveAlfabetID, failFetch, submitBusinessCase, saveCedarID)
+
+		_, err := reconcile(ctx, false)
+
+		s.Error(err)
+	})
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchFailedOutboxEmails is a service to fetch emails that failed delivery
+func NewFetchFailedOutboxEmails(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context) (models.OutboxEmails, error),
+) func(context.Context) (models.OutboxEmails, error) {
+	return func(ctx context.Context) (models.OutboxEmails, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch failed emails")}
+		}
+		return fetch(ctx)
+	}
+}
+
+// NewRedriveOutboxEmail is a service to queue a failed email for immediate delivery
+func NewRedriveOutboxEmail(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.OutboxEmail, error),
+	update func(context.Context, *models.OutboxEmail) (*models.OutboxEmail, error),
+) func(context.Context, uuid.UUID) (*models.OutboxEmail, error) {
+	return func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize re-drive email")}
+		}
+		email, err := fetch(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if email.SentAt != nil {
+			return nil, &apperrors.ResourceConflictError{
+				Err:        errors.New("email has already been sent"),
+				Resource:   models.OutboxEmail{},
+				ResourceID: id.String(),
+			}
+		}
+		// the last error is kept so admins can see why it was re-driven
+		now := config.clock.Now()
+		email.Attempts = 0
+		email.NextAttemptAt = &now
+		return update(ctx, email)
+	}
+}
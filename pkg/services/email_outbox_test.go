@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestFetchFailedOutboxEmails() {
+	ctx := context.Background()
+	cfg := NewConfig(nil, nil)
+	authorize := func(context.Context) (bool, error) { return true, nil }
+	fetch := func(context.Context) (models.OutboxEmails, error) {
+		return models.OutboxEmails{{ID: uuid.New()}}, nil
+	}
+
+	s.Run("golden path fetches failed emails", func() {
+		emails, err := NewFetchFailedOutboxEmails(cfg, authorize, fetch)(ctx)
+		s.NoError(err)
+		s.Len(emails, 1)
+	})
+
+	s.Run("returns unauthorized error if authorization fails", func() {
+		unauthorize := func(context.Context) (bool, error) { return false, nil }
+		_, err := NewFetchFailedOutboxEmails(cfg, unauthorize, fetch)(ctx)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestRedriveOutboxEmail() {
+	ctx := context.Background()
+	cfg := NewConfig(nil, nil)
+	mockClock := clock.NewMock()
+	mockClock.Add(time.Hour)
+	cfg.clock = mockClock
+	authorize := func(context.Context) (bool, error) { return true, nil }
+	failedEmail := func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+		return &models.OutboxEmail{
+			ID:        id,
+			Attempts:  8,
+			LastError: null.StringFrom("throttled"),
+		}, nil
+	}
+	update := func(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+		return email, nil
+	}
+
+	s.Run("golden path queues the email for immediate delivery", func() {
+		id := uuid.New()
+		email, err := NewRedriveOutboxEmail(cfg, authorize, failedEmail, update)(ctx, id)
+		s.NoError(err)
+		s.Equal(id, email.ID)
+		s.Equal(0, email.Attempts)
+		s.Equal(mockClock.Now(), *email.NextAttemptAt)
+		s.Equal("throttled", email.LastError.String)
+	})
+
+	s.Run("returns conflict error if the email was already sent", func() {
+		sentEmail := func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+			sentAt := mockClock.Now()
+			return &models.OutboxEmail{ID: id, SentAt: &sentAt}, nil
+		}
+		_, err := NewRedriveOutboxEmail(cfg, authorize, sentEmail, update)(ctx, uuid.New())
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("returns unauthorized error if authorization fails", func() {
+		unauthorize := func(context.Context) (bool, error) { return false, nil }
+		_, err := NewRedriveOutboxEmail(cfg, unauthorize, failedEmail, update)(ctx, uuid.New())
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns error if fetch fails", func() {
+		fetchErr := errors.New("failed to fetch")
+		failFetch := func(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+			return nil, fetchErr
+		}
+		_, err := NewRedriveOutboxEmail(cfg, authorize, failFetch, update)(ctx, uuid.New())
+		s.Equal(fetchErr, err)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/upload"
@@ -68,8 +70,11 @@ func NewCreateFileDownloadURL(config Config, authorize authFunc, s3client upload
 
 }
 
+// publishFunc is a function that announces a newly uploaded file
+type publishFunc func(context.Context, *models.UploadedFile) error
+
 // NewCreateUploadedFile returns a function that saves the metadata of an uploaded file
-func NewCreateUploadedFile(config Config, authorize authFunc, create createFunc) func(ctx context.Context, file *models.UploadedFile) (*models.UploadedFile, error) {
+func NewCreateUploadedFile(config Config, authorize authFunc, create createFunc, publish publishFunc) func(ctx context.Context, file *models.UploadedFile) (*models.UploadedFile, error) {
 	return func(ctx context.Context, file *models.UploadedFile) (*models.UploadedFile, error) {
 		ok, err := authorize(ctx)
 		if err != nil {
@@ -83,7 +88,14 @@ func NewCreateUploadedFile(config Config, authorize authFunc, create createFunc)
 			}
 		}
 
-		return create(ctx, file)
+		created, err := create(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		if err := publish(ctx, created); err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to publish uploaded file", zap.Error(err))
+		}
+		return created, nil
 	}
 }
 
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// GovernanceRole represents who is allowed to take a governance action
+type GovernanceRole string
+
+const (
+	// GovernanceRoleRequester is the requester who owns the system intake
+	GovernanceRoleRequester GovernanceRole = "REQUESTER"
+	// GovernanceRoleGRT is a member of the Governance Review Team
+	GovernanceRoleGRT GovernanceRole = "GRT"
+)
+
+// GovernanceEffect represents the work done when a governance action is taken,
+// including which email gets sent
+type GovernanceEffect string
+
+const (
+	// GovernanceEffectSubmitIntake submits the intake to CEDAR and emails the GRT
+	GovernanceEffectSubmitIntake GovernanceEffect = "SUBMIT_INTAKE"
+	// GovernanceEffectSubmitBusinessCase submits the open business case and emails the GRT
+	GovernanceEffectSubmitBusinessCase GovernanceEffect = "SUBMIT_BUSINESS_CASE"
+	// GovernanceEffectReview records GRT feedback and emails it to the requester
+	GovernanceEffectReview GovernanceEffect = "REVIEW"
+	// GovernanceEffectDecide issues an LCID or rejects the request.
+	// Decisions take their own inputs, so their services check the table themselves
+	// and they aren't steps in the workflow
+	GovernanceEffectDecide GovernanceEffect = "DECIDE"
+	// GovernanceEffectChangeLCID extends, amends or retires an issued LCID.
+	// Like decisions, these have their own services
+	GovernanceEffectChangeLCID GovernanceEffect = "CHANGE_LCID"
+)
+
+// GovernanceTransition is a single allowed step in the governance workflow
+type GovernanceTransition struct {
+	ActionType        models.ActionType
+	From              []models.SystemIntakeStatus
+	To                models.SystemIntakeStatus
+	Role              GovernanceRole
+	Effect            GovernanceEffect
+	CloseBusinessCase bool
+}
+
+// AllowedFrom returns whether the transition can be taken from the given status
+func (t GovernanceTransition) AllowedFrom(status models.SystemIntakeStatus) bool {
+	for _, from := range t.From {
+		if from == status {
+			return true
+		}
+	}
+	return false
+}
+
+// governanceOpenStatuses are the statuses of a request the GRT is still reviewing,
+// which it can close from any of
+var governanceOpenStatuses = []models.SystemIntakeStatus{
+	models.SystemIntakeStatusINTAKESUBMITTED,
+	models.SystemIntakeStatusNEEDBIZCASE,
+	models.SystemIntakeStatusBIZCASEDRAFT,
+	models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+	models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+	models.SystemIntakeStatusBIZCASEFINALNEEDED,
+	models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+	models.SystemIntakeStatusREADYFORGRT,
+	models.SystemIntakeStatusREADYFORGRB,
+	models.SystemIntakeStatusSHUTDOWNINPROGRESS,
+}
+
+// governanceDecisionStatuses are the statuses a request can be decided from
+var governanceDecisionStatuses = []models.SystemIntakeStatus{
+	models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+	models.SystemIntakeStatusREADYFORGRT,
+	models.SystemIntakeStatusREADYFORGRB,
+}
+
+// GovernanceTransitions returns every transition allowed by the governance workflow
+func GovernanceTransitions() []GovernanceTransition {
+	return []GovernanceTransition{
+		{
+			ActionType: models.ActionTypeSUBMITINTAKE,
+			From:       []models.SystemIntakeStatus{models.SystemIntakeStatusINTAKEDRAFT},
+			To:         models.SystemIntakeStatusINTAKESUBMITTED,
+			Role:       GovernanceRoleRequester,
+			Effect:     GovernanceEffectSubmitIntake,
+		},
+		{
+			ActionType: models.ActionTypeSUBMITBIZCASE,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusBIZCASEDRAFT,
+				models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+			},
+			To:     models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+			Role:   GovernanceRoleRequester,
+			Effect: GovernanceEffectSubmitBusinessCase,
+		},
+		{
+			ActionType: models.ActionTypeSUBMITFINALBIZCASE,
+			From:       []models.SystemIntakeStatus{models.SystemIntakeStatusBIZCASEFINALNEEDED},
+			To:         models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+			Role:       GovernanceRoleRequester,
+			Effect:     GovernanceEffectSubmitBusinessCase,
+		},
+		{
+			ActionType:        models.ActionTypeNOTITREQUEST,
+			From:              governanceOpenStatuses,
+			To:                models.SystemIntakeStatusNOTITREQUEST,
+			Role:              GovernanceRoleGRT,
+			Effect:            GovernanceEffectReview,
+			CloseBusinessCase: true,
+		},
+		{
+			ActionType: models.ActionTypeNEEDBIZCASE,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusINTAKESUBMITTED,
+				models.SystemIntakeStatusREADYFORGRT,
+			},
+			To:     models.SystemIntakeStatusNEEDBIZCASE,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypeREADYFORGRT,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusINTAKESUBMITTED,
+				models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+			},
+			To:     models.SystemIntakeStatusREADYFORGRT,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypePROVIDEFEEDBACKNEEDBIZCASE,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusINTAKESUBMITTED,
+				models.SystemIntakeStatusREADYFORGRT,
+			},
+			To:     models.SystemIntakeStatusNEEDBIZCASE,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypeREADYFORGRB,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+				models.SystemIntakeStatusREADYFORGRT,
+			},
+			To:     models.SystemIntakeStatusREADYFORGRB,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypeBIZCASENEEDSCHANGES,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+				models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+			},
+			To:     models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypePROVIDEFEEDBACKBIZCASENEEDSCHANGES,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+				models.SystemIntakeStatusBIZCASEFINALSUBMITTED,
+			},
+			To:     models.SystemIntakeStatusBIZCASECHANGESNEEDED,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType: models.ActionTypePROVIDEFEEDBACKBIZCASEFINAL,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+				models.SystemIntakeStatusREADYFORGRT,
+			},
+			To:     models.SystemIntakeStatusBIZCASEFINALNEEDED,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType:        models.ActionTypeNOGOVERNANCENEEDED,
+			From:              governanceOpenStatuses,
+			To:                models.SystemIntakeStatusNOGOVERNANCE,
+			Role:              GovernanceRoleGRT,
+			Effect:            GovernanceEffectReview,
+			CloseBusinessCase: true,
+		},
+		{
+			ActionType: models.ActionTypeSENDEMAIL,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusINTAKESUBMITTED,
+				models.SystemIntakeStatusREADYFORGRT,
+				models.SystemIntakeStatusREADYFORGRB,
+			},
+			To:     models.SystemIntakeStatusSHUTDOWNINPROGRESS,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectReview,
+		},
+		{
+			ActionType:        models.ActionTypeGUIDERECEIVEDCLOSE,
+			From:              []models.SystemIntakeStatus{models.SystemIntakeStatusSHUTDOWNINPROGRESS},
+			To:                models.SystemIntakeStatusSHUTDOWNCOMPLETE,
+			Role:              GovernanceRoleGRT,
+			Effect:            GovernanceEffectReview,
+			CloseBusinessCase: true,
+		},
+		{
+			ActionType:        models.ActionTypeNOTRESPONDINGCLOSE,
+			From:              governanceOpenStatuses,
+			To:                models.SystemIntakeStatusNOGOVERNANCE,
+			Role:              GovernanceRoleGRT,
+			Effect:            GovernanceEffectReview,
+			CloseBusinessCase: true,
+		},
+		{
+			ActionType: models.ActionTypeISSUELCID,
+			From:       governanceDecisionStatuses,
+			To:         models.SystemIntakeStatusLCIDISSUED,
+			Role:       GovernanceRoleGRT,
+			Effect:     GovernanceEffectDecide,
+		},
+		{
+			ActionType: models.ActionTypeREJECT,
+			From:       governanceDecisionStatuses,
+			To:         models.SystemIntakeStatusNOTAPPROVED,
+			Role:       GovernanceRoleGRT,
+			Effect:     GovernanceEffectDecide,
+		},
+		{
+			ActionType: models.ActionTypeEXTENDLCID,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusLCIDISSUED,
+				models.SystemIntakeStatusLCIDEXPIRED,
+			},
+			To:     models.SystemIntakeStatusLCIDISSUED,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectChangeLCID,
+		},
+		{
+			ActionType: models.ActionTypeAMENDLCID,
+			From:       []models.SystemIntakeStatus{models.SystemIntakeStatusLCIDISSUED},
+			To:         models.SystemIntakeStatusLCIDISSUED,
+			Role:       GovernanceRoleGRT,
+			Effect:     GovernanceEffectChangeLCID,
+		},
+		{
+			ActionType: models.ActionTypeRETIRELCID,
+			From: []models.SystemIntakeStatus{
+				models.SystemIntakeStatusLCIDISSUED,
+				models.SystemIntakeStatusLCIDEXPIRED,
+			},
+			To:     models.SystemIntakeStatusLCIDRETIRED,
+			Role:   GovernanceRoleGRT,
+			Effect: GovernanceEffectChangeLCID,
+		},
+	}
+}
+
+// checkGovernanceTransition returns a ResourceConflictError naming the intake's status
+// if the transition table doesn't allow the action from it
+func checkGovernanceTransition(actionType models.ActionType, intake *models.SystemIntake) error {
+	for _, transition := range GovernanceTransitions() {
+		if transition.ActionType == actionType && transition.AllowedFrom(intake.Status) {
+			return nil
+		}
+	}
+	return &apperrors.ResourceConflictError{
+		Err:        fmt.Errorf("action %s is not allowed on an intake with status %s", actionType, intake.Status),
+		Resource:   models.SystemIntake{},
+		ResourceID: intake.ID.String(),
+	}
+}
+
+// GovernanceStep pairs a transition with the executer that carries it out
+type GovernanceStep struct {
+	Transition GovernanceTransition
+	Execute    ActionExecuter
+}
+
+// GovernanceWorkflow maps each action type to the step it takes
+type GovernanceWorkflow map[models.ActionType]GovernanceStep
+
+// NewGovernanceWorkflow builds a workflow from a transition table,
+// creating each executer from the transition's declared effect.
+// Decisions and LCID changes are left out, as they have their own services
+func NewGovernanceWorkflow(
+	transitions []GovernanceTransition,
+	effects map[GovernanceEffect]func(GovernanceTransition) ActionExecuter,
+) (GovernanceWorkflow, error) {
+	workflow := GovernanceWorkflow{}
+	for _, transition := range transitions {
+		if transition.Effect == GovernanceEffectDecide || transition.Effect == GovernanceEffectChangeLCID {
+			continue
+		}
+		if _, ok := workflow[transition.ActionType]; ok {
+			return nil, fmt.Errorf("duplicate governance transition for action type %s", transition.ActionType)
+		}
+		newExecuter, ok := effects[transition.Effect]
+		if !ok {
+			return nil, fmt.Errorf("no executer for governance effect %s", transition.Effect)
+		}
+		workflow[transition.ActionType] = GovernanceStep{
+			Transition: transition,
+			Execute:    newExecuter(transition),
+		}
+	}
+	return workflow, nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestGovernanceTransitions() {
+	transitions := GovernanceTransitions()
+
+	s.Run("each action type has a single transition", func() {
+		seen := map[models.ActionType]bool{}
+		for _, transition := range transitions {
+			s.False(seen[transition.ActionType], "duplicate transition for %s", transition.ActionType)
+			seen[transition.ActionType] = true
+		}
+	})
+
+	s.Run("GRT cannot move a draft or withdrawn intake to GRB", func() {
+		for _, transition := range transitions {
+			if transition.ActionType != models.ActionTypeREADYFORGRB {
+				continue
+			}
+			s.False(transition.AllowedFrom(models.SystemIntakeStatusINTAKEDRAFT))
+			s.False(transition.AllowedFrom(models.SystemIntakeStatusWITHDRAWN))
+			s.True(transition.AllowedFrom(models.SystemIntakeStatusREADYFORGRT))
+			s.False(transition.AllowedFrom(models.SystemIntakeStatusINTAKESUBMITTED))
+		}
+	})
+
+	s.Run("a request is decided after its review", func() {
+		for _, actionType := range []models.ActionType{models.ActionTypeISSUELCID, models.ActionTypeREJECT} {
+			intake := &models.SystemIntake{Status: models.SystemIntakeStatusREADYFORGRB}
+			s.NoError(checkGovernanceTransition(actionType, intake))
+
+			intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+			s.IsType(&apperrors.ResourceConflictError{}, checkGovernanceTransition(actionType, intake))
+		}
+	})
+
+	s.Run("only the requester can submit", func() {
+		for _, transition := range transitions {
+			switch transition.Effect {
+			case GovernanceEffectSubmitIntake, GovernanceEffectSubmitBusinessCase:
+				s.Equal(GovernanceRoleRequester, transition.Role, transition.ActionType)
+			default:
+				s.Equal(GovernanceRoleGRT, transition.Role, transition.ActionType)
+			}
+		}
+	})
+}
+
+func (s ServicesTestSuite) TestNewGovernanceWorkflow() {
+	noop := func(ctx context.Context, intake *models.SystemIntake, action *models.Action) error {
+		return nil
+	}
+	newNoop := func(GovernanceTransition) ActionExecuter {
+		return noop
+	}
+
+	s.Run("golden path builds a step for every transition", func() {
+		workflow, err := NewGovernanceWorkflow(
+			GovernanceTransitions(),
+			map[GovernanceEffect]func(GovernanceTransition) ActionExecuter{
+				GovernanceEffectSubmitIntake:       newNoop,
+				GovernanceEffectSubmitBusinessCase: newNoop,
+				GovernanceEffectReview:             newNoop,
+			},
+		)
+
+		s.NoError(err)
+		s.Len(workflow, len(GovernanceTransitions())-5)
+		s.NotContains(workflow, models.ActionTypeISSUELCID)
+		s.NotContains(workflow, models.ActionTypeRETIRELCID)
+		s.Equal(models.SystemIntakeStatusNOTITREQUEST, workflow[models.ActionTypeNOTITREQUEST].Transition.To)
+	})
+
+	s.Run("returns error if an effect has no executer", func() {
+		_, err := NewGovernanceWorkflow(
+			GovernanceTransitions(),
+			map[GovernanceEffect]func(GovernanceTransition) ActionExecuter{
+				GovernanceEffectReview: newNoop,
+			},
+		)
+
+		s.Error(err)
+	})
+
+	s.Run("returns error for duplicate action types", func() {
+		transition := GovernanceTransition{
+			ActionType: models.ActionTypeREADYFORGRT,
+			Effect:     GovernanceEffectReview,
+		}
+		_, err := NewGovernanceWorkflow(
+			[]GovernanceTransition{transition, transition},
+			map[GovernanceEffect]func(GovernanceTransition) ActionExecuter{
+				GovernanceEffectReview: newNoop,
+			},
+		)
+
+		s.Error(err)
+	})
+}
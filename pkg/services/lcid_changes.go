@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// lcidChange describes one way an issued LCID can be changed
+type lcidChange struct {
+	actionType models.ActionType
+	// apply validates the requested change and copies it onto the existing intake,
+	// recording the before and after values on the action
+	apply func(now time.Time, existing *models.SystemIntake, requested *models.SystemIntake, action *models.Action) error
+	send  func(ctx context.Context, requesterEmail string, updated *models.SystemIntake, action *models.Action) error
+}
+
+// newChangeLifecycleID returns a function that makes an lcidChange to an intake,
+// saving the change and its action together before emailing the requester
+func newChangeLifecycleID(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	saveAction func(context.Context, *models.Action) error,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+	change lcidChange,
+) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
+	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: fmt.Errorf("failed to authorize action %s", change.actionType)}
+		}
+
+		existing, err := fetch(ctx, intake.ID)
+		if err != nil {
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Operation: apperrors.QueryFetch,
+				Model:     intake,
+			}
+		}
+
+		if existing.LifecycleID.ValueOrZero() == "" {
+			return nil, &apperrors.ResourceConflictError{
+				Err:        errors.New("lifecycle id has not been issued"),
+				Resource:   models.SystemIntake{},
+				ResourceID: existing.ID.String(),
+			}
+		}
+		if err = checkGovernanceTransition(change.actionType, existing); err != nil {
+			return nil, err
+		}
+
+		previousStatus := existing.Status
+		now := config.clock.Now()
+		if err = change.apply(now, existing, intake, action); err != nil {
+			return nil, err
+		}
+
+		requesterInfo, err := fetchUserInfo(ctx, existing.EUAUserID.ValueOrZero())
+		if err != nil {
+			return nil, err
+		}
+		if requesterInfo == nil || requesterInfo.Email == "" {
+			return nil, &apperrors.ExternalAPIError{
+				Err:       errors.New("requester info fetch was not successful when changing a lifecycle id"),
+				Model:     existing,
+				ModelID:   existing.ID.String(),
+				Operation: apperrors.Fetch,
+				Source:    "CEDAR LDAP",
+			}
+		}
+
+		contacts, err := fetchContacts(ctx, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err = chooseRecipients(action, requesterInfo.Email, contacts); err != nil {
+			return nil, err
+		}
+
+		existing.UpdatedAt = &now
+		action.IntakeID = &existing.ID
+		action.ActionType = change.actionType
+
+		var updated *models.SystemIntake
+		err = withTransaction(ctx, func(ctx context.Context) error {
+			if err = saveAction(ctx, action); err != nil {
+				return err
+			}
+			updated, err = update(ctx, existing)
+			if err != nil {
+				return err
+			}
+			return change.send(ctx, requesterInfo.Email, updated, action)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if updated.Status != previousStatus {
+			publishIntakeStatusChanged(ctx, publishStatusChanged, updated)
+		}
+		syncSystemIntakeToCedar(ctx, updateCedar, updated)
+		return updated, nil
+	}
+}
+
+// lcidValidationError is a helper for rejecting a requested LCID change
+func lcidValidationError(intake *models.SystemIntake, key string, message string) error {
+	valErr := apperrors.NewValidationError(
+		errors.New("lifecycle id change failed validation"),
+		models.SystemIntake{},
+		intake.ID.String(),
+	)
+	valErr.WithValidation(key, message)
+	return &valErr
+}
+
+// NewExtendLifecycleID returns a function that moves an LCID's expiration date later,
+// reissuing it if it had expired
+func NewExtendLifecycleID(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	saveAction func(context.Context, *models.Action) error,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendExtendLCIDEmail func(context.Context, uuid.UUID, string, models.EmailRecipients, string, *time.Time, *time.Time, string) error,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
+	return newChangeLifecycleID(config, authorize, fetch, update, saveAction, fetchUserInfo, fetchContacts, withTransaction, publishStatusChanged, updateCedar, lcidChange{
+		actionType: models.ActionTypeEXTENDLCID,
+		apply: func(now time.Time, existing *models.SystemIntake, requested *models.SystemIntake, action *models.Action) error {
+			if requested.LifecycleExpiresAt == nil {
+				return lcidValidationError(existing, "lcidExpiresAt", "is required")
+			}
+			if !requested.LifecycleExpiresAt.After(now) {
+				return lcidValidationError(existing, "lcidExpiresAt", "must be in the future")
+			}
+			if existing.LifecycleExpiresAt != nil && !requested.LifecycleExpiresAt.After(*existing.LifecycleExpiresAt) {
+				return lcidValidationError(existing, "lcidExpiresAt", "must be after the current expiration date")
+			}
+			action.PreviousExpiresAt = existing.LifecycleExpiresAt
+			action.NewExpiresAt = requested.LifecycleExpiresAt
+			existing.LifecycleExpiresAt = requested.LifecycleExpiresAt
+			// reminders start over for the new expiration date
+			existing.LifecycleReminderDays = null.Int{}
+			existing.Status = models.SystemIntakeStatusLCIDISSUED
+			return nil
+		},
+		send: func(ctx context.Context, requesterEmail string, updated *models.SystemIntake, action *models.Action) error {
+			return sendExtendLCIDEmail(
+				ctx,
+				updated.ID,
+				requesterEmail,
+				action.EmailRecipients,
+				updated.LifecycleID.String,
+				action.PreviousExpiresAt,
+				action.NewExpiresAt,
+				action.Feedback.String,
+			)
+		},
+	})
+}
+
+// NewAmendLifecycleID returns a function that changes the scope and next steps of an LCID
+func NewAmendLifecycleID(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	saveAction func(context.Context, *models.Action) error,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendAmendLCIDEmail func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string, string) error,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
+	return newChangeLifecycleID(config, authorize, fetch, update, saveAction, fetchUserInfo, fetchContacts, withTransaction, publishStatusChanged, updateCedar, lcidChange{
+		actionType: models.ActionTypeAMENDLCID,
+		apply: func(now time.Time, existing *models.SystemIntake, requested *models.SystemIntake, action *models.Action) error {
+			if requested.LifecycleScope.ValueOrZero() == "" && requested.DecisionNextSteps.ValueOrZero() == "" {
+				return lcidValidationError(existing, "lcidScope", "is required when next steps are not changed")
+			}
+			if requested.LifecycleScope.ValueOrZero() != "" {
+				action.PreviousScope = existing.LifecycleScope
+				action.NewScope = requested.LifecycleScope
+				existing.LifecycleScope = requested.LifecycleScope
+			}
+			if requested.DecisionNextSteps.ValueOrZero() != "" {
+				action.PreviousNextSteps = existing.DecisionNextSteps
+				action.NewNextSteps = requested.DecisionNextSteps
+				existing.DecisionNextSteps = requested.DecisionNextSteps
+			}
+			return nil
+		},
+		send: func(ctx context.Context, requesterEmail string, updated *models.SystemIntake, action *models.Action) error {
+			return sendAmendLCIDEmail(
+				ctx,
+				updated.ID,
+				requesterEmail,
+				action.EmailRecipients,
+				updated.LifecycleID.String,
+				updated.LifecycleScope.String,
+				updated.DecisionNextSteps.String,
+				action.Feedback.String,
+			)
+		},
+	})
+}
+
+// NewRetireLifecycleID returns a function that retires an LCID that is no longer needed
+func NewRetireLifecycleID(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	saveAction func(context.Context, *models.Action) error,
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendRetireLCIDEmail func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string) error,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
+	return newChangeLifecycleID(config, authorize, fetch, update, saveAction, fetchUserInfo, fetchContacts, withTransaction, publishStatusChanged, updateCedar, lcidChange{
+		actionType: models.ActionTypeRETIRELCID,
+		apply: func(now time.Time, existing *models.SystemIntake, requested *models.SystemIntake, action *models.Action) error {
+			if requested.LifecycleRetirementReason.ValueOrZero() == "" {
+				return lcidValidationError(existing, "lcidRetirementReason", "is required")
+			}
+			action.RetirementReason = requested.LifecycleRetirementReason
+			existing.LifecycleRetirementReason = requested.LifecycleRetirementReason
+			existing.LifecycleRetiredAt = &now
+			existing.Status = models.SystemIntakeStatusLCIDRETIRED
+			return nil
+		},
+		send: func(ctx context.Context, requesterEmail string, updated *models.SystemIntake, action *models.Action) error {
+			return sendRetireLCIDEmail(
+				ctx,
+				updated.ID,
+				requesterEmail,
+				action.EmailRecipients,
+				updated.LifecycleID.String,
+				updated.LifecycleRetirementReason.String,
+				action.Feedback.String,
+			)
+		},
+	})
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestLifecycleIDChanges() {
+	ctx := context.Background()
+	mockClock := clock.NewMock()
+	mockClock.Add(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC).Sub(mockClock.Now()))
+	cfg := Config{clock: mockClock}
+	expiresAt := time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	existingIntake := func(status models.SystemIntakeStatus) *models.SystemIntake {
+		currentExpiresAt := expiresAt
+		return &models.SystemIntake{
+			ID:                    uuid.New(),
+			EUAUserID:             null.StringFrom("ABCD"),
+			Status:                status,
+			LifecycleID:           null.StringFrom("210001"),
+			LifecycleExpiresAt:    &currentExpiresAt,
+			LifecycleScope:        null.StringFrom("old scope"),
+			DecisionNextSteps:     null.StringFrom("old next steps"),
+			LifecycleReminderDays: null.IntFrom(30),
+			AlfabetID:             null.StringFrom("000-000-0"),
+		}
+	}
+	authorized := func(context.Context) (bool, error) { return true, nil }
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		return &models.UserInfo{Email: "name@site.com", CommonName: "NAME", EuaUserID: euaID}, nil
+	}
+	update := func(_ context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+		return intake, nil
+	}
+	var published []models.SystemIntakeStatus
+	publish := func(_ context.Context, intake *models.SystemIntake) error {
+		published = append(published, intake.Status)
+		return nil
+	}
+	var synced []models.SystemIntakeStatus
+	updateCedar := func(_ context.Context, intake *models.SystemIntake) error {
+		synced = append(synced, intake.Status)
+		return nil
+	}
+
+	s.Run("extends an LCID and reissues it if it expired", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDEXPIRED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		var saved *models.Action
+		saveAction := func(_ context.Context, action *models.Action) error {
+			saved = action
+			return nil
+		}
+		var emailed *time.Time
+		sendEmail := func(_ context.Context, _ uuid.UUID, _ string, _ models.EmailRecipients, _ string, _ *time.Time, newExpiresAt *time.Time, _ string) error {
+			emailed = newExpiresAt
+			return nil
+		}
+		published = nil
+		synced = nil
+		extend := NewExtendLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, publish, updateCedar)
+		newExpiresAt := time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC)
+
+		updated, err := extend(ctx, &models.SystemIntake{ID: existing.ID, LifecycleExpiresAt: &newExpiresAt}, &models.Action{})
+
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusLCIDISSUED, updated.Status)
+		s.Equal(newExpiresAt, *updated.LifecycleExpiresAt)
+		s.False(updated.LifecycleReminderDays.Valid)
+		s.Equal(models.ActionTypeEXTENDLCID, saved.ActionType)
+		s.Equal(expiresAt, *saved.PreviousExpiresAt)
+		s.Equal(newExpiresAt, *saved.NewExpiresAt)
+		s.True(saved.NotifyRequester)
+		s.Equal(newExpiresAt, *emailed)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDISSUED}, published)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDISSUED}, synced)
+	})
+
+	s.Run("cannot extend an LCID to an earlier date", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, *time.Time, *time.Time, string) error {
+			return nil
+		}
+		extend := NewExtendLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, noUpdateCedar)
+		earlier := expiresAt.AddDate(0, -1, 0)
+
+		_, err := extend(ctx, &models.SystemIntake{ID: existing.ID, LifecycleExpiresAt: &earlier}, &models.Action{})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("cannot change an intake without an LCID", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		existing.LifecycleID = null.String{}
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string, string) error {
+			return nil
+		}
+		amend := NewAmendLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, noUpdateCedar)
+
+		_, err := amend(ctx, &models.SystemIntake{ID: existing.ID, LifecycleScope: null.StringFrom("scope")}, &models.Action{})
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("amends the scope and keeps the next steps", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		var saved *models.Action
+		saveAction := func(_ context.Context, action *models.Action) error {
+			saved = action
+			return nil
+		}
+		emailCount := 0
+		sendEmail := func(_ context.Context, _ uuid.UUID, _ string, _ models.EmailRecipients, _ string, scope string, nextSteps string, _ string) error {
+			s.Equal("new scope", scope)
+			s.Equal("old next steps", nextSteps)
+			emailCount++
+			return nil
+		}
+		published = nil
+		synced = nil
+		amend := NewAmendLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, publish, updateCedar)
+
+		updated, err := amend(ctx, &models.SystemIntake{ID: existing.ID, LifecycleScope: null.StringFrom("new scope")}, &models.Action{})
+
+		s.NoError(err)
+		s.Equal("new scope", updated.LifecycleScope.String)
+		s.Equal(models.ActionTypeAMENDLCID, saved.ActionType)
+		s.Equal("old scope", saved.PreviousScope.String)
+		s.Equal("new scope", saved.NewScope.String)
+		s.False(saved.PreviousNextSteps.Valid)
+		s.Equal(1, emailCount)
+		s.Empty(published)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDISSUED}, synced)
+	})
+
+	s.Run("cannot amend an expired LCID", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDEXPIRED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string, string) error {
+			return nil
+		}
+		amend := NewAmendLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, noUpdateCedar)
+
+		_, err := amend(ctx, &models.SystemIntake{ID: existing.ID, LifecycleScope: null.StringFrom("scope")}, &models.Action{})
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("retires an LCID with a reason", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		var saved *models.Action
+		saveAction := func(_ context.Context, action *models.Action) error {
+			saved = action
+			return nil
+		}
+		sendEmail := func(_ context.Context, _ uuid.UUID, _ string, _ models.EmailRecipients, _ string, reason string, _ string) error {
+			s.Equal("no longer needed", reason)
+			return nil
+		}
+		published = nil
+		synced = nil
+		retire := NewRetireLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, publish, updateCedar)
+
+		updated, err := retire(ctx, &models.SystemIntake{ID: existing.ID, LifecycleRetirementReason: null.StringFrom("no longer needed")}, &models.Action{})
+
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusLCIDRETIRED, updated.Status)
+		s.Equal(mockClock.Now(), *updated.LifecycleRetiredAt)
+		s.Equal(models.ActionTypeRETIRELCID, saved.ActionType)
+		s.Equal("no longer needed", saved.RetirementReason.String)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDRETIRED}, published)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDRETIRED}, synced)
+	})
+
+	s.Run("keeps a retirement CEDAR doesn't get", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string) error {
+			return nil
+		}
+		failUpdateCedar := func(context.Context, *models.SystemIntake) error {
+			return errors.New("CEDAR is down")
+		}
+		retire := NewRetireLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, failUpdateCedar)
+
+		updated, err := retire(ctx, &models.SystemIntake{ID: existing.ID, LifecycleRetirementReason: null.StringFrom("no longer needed")}, &models.Action{})
+
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusLCIDRETIRED, updated.Status)
+	})
+
+	s.Run("cannot retire an LCID without a reason", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string) error {
+			return nil
+		}
+		synced = nil
+		retire := NewRetireLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, updateCedar)
+
+		_, err := retire(ctx, &models.SystemIntake{ID: existing.ID}, &models.Action{})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Empty(synced)
+	})
+
+	s.Run("only the GRT can change an LCID", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string) error {
+			return nil
+		}
+		unauthorized := func(context.Context) (bool, error) { return false, nil }
+		retire := NewRetireLifecycleID(cfg, unauthorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, noUpdateCedar)
+
+		_, err := retire(ctx, &models.SystemIntake{ID: existing.ID, LifecycleRetirementReason: null.StringFrom("reason")}, &models.Action{})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error if the email fails", func() {
+		existing := existingIntake(models.SystemIntakeStatusLCIDISSUED)
+		fetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) { return existing, nil }
+		saveAction := func(context.Context, *models.Action) error { return nil }
+		sendEmail := func(context.Context, uuid.UUID, string, models.EmailRecipients, string, string, string) error {
+			return errors.New("failed to send")
+		}
+		retire := NewRetireLifecycleID(cfg, authorized, fetch, update, saveAction, fetchUserInfo, noIntakeContacts, sendEmail, noTransaction, noPublish, noUpdateCedar)
+
+		_, err := retire(ctx, &models.SystemIntake{ID: existing.ID, LifecycleRetirementReason: null.StringFrom("reason")}, &models.Action{})
+
+		s.Error(err)
+	})
+}
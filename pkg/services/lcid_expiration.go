@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// lcidReminderDays are how many days before an LCID expires its requester is reminded, soonest first
+var lcidReminderDays = []int{30, 60, 90}
+
+// scheduled jobs record their actions as EASi itself rather than as a signed in user
+const (
+	systemActorName      = "EASi"
+	systemActorEUAUserID = "EASI"
+)
+
+// dueLCIDReminder returns the reminder that is due for an LCID expiring in daysLeft days,
+// if it hasn't already been sent.
+// Only the latest reminder is sent when earlier ones were missed.
+func dueLCIDReminder(daysLeft int, sentDays null.Int) (int, bool) {
+	for _, days := range lcidReminderDays {
+		if daysLeft > days {
+			continue
+		}
+		if sentDays.Valid && sentDays.Int64 <= int64(days) {
+			return 0, false
+		}
+		return days, true
+	}
+	return 0, false
+}
+
+// NewCheckLCIDExpirations returns a function that reminds requesters and the GRT
+// of LCIDs expiring within 90, 60 and 30 days, and expires the ones that have passed
+func NewCheckLCIDExpirations(
+	config Config,
+	fetchExpiring func(context.Context, time.Time) (models.SystemIntakes, error),
+	lock func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
+	createAction func(context.Context, *models.Action) (*models.Action, error),
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	sendReminderEmail func(context.Context, uuid.UUID, string, string, *time.Time, int) error,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
+) func(context.Context) error {
+	saveSystemAction := func(ctx context.Context, intake *models.SystemIntake, actionType models.ActionType, feedback null.String) error {
+		_, err := createAction(ctx, &models.Action{
+			IntakeID:       &intake.ID,
+			ActionType:     actionType,
+			ActorName:      systemActorName,
+			ActorEUAUserID: systemActorEUAUserID,
+			Feedback:       feedback,
+		})
+		if err != nil {
+			return &apperrors.QueryError{
+				Err:       err,
+				Model:     intake,
+				Operation: apperrors.QueryPost,
+			}
+		}
+		return nil
+	}
+
+	expire := func(ctx context.Context, intake *models.SystemIntake, now time.Time) error {
+		if err := saveSystemAction(ctx, intake, models.ActionTypeEXPIRELCID, null.String{}); err != nil {
+			return err
+		}
+		intake.Status = models.SystemIntakeStatusLCIDEXPIRED
+		intake.UpdatedAt = &now
+		expired, err := update(ctx, intake)
+		if err != nil {
+			return err
+		}
+		publishIntakeStatusChanged(ctx, publishStatusChanged, expired)
+		// the expiration is still inside the lock's transaction, so CEDAR hears about it once that commits
+		sync := func(ctx context.Context) {
+			syncSystemIntakeToCedar(ctx, updateCedar, expired)
+		}
+		if !appcontext.AfterCommit(ctx, sync) {
+			sync(ctx)
+		}
+		return nil
+	}
+
+	remind := func(ctx context.Context, intake *models.SystemIntake, now time.Time) error {
+		daysLeft := int(math.Ceil(intake.LifecycleExpiresAt.Sub(now).Hours() / 24))
+		reminderDays, due := dueLCIDReminder(daysLeft, intake.LifecycleReminderDays)
+		if !due {
+			return nil
+		}
+
+		requesterInfo, err := fetchUserInfo(ctx, intake.EUAUserID.ValueOrZero())
+		if err != nil {
+			return err
+		}
+		if requesterInfo == nil || requesterInfo.Email == "" {
+			return &apperrors.ExternalAPIError{
+				Err:       errors.New("requester info fetch was not successful when sending an LCID reminder"),
+				Model:     intake,
+				ModelID:   intake.ID.String(),
+				Operation: apperrors.Fetch,
+				Source:    "CEDAR LDAP",
+			}
+		}
+
+		feedback := null.StringFrom(fmt.Sprintf("Reminded the requester that the Lifecycle ID expires in %d days.", daysLeft))
+		if err = saveSystemAction(ctx, intake, models.ActionTypeLCIDEXPIRATIONREMINDER, feedback); err != nil {
+			return err
+		}
+		intake.LifecycleReminderDays = null.IntFrom(int64(reminderDays))
+		intake.UpdatedAt = &now
+		if _, err = update(ctx, intake); err != nil {
+			return err
+		}
+		return sendReminderEmail(
+			ctx,
+			intake.ID,
+			requesterInfo.Email,
+			intake.LifecycleID.String,
+			intake.LifecycleExpiresAt,
+			daysLeft,
+		)
+	}
+
+	return func(ctx context.Context) error {
+		now := config.clock.Now()
+		furthest := lcidReminderDays[len(lcidReminderDays)-1]
+		intakes, err := fetchExpiring(ctx, now.AddDate(0, 0, furthest))
+		if err != nil {
+			return &apperrors.QueryError{
+				Err:       err,
+				Model:     models.SystemIntakes{},
+				Operation: apperrors.QueryFetch,
+			}
+		}
+
+		failed := 0
+		for _, candidate := range intakes {
+			// the intake is locked and checked again so that
+			// jobs running at the same time don't remind twice
+			err = withTransaction(ctx, func(ctx context.Context) error {
+				intake, lockErr := lock(ctx, candidate.ID)
+				if lockErr != nil {
+					return lockErr
+				}
+				if intake.Status != models.SystemIntakeStatusLCIDISSUED || intake.LifecycleExpiresAt == nil {
+					return nil
+				}
+				if !intake.LifecycleExpiresAt.After(now) {
+					return expire(ctx, intake, now)
+				}
+				return remind(ctx, intake, now)
+			})
+			if err != nil {
+				failed++
+				appcontext.ZLogger(ctx).Error(
+					"Failed to check LCID expiration",
+					zap.String("intakeID", candidate.ID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to check %d of %d expiring LCIDs", failed, len(intakes))
+		}
+		return nil
+	}
+}
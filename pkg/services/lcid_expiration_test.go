@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestDueLCIDReminder() {
+	s.Run("nothing is due more than 90 days out", func() {
+		_, due := dueLCIDReminder(91, null.Int{})
+		s.False(due)
+	})
+
+	s.Run("the 90 day reminder is due first", func() {
+		days, due := dueLCIDReminder(90, null.Int{})
+		s.True(due)
+		s.Equal(90, days)
+	})
+
+	s.Run("a sent reminder is not sent again", func() {
+		_, due := dueLCIDReminder(75, null.IntFrom(90))
+		s.False(due)
+	})
+
+	s.Run("the next reminder is due once it is reached", func() {
+		days, due := dueLCIDReminder(60, null.IntFrom(90))
+		s.True(due)
+		s.Equal(60, days)
+	})
+
+	s.Run("only the latest missed reminder is due", func() {
+		days, due := dueLCIDReminder(12, null.Int{})
+		s.True(due)
+		s.Equal(30, days)
+	})
+}
+
+func (s ServicesTestSuite) TestCheckLCIDExpirations() {
+	ctx := context.Background()
+	mockClock := clock.NewMock()
+	mockClock.Add(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC).Sub(mockClock.Now()))
+	cfg := Config{clock: mockClock, logger: s.logger}
+
+	newIntake := func(daysLeft int, sentDays null.Int) *models.SystemIntake {
+		expiresAt := mockClock.Now().AddDate(0, 0, daysLeft)
+		return &models.SystemIntake{
+			ID:                    uuid.New(),
+			EUAUserID:             null.StringFrom("ABCD"),
+			Status:                models.SystemIntakeStatusLCIDISSUED,
+			LifecycleID:           null.StringFrom("210001"),
+			LifecycleExpiresAt:    &expiresAt,
+			LifecycleReminderDays: sentDays,
+			AlfabetID:             null.StringFrom("000-000-0"),
+		}
+	}
+
+	type result struct {
+		actions   []models.Action
+		updated   []models.SystemIntake
+		emails    []int
+		published []models.SystemIntakeStatus
+		synced    []models.SystemIntakeStatus
+	}
+	check := func(intakes ...*models.SystemIntake) (*result, error) {
+		res := &result{}
+		byID := map[uuid.UUID]*models.SystemIntake{}
+		list := models.SystemIntakes{}
+		for _, intake := range intakes {
+			byID[intake.ID] = intake
+			list = append(list, *intake)
+		}
+		fetch := func(_ context.Context, expiresBy time.Time) (models.SystemIntakes, error) {
+			s.Equal(mockClock.Now().AddDate(0, 0, 90), expiresBy)
+			return list, nil
+		}
+		lock := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return byID[id], nil
+		}
+		update := func(_ context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			res.updated = append(res.updated, *intake)
+			return intake, nil
+		}
+		createAction := func(_ context.Context, action *models.Action) (*models.Action, error) {
+			res.actions = append(res.actions, *action)
+			return action, nil
+		}
+		fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+			return &models.UserInfo{Email: "name@site.com", CommonName: "NAME", EuaUserID: euaID}, nil
+		}
+		sendEmail := func(_ context.Context, _ uuid.UUID, requesterEmail string, _ string, _ *time.Time, daysLeft int) error {
+			s.Equal("name@site.com", requesterEmail)
+			res.emails = append(res.emails, daysLeft)
+			return nil
+		}
+		committed := false
+		withTransaction := func(ctx context.Context, f func(context.Context) error) error {
+			txCtx, hooks := appcontext.WithCommitHooks(ctx)
+			if err := f(txCtx); err != nil {
+				return err
+			}
+			committed = true
+			hooks.Run(ctx)
+			return nil
+		}
+		publish := func(_ context.Context, intake *models.SystemIntake) error {
+			s.True(committed, "published before the transaction committed")
+			res.published = append(res.published, intake.Status)
+			return nil
+		}
+		updateCedar := func(_ context.Context, intake *models.SystemIntake) error {
+			s.True(committed, "sent to CEDAR before the transaction committed")
+			res.synced = append(res.synced, intake.Status)
+			return nil
+		}
+		checkExpirations := NewCheckLCIDExpirations(cfg, fetch, lock, update, createAction, fetchUserInfo, sendEmail, withTransaction, publish, updateCedar)
+		return res, checkExpirations(ctx)
+	}
+
+	s.Run("reminds the requester of a due reminder", func() {
+		res, err := check(newIntake(60, null.IntFrom(90)))
+
+		s.NoError(err)
+		s.Equal([]int{60}, res.emails)
+		s.Len(res.actions, 1)
+		s.Equal(models.ActionTypeLCIDEXPIRATIONREMINDER, res.actions[0].ActionType)
+		s.Equal(systemActorEUAUserID, res.actions[0].ActorEUAUserID)
+		s.Len(res.updated, 1)
+		s.Equal(null.IntFrom(60), res.updated[0].LifecycleReminderDays)
+		s.Equal(models.SystemIntakeStatusLCIDISSUED, res.updated[0].Status)
+		s.Empty(res.published)
+		s.Empty(res.synced)
+	})
+
+	s.Run("does not remind twice", func() {
+		res, err := check(newIntake(45, null.IntFrom(60)))
+
+		s.NoError(err)
+		s.Empty(res.emails)
+		s.Empty(res.actions)
+		s.Empty(res.updated)
+	})
+
+	s.Run("expires a passed LCID without emailing", func() {
+		res, err := check(newIntake(-1, null.IntFrom(30)))
+
+		s.NoError(err)
+		s.Empty(res.emails)
+		s.Len(res.actions, 1)
+		s.Equal(models.ActionTypeEXPIRELCID, res.actions[0].ActionType)
+		s.Len(res.updated, 1)
+		s.Equal(models.SystemIntakeStatusLCIDEXPIRED, res.updated[0].Status)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDEXPIRED}, res.published)
+		s.Equal([]models.SystemIntakeStatus{models.SystemIntakeStatusLCIDEXPIRED}, res.synced)
+	})
+
+	s.Run("skips an intake that changed after it was fetched", func() {
+		intake := newIntake(30, null.Int{})
+		intake.Status = models.SystemIntakeStatusLCIDEXPIRED
+
+		res, err := check(intake)
+
+		s.NoError(err)
+		s.Empty(res.actions)
+	})
+
+	s.Run("keeps going after an intake fails", func() {
+		failing := newIntake(30, null.Int{})
+		good := newIntake(30, null.Int{})
+
+		fetch := func(context.Context, time.Time) (models.SystemIntakes, error) {
+			return models.SystemIntakes{*failing, *good}, nil
+		}
+		lock := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			if id == failing.ID {
+				return nil, errors.New("lock failed")
+			}
+			return good, nil
+		}
+		update := func(_ context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
+			return intake, nil
+		}
+		createAction := func(_ context.Context, action *models.Action) (*models.Action, error) {
+			return action, nil
+		}
+		fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+			return &models.UserInfo{Email: "name@site.com"}, nil
+		}
+		emailCount := 0
+		sendEmail := func(context.Context, uuid.UUID, string, string, *time.Time, int) error {
+			emailCount++
+			return nil
+		}
+		checkExpirations := NewCheckLCIDExpirations(cfg, fetch, lock, update, createAction, fetchUserInfo, sendEmail, noTransaction, noPublish, noUpdateCedar)
+
+		err := checkExpirations(ctx)
+
+		s.Error(err)
+		s.Equal(1, emailCount)
+	})
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+var lifecycleCostSolutions = []models.LifecycleCostSolution{
+	models.LifecycleCostSolutionASIS,
+	models.LifecycleCostSolutionPREFERRED,
+	models.LifecycleCostSolutionA,
+	models.LifecycleCostSolutionB,
+}
+
+var lifecycleCostPhases = []models.LifecycleCostPhase{
+	models.LifecycleCostPhaseDEVELOPMENT,
+	models.LifecycleCostPhaseOPERATIONMAINTENANCE,
+}
+
+var lifecycleCostYears = []models.LifecycleCostYear{
+	models.LifecycleCostYear1,
+	models.LifecycleCostYear2,
+	models.LifecycleCostYear3,
+	models.LifecycleCostYear4,
+	models.LifecycleCostYear5,
+}
+
+// SummarizeLifecycleCosts totals the estimated lifecycle costs of each solution that has any,
+// by phase and by year, and compares the alternatives to As Is.
+// Costs that haven't been entered count as nothing.
+// With a discount rate, it also discounts each year's costs to their net present value,
+// treating them as spent at the end of the year.
+func SummarizeLifecycleCosts(lines models.EstimatedLifecycleCosts, discountRate *float64) (*models.LifecycleCostSummary, error) {
+	if discountRate != nil && *discountRate < 0 {
+		valErr := apperrors.NewValidationError(
+			errors.New("lifecycle cost summary failed validation"),
+			models.LifecycleCostSummary{},
+			"",
+		)
+		valErr.WithValidation("discountRate", "must not be negative")
+		return nil, &valErr
+	}
+
+	solutionLines := map[models.LifecycleCostSolution]models.EstimatedLifecycleCosts{}
+	for _, line := range lines {
+		solutionLines[line.Solution] = append(solutionLines[line.Solution], line)
+	}
+
+	summary := &models.LifecycleCostSummary{
+		DiscountRate: discountRate,
+		Solutions:    []models.LifecycleCostSolutionSummary{},
+	}
+	var asIsTotal *int
+	for _, solution := range lifecycleCostSolutions {
+		if len(solutionLines[solution]) == 0 {
+			continue
+		}
+		solutionSummary := summarizeSolutionCosts(solution, solutionLines[solution], discountRate)
+
+		if solution == models.LifecycleCostSolutionASIS {
+			total := solutionSummary.Total
+			asIsTotal = &total
+		} else if asIsTotal != nil {
+			delta := solutionSummary.Total - *asIsTotal
+			solutionSummary.DeltaFromAsIs = &delta
+		}
+		summary.Solutions = append(summary.Solutions, solutionSummary)
+	}
+	return summary, nil
+}
+
+func summarizeSolutionCosts(
+	solution models.LifecycleCostSolution,
+	lines models.EstimatedLifecycleCosts,
+	discountRate *float64,
+) models.LifecycleCostSolutionSummary {
+	phaseTotals := map[models.LifecycleCostPhase]int{}
+	hasPhase := map[models.LifecycleCostPhase]bool{}
+	yearTotals := map[models.LifecycleCostYear]int{}
+	total := 0
+	for _, line := range lines {
+		cost := 0
+		if line.Cost != nil {
+			cost = *line.Cost
+		}
+		if line.Phase != nil {
+			phaseTotals[*line.Phase] += cost
+			hasPhase[*line.Phase] = true
+		}
+		yearTotals[line.Year] += cost
+		total += cost
+	}
+
+	summary := models.LifecycleCostSolutionSummary{
+		Solution: solution,
+		Total:    total,
+		Phases:   []models.LifecycleCostPhaseTotal{},
+		Years:    []models.LifecycleCostYearTotal{},
+	}
+	for _, phase := range lifecycleCostPhases {
+		if hasPhase[phase] {
+			summary.Phases = append(summary.Phases, models.LifecycleCostPhaseTotal{Phase: phase, Total: phaseTotals[phase]})
+		}
+	}
+	for _, year := range lifecycleCostYears {
+		summary.Years = append(summary.Years, models.LifecycleCostYearTotal{Year: year, Total: yearTotals[year]})
+	}
+
+	if discountRate != nil {
+		npv := 0.0
+		for i, year := range summary.Years {
+			npv += float64(year.Total) / math.Pow(1+*discountRate, float64(i+1))
+		}
+		summary.NetPresentValue = &npv
+	}
+	return summary
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestSummarizeLifecycleCosts() {
+	development := models.LifecycleCostPhaseDEVELOPMENT
+	operations := models.LifecycleCostPhaseOPERATIONMAINTENANCE
+	cost := func(c int) *int { return &c }
+	rate := func(r float64) *float64 { return &r }
+	line := func(solution models.LifecycleCostSolution, phase *models.LifecycleCostPhase, year models.LifecycleCostYear, c *int) models.EstimatedLifecycleCost {
+		return models.EstimatedLifecycleCost{Solution: solution, Phase: phase, Year: year, Cost: c}
+	}
+	years := func(totals ...int) []models.LifecycleCostYearTotal {
+		yearTotals := []models.LifecycleCostYearTotal{}
+		for i, year := range lifecycleCostYears {
+			yearTotals = append(yearTotals, models.LifecycleCostYearTotal{Year: year, Total: totals[i]})
+		}
+		return yearTotals
+	}
+
+	tests := []struct {
+		name         string
+		lines        models.EstimatedLifecycleCosts
+		discountRate *float64
+		expected     []models.LifecycleCostSolutionSummary
+	}{
+		{
+			name:     "no costs",
+			lines:    models.EstimatedLifecycleCosts{},
+			expected: []models.LifecycleCostSolutionSummary{},
+		},
+		{
+			name: "totals each phase and year, counting missing years as nothing",
+			lines: models.EstimatedLifecycleCosts{
+				line(models.LifecycleCostSolutionASIS, &development, models.LifecycleCostYear1, cost(100)),
+				line(models.LifecycleCostSolutionASIS, &operations, models.LifecycleCostYear1, cost(20)),
+				line(models.LifecycleCostSolutionASIS, &operations, models.LifecycleCostYear3, cost(30)),
+			},
+			expected: []models.LifecycleCostSolutionSummary{
+				{
+					Solution: models.LifecycleCostSolutionASIS,
+					Total:    150,
+					Phases: []models.LifecycleCostPhaseTotal{
+						{Phase: development, Total: 100},
+						{Phase: operations, Total: 50},
+					},
+					Years: years(120, 0, 30, 0, 0),
+				},
+			},
+		},
+		{
+			name: "counts costs that haven't been entered as nothing",
+			lines: models.EstimatedLifecycleCosts{
+				line(models.LifecycleCostSolutionPREFERRED, &development, models.LifecycleCostYear1, nil),
+				line(models.LifecycleCostSolutionPREFERRED, &development, models.LifecycleCostYear2, cost(40)),
+				line(models.LifecycleCostSolutionPREFERRED, nil, models.LifecycleCostYear2, cost(5)),
+			},
+			expected: []models.LifecycleCostSolutionSummary{
+				{
+					Solution: models.LifecycleCostSolutionPREFERRED,
+					Total:    45,
+					Phases:   []models.LifecycleCostPhaseTotal{{Phase: development, Total: 40}},
+					Years:    years(0, 45, 0, 0, 0),
+				},
+			},
+		},
+		{
+			name: "compares alternatives to As Is",
+			lines: models.EstimatedLifecycleCosts{
+				line(models.LifecycleCostSolutionB, &development, models.LifecycleCostYear1, cost(90)),
+				line(models.LifecycleCostSolutionPREFERRED, &development, models.LifecycleCostYear1, cost(150)),
+				line(models.LifecycleCostSolutionASIS, &development, models.LifecycleCostYear1, cost(100)),
+			},
+			expected: []models.LifecycleCostSolutionSummary{
+				{
+					Solution: models.LifecycleCostSolutionASIS,
+					Total:    100,
+					Phases:   []models.LifecycleCostPhaseTotal{{Phase: development, Total: 100}},
+					Years:    years(100, 0, 0, 0, 0),
+				},
+				{
+					Solution:      models.LifecycleCostSolutionPREFERRED,
+					Total:         150,
+					Phases:        []models.LifecycleCostPhaseTotal{{Phase: development, Total: 150}},
+					Years:         years(150, 0, 0, 0, 0),
+					DeltaFromAsIs: cost(50),
+				},
+				{
+					Solution:      models.LifecycleCostSolutionB,
+					Total:         90,
+					Phases:        []models.LifecycleCostPhaseTotal{{Phase: development, Total: 90}},
+					Years:         years(90, 0, 0, 0, 0),
+					DeltaFromAsIs: cost(-10),
+				},
+			},
+		},
+		{
+			name: "discounts each year to its net present value",
+			lines: models.EstimatedLifecycleCosts{
+				line(models.LifecycleCostSolutionA, &operations, models.LifecycleCostYear1, cost(110)),
+				line(models.LifecycleCostSolutionA, &operations, models.LifecycleCostYear2, cost(121)),
+				line(models.LifecycleCostSolutionA, &operations, models.LifecycleCostYear4, nil),
+			},
+			discountRate: rate(0.1),
+			expected: []models.LifecycleCostSolutionSummary{
+				{
+					Solution:        models.LifecycleCostSolutionA,
+					Total:           231,
+					Phases:          []models.LifecycleCostPhaseTotal{{Phase: operations, Total: 231}},
+					Years:           years(110, 121, 0, 0, 0),
+					NetPresentValue: rate(200),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			summary, err := SummarizeLifecycleCosts(tt.lines, tt.discountRate)
+			s.NoError(err)
+			s.Equal(tt.discountRate, summary.DiscountRate)
+
+			s.Len(summary.Solutions, len(tt.expected))
+			for i, expected := range tt.expected {
+				actual := summary.Solutions[i]
+				if expected.NetPresentValue != nil {
+					s.InDelta(*expected.NetPresentValue, *actual.NetPresentValue, 0.0001)
+					expected.NetPresentValue, actual.NetPresentValue = nil, nil
+				}
+				s.Equal(expected, actual)
+			}
+		})
+	}
+
+	s.Run("rejects a negative discount rate", func() {
+		_, err := SummarizeLifecycleCosts(models.EstimatedLifecycleCosts{}, rate(-0.1))
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+}
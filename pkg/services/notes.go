@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
@@ -33,12 +34,34 @@ func NewFetchNotes(
 	}
 }
 
+// NewFetchNotesBySystemIntakeIDs is a service to fetch the notes of many SystemIntakes at once
+func NewFetchNotesBySystemIntakeIDs(
+	config Config,
+	fetchBySystemIntakeIDs func(context.Context, []uuid.UUID) ([]*models.Note, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, []uuid.UUID) ([]*models.Note, error) {
+	return func(ctx context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.ResourceNotFoundError{
+				Err:      errors.New("failed to authorize fetch notes"),
+				Resource: models.Note{},
+			}
+		}
+		return fetchBySystemIntakeIDs(ctx, ids)
+	}
+}
+
 // NewCreateNote is a service to create and return a new note
 // associated with a given SystemIntake
 func NewCreateNote(
 	config Config,
 	create func(context.Context, *models.Note) (*models.Note, error),
 	authorize func(context.Context) (bool, error),
+	publishNoteAdded func(context.Context, *models.Note) error,
 ) func(context.Context, *models.Note) (*models.Note, error) {
 	return func(ctx context.Context, note *models.Note) (*models.Note, error) {
 		ok, err := authorize(ctx)
@@ -53,6 +76,13 @@ func NewCreateNote(
 		}
 		note.AuthorEUAID = appcontext.Principal(ctx).ID()
 
-		return create(ctx, note)
+		created, err := create(ctx, note)
+		if err != nil {
+			return nil, err
+		}
+		if err := publishNoteAdded(ctx, created); err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to publish added note", zap.Error(err))
+		}
+		return created, nil
 	}
 }
@@ -103,6 +103,33 @@ func (s ServicesTestSuite) TestFetchNotes() {
 
 }
 
+func (s ServicesTestSuite) TestFetchNotesBySystemIntakeIDs() {
+	cfg := NewConfig(nil, nil)
+	cfg.clock = clock.NewMock()
+
+	fetcher := func(_ context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+		notes := []*models.Note{}
+		for _, id := range ids {
+			notes = append(notes, &models.Note{ID: uuid.New(), SystemIntakeID: id})
+		}
+		return notes, nil
+	}
+	fn := NewFetchNotesBySystemIntakeIDs(cfg, fetcher, NewAuthorizeRequireGRTJobCode())
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	s.Run("reviewer gets the notes of every intake", func() {
+		notes, err := fn(appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal()), ids)
+		s.NoError(err)
+		s.Len(notes, 2)
+	})
+
+	s.Run("requester gets no notes", func() {
+		notes, err := fn(appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal()), ids)
+		s.Error(err)
+		s.Nil(notes)
+	})
+}
+
 func (s ServicesTestSuite) TestCreateNote() {
 	cfg := NewConfig(nil, nil)
 	cfg.clock = clock.NewMock()
@@ -126,6 +153,11 @@ func (s ServicesTestSuite) TestCreateNote() {
 		}
 		return nil, nil
 	}
+	published := []*models.Note{}
+	publish := func(_ context.Context, note *models.Note) error {
+		published = append(published, note)
+		return nil
+	}
 
 	s.Run("unhappy paths", func() {
 		errorCases := map[string]struct {
@@ -136,17 +168,17 @@ func (s ServicesTestSuite) TestCreateNote() {
 			"anonymous user": {
 				ctx:  context.Background(),
 				note: &noteCreated,
-				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode()),
+				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode(), publish),
 			},
 			"not reviewer": {
 				ctx:  appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal()),
 				note: &noteCreated,
-				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode()),
+				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode(), publish),
 			},
 			"errors when talking to storage layer": {
 				ctx:  appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal()),
 				note: &noteError,
-				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode()),
+				fn:   NewCreateNote(cfg, creator, NewAuthorizeRequireGRTJobCode(), publish),
 			},
 		}
 
@@ -170,13 +202,14 @@ func (s ServicesTestSuite) TestCreateNote() {
 				Content:        content,
 			}, nil
 		}
-		createNote := NewCreateNote(cfg, create, NewAuthorizeRequireGRTJobCode())
+		createNote := NewCreateNote(cfg, create, NewAuthorizeRequireGRTJobCode(), publish)
 		note, err := createNote(ctx, &models.Note{
 			SystemIntakeID: systemIntakeID,
 			Content:        content,
 		})
 		s.NoError(err)
 		s.Equal(content, note.Content)
+		s.Equal([]*models.Note{note}, published)
 	})
 
 }
@@ -1,9 +1,11 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -11,6 +13,7 @@ import (
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 
 	"github.com/cmsgov/easi-app/pkg/appconfig"
+	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/storage"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
 )
@@ -49,3 +52,23 @@ func TestServicesTestSuite(t *testing.T) {
 	}
 	suite.Run(t, servicesTestSuite)
 }
+
+// noTransaction runs f without a database transaction
+func noTransaction(ctx context.Context, f func(context.Context) error) error {
+	return f(ctx)
+}
+
+// noIntakeContacts fetches an intake without any contacts
+func noIntakeContacts(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+	return []models.SystemIntakeContact{}, nil
+}
+
+// noPublish drops the intake status changes it's asked to publish
+func noPublish(context.Context, *models.SystemIntake) error {
+	return nil
+}
+
+// noUpdateCedar drops the intake changes it's asked to send to CEDAR
+func noUpdateCedar(context.Context, *models.SystemIntake) error {
+	return nil
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pubsub"
+)
+
+// NewSubscribeIntakeStatusChanged is a service to follow an intake as its status changes.
+// Only the requester and the GRT may follow an intake.
+func NewSubscribeIntakeStatusChanged(
+	config Config,
+	fetch func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	subscribe func(context.Context, uuid.UUID) (<-chan pubsub.IntakeStatusChanged, error),
+) func(context.Context, uuid.UUID) (<-chan *models.SystemIntake, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (<-chan *models.SystemIntake, error) {
+		intake, err := fetch(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize subscribe to intake status")}
+		}
+
+		events, err := subscribe(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		intakes := make(chan *models.SystemIntake)
+		go func() {
+			defer close(intakes)
+			for range events {
+				intake, err := fetch(ctx, intakeID)
+				if err != nil {
+					appcontext.ZLogger(ctx).Error("Failed to fetch changed intake", zap.Error(err))
+					continue
+				}
+				select {
+				case intakes <- intake:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return intakes, nil
+	}
+}
+
+// NewSubscribeNoteAdded is a service to follow the notes added to an intake
+func NewSubscribeNoteAdded(
+	config Config,
+	fetch func(context.Context, uuid.UUID) (*models.Note, error),
+	authorize func(context.Context) (bool, error),
+	subscribe func(context.Context, uuid.UUID) (<-chan pubsub.NoteAdded, error),
+) func(context.Context, uuid.UUID) (<-chan *models.Note, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) (<-chan *models.Note, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.ResourceNotFoundError{
+				Err:      errors.New("failed to authorize subscribe to notes"),
+				Resource: models.Note{},
+			}
+		}
+
+		events, err := subscribe(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		notes := make(chan *models.Note)
+		go func() {
+			defer close(notes)
+			for event := range events {
+				note, err := fetch(ctx, event.NoteID)
+				if err != nil {
+					appcontext.ZLogger(ctx).Error("Failed to fetch added note", zap.Error(err))
+					continue
+				}
+				select {
+				case notes <- note:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return notes, nil
+	}
+}
+
+// NewSubscribeDocumentAdded is a service to follow the files of an accessibility request as they are uploaded
+func NewSubscribeDocumentAdded(
+	config Config,
+	fetch func(context.Context, uuid.UUID) (*models.UploadedFile, error),
+	authorize func(context.Context) (bool, error),
+	subscribe func(context.Context, uuid.UUID) (<-chan pubsub.DocumentAdded, error),
+) func(context.Context, uuid.UUID) (<-chan *models.UploadedFile, error) {
+	return func(ctx context.Context, requestID uuid.UUID) (<-chan *models.UploadedFile, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.ResourceNotFoundError{
+				Err:      errors.New("failed to authorize subscribe to uploaded files"),
+				Resource: models.UploadedFile{},
+			}
+		}
+
+		events, err := subscribe(ctx, requestID)
+		if err != nil {
+			return nil, err
+		}
+		files := make(chan *models.UploadedFile)
+		go func() {
+			defer close(files)
+			for event := range events {
+				file, err := fetch(ctx, event.FileID)
+				if err != nil {
+					appcontext.ZLogger(ctx).Error("Failed to fetch changed file", zap.Error(err))
+					continue
+				}
+				select {
+				case files <- file:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return files, nil
+	}
+}
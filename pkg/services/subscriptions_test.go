@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/pubsub"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestSubscribeIntakeStatusChanged() {
+	cfg := NewConfig(nil, nil)
+	intakeID := uuid.New()
+	status := models.SystemIntakeStatusINTAKESUBMITTED
+	fetch := func(_ context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &models.SystemIntake{ID: id, Status: status}, nil
+	}
+	subscribe := func(ctx context.Context, id uuid.UUID) (<-chan pubsub.IntakeStatusChanged, error) {
+		// the intake moves on as soon as it is subscribed to
+		status = models.SystemIntakeStatusREADYFORGRT
+		events := make(chan pubsub.IntakeStatusChanged, 1)
+		events <- pubsub.IntakeStatusChanged{IntakeID: id, Status: models.SystemIntakeStatusREADYFORGRT}
+		close(events)
+		return events, nil
+	}
+
+	s.Run("sends the intake as it is after each change", func() {
+		authorize := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
+		subscribeIntake := NewSubscribeIntakeStatusChanged(cfg, fetch, authorize, subscribe)
+
+		intakes, err := subscribeIntake(context.Background(), intakeID)
+		s.NoError(err)
+
+		select {
+		case intake := <-intakes:
+			s.Equal(intakeID, intake.ID)
+			s.Equal(models.SystemIntakeStatusREADYFORGRT, intake.Status)
+		case <-time.After(time.Second):
+			s.Fail("no intake received")
+		}
+		_, open := <-intakes
+		s.False(open)
+	})
+
+	s.Run("won't let someone who can't see the intake subscribe", func() {
+		unauthorize := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		subscribeIntake := NewSubscribeIntakeStatusChanged(cfg, fetch, unauthorize, subscribe)
+
+		_, err := subscribeIntake(context.Background(), intakeID)
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns the error if the intake can't be fetched", func() {
+		failFetch := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+			return nil, errors.New("failed to fetch")
+		}
+		authorize := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
+		subscribeIntake := NewSubscribeIntakeStatusChanged(cfg, failFetch, authorize, subscribe)
+
+		_, err := subscribeIntake(context.Background(), intakeID)
+		s.Error(err)
+	})
+}
+
+func (s ServicesTestSuite) TestSubscribeNoteAdded() {
+	cfg := NewConfig(nil, nil)
+	intakeID := uuid.New()
+	noteID := uuid.New()
+	fetch := func(_ context.Context, id uuid.UUID) (*models.Note, error) {
+		return &models.Note{ID: id, SystemIntakeID: intakeID}, nil
+	}
+	subscribe := func(ctx context.Context, id uuid.UUID) (<-chan pubsub.NoteAdded, error) {
+		events := make(chan pubsub.NoteAdded, 1)
+		events <- pubsub.NoteAdded{IntakeID: id, NoteID: noteID}
+		close(events)
+		return events, nil
+	}
+	subscribeNotes := NewSubscribeNoteAdded(cfg, fetch, NewAuthorizeRequireGRTJobCode(), subscribe)
+
+	s.Run("reviewer gets each added note", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+		notes, err := subscribeNotes(ctx, intakeID)
+		s.NoError(err)
+
+		select {
+		case note := <-notes:
+			s.Equal(noteID, note.ID)
+		case <-time.After(time.Second):
+			s.Fail("no note received")
+		}
+	})
+
+	s.Run("requester can't subscribe", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+		_, err := subscribeNotes(ctx, intakeID)
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// NewFetchSystemIntakeContacts is a service to fetch the contacts of an intake.
+// Only its requester and the GRT can see them.
+func NewFetchSystemIntakeContacts(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+) func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+	return func(ctx context.Context, intakeID uuid.UUID) ([]models.SystemIntakeContact, error) {
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch system intake contacts")}
+		}
+		return fetchContacts(ctx, intakeID)
+	}
+}
+
+// NewCreateSystemIntakeContact is a service to add a contact to an intake.
+// Their name and email are looked up in CEDAR LDAP by their EUA ID.
+// Only its requester and the GRT can add them.
+func NewCreateSystemIntakeContact(
+	config Config,
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
+	create func(context.Context, *models.SystemIntakeContact) (*models.SystemIntakeContact, error),
+) func(context.Context, *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+	return func(ctx context.Context, contact *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+		contact.EUAUserID = strings.ToUpper(strings.TrimSpace(contact.EUAUserID))
+		valErr := apperrors.NewValidationError(
+			errors.New("system intake contact failed validation"),
+			contact,
+			contact.SystemIntakeID.String(),
+		)
+		if !validContactRole(contact.Role) {
+			valErr.WithValidation("role", "must be a contact role")
+		}
+		if contact.EUAUserID == "" {
+			valErr.WithValidation("euaUserId", "is required")
+		}
+		if len(valErr.Validations) > 0 {
+			return nil, &valErr
+		}
+
+		intake, err := fetchIntake(ctx, contact.SystemIntakeID)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize create system intake contact")}
+		}
+
+		existing, err := fetchContacts(ctx, contact.SystemIntakeID)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range existing {
+			if other.Role == contact.Role && other.EUAUserID == contact.EUAUserID {
+				return nil, &apperrors.ResourceConflictError{
+					Err:        fmt.Errorf("%s is already a %s contact", contact.EUAUserID, contact.Role),
+					Resource:   intake,
+					ResourceID: intake.ID.String(),
+				}
+			}
+		}
+
+		userInfo, err := fetchUserInfo(ctx, contact.EUAUserID)
+		if err != nil {
+			return nil, err
+		}
+		contact.CommonName = userInfo.CommonName
+		contact.Email = userInfo.Email
+		return create(ctx, contact)
+	}
+}
+
+// NewDeleteSystemIntakeContact is a service to remove a contact from an intake.
+// Only its requester and the GRT can remove them.
+func NewDeleteSystemIntakeContact(
+	config Config,
+	fetchContact func(context.Context, uuid.UUID) (*models.SystemIntakeContact, error),
+	fetchIntake func(context.Context, uuid.UUID) (*models.SystemIntake, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
+	deleteContact func(context.Context, uuid.UUID) error,
+) func(context.Context, uuid.UUID, uuid.UUID) error {
+	return func(ctx context.Context, intakeID uuid.UUID, contactID uuid.UUID) error {
+		contact, err := fetchContact(ctx, contactID)
+		if err != nil {
+			return err
+		}
+		if contact.SystemIntakeID != intakeID {
+			return &apperrors.ResourceNotFoundError{
+				Err:      errors.New("contact is not on the system intake"),
+				Resource: models.SystemIntakeContact{},
+			}
+		}
+
+		intake, err := fetchIntake(ctx, intakeID)
+		if err != nil {
+			return err
+		}
+		ok, err := authorize(ctx, intake)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &apperrors.UnauthorizedError{Err: errors.New("failed to authorize delete system intake contact")}
+		}
+		return deleteContact(ctx, contactID)
+	}
+}
+
+// NewFetchRelatedSystemIntakes is a service to fetch the intakes where the principal holds any contact role
+func NewFetchRelatedSystemIntakes(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	fetch func(context.Context, string) (models.SystemIntakes, error),
+) func(context.Context) (models.SystemIntakes, error) {
+	return func(ctx context.Context) (models.SystemIntakes, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch related system intakes")}
+		}
+		return fetch(ctx, appcontext.Principal(ctx).ID())
+	}
+}
+
+// NewBackfillSystemIntakeContacts is a service to add the contacts intakes only name in free text,
+// for the roles that don't have a contact yet.
+// A name is only resolved when CEDAR LDAP has exactly one person by that name;
+// a search that comes back with searchLimit people may have left some out, so it resolves nothing.
+// Names with LDAP filter characters in them are skipped rather than searched for.
+// It keeps going past intakes that fail, and returns how many contacts it added.
+func NewBackfillSystemIntakeContacts(
+	config Config,
+	fetchIntakes func(context.Context) (models.SystemIntakes, error),
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	search func(context.Context, string) ([]*models.UserInfo, error),
+	searchLimit int,
+	create func(context.Context, *models.SystemIntakeContact) (*models.SystemIntakeContact, error),
+) func(context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		intakes, err := fetchIntakes(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		added, failed := 0, 0
+		for _, intake := range intakes {
+			logger := appcontext.ZLogger(ctx).With(zap.String("intakeID", intake.ID.String()))
+			contacts, err := fetchContacts(ctx, intake.ID)
+			if err != nil {
+				logger.Error("Failed to fetch contacts to backfill", zap.Error(err))
+				failed++
+				continue
+			}
+			hasRole := map[models.SystemIntakeContactRole]bool{}
+			for _, contact := range contacts {
+				hasRole[contact.Role] = true
+			}
+
+			for _, role := range models.SystemIntakeContactRoles {
+				name := strings.TrimSpace(intake.ContactName(role).ValueOrZero())
+				if name == "" || hasRole[role] {
+					continue
+				}
+				// a name with filter characters in it can't be searched for as written
+				if strings.ContainsAny(name, userSearchFilterChars) {
+					logger.Info("Couldn't search for contact to backfill", zap.String("role", string(role)))
+					continue
+				}
+				person, err := findPersonNamed(ctx, search, searchLimit, name)
+				if err != nil {
+					logger.Error("Failed to search for contact to backfill", zap.Error(err), zap.String("role", string(role)))
+					failed++
+					continue
+				}
+				if person == nil {
+					logger.Info("Couldn't resolve contact to backfill", zap.String("role", string(role)))
+					continue
+				}
+				_, err = create(ctx, &models.SystemIntakeContact{
+					SystemIntakeID: intake.ID,
+					Role:           role,
+					EUAUserID:      person.EuaUserID,
+					CommonName:     person.CommonName,
+					Email:          person.Email,
+					Component:      intake.ContactComponent(role),
+				})
+				if err != nil {
+					logger.Error("Failed to backfill contact", zap.Error(err), zap.String("role", string(role)))
+					failed++
+					continue
+				}
+				added++
+			}
+		}
+		if failed > 0 {
+			return added, fmt.Errorf("failed to backfill %d contacts", failed)
+		}
+		return added, nil
+	}
+}
+
+// findPersonNamed returns the one person with exactly the name, or nil if there isn't exactly one
+// or the search hit its limit
+func findPersonNamed(ctx context.Context, search func(context.Context, string) ([]*models.UserInfo, error), searchLimit int, name string) (*models.UserInfo, error) {
+	people, err := search(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(people) >= searchLimit {
+		return nil, nil
+	}
+	var found *models.UserInfo
+	for _, person := range people {
+		if !strings.EqualFold(strings.Join(strings.Fields(person.CommonName), " "), strings.Join(strings.Fields(name), " ")) {
+			continue
+		}
+		if found != nil {
+			return nil, nil
+		}
+		found = person
+	}
+	return found, nil
+}
+
+func validContactRole(role models.SystemIntakeContactRole) bool {
+	for _, contactRole := range models.SystemIntakeContactRoles {
+		if role == contactRole {
+			return true
+		}
+	}
+	return false
+}
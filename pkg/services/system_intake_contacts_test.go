@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s ServicesTestSuite) TestCreateSystemIntakeContact() {
+	ctx := context.Background()
+	cfg := NewConfig(s.logger, nil)
+	intake := testhelpers.NewSystemIntake()
+	fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	authorized := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
+	noContacts := func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+		return []models.SystemIntakeContact{}, nil
+	}
+	fetchUserInfo := func(_ context.Context, euaID string) (*models.UserInfo, error) {
+		return &models.UserInfo{CommonName: "Adeline Aarons", Email: "adeline.aarons@cms.hhs.gov", EuaUserID: euaID}, nil
+	}
+	var created *models.SystemIntakeContact
+	create := func(_ context.Context, contact *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+		created = contact
+		return contact, nil
+	}
+
+	s.Run("adds the contact with their name and email from LDAP", func() {
+		created = nil
+		createContact := NewCreateSystemIntakeContact(cfg, fetchIntake, authorized, noContacts, fetchUserInfo, create)
+
+		contact, err := createContact(ctx, &models.SystemIntakeContact{
+			SystemIntakeID: intake.ID,
+			Role:           models.SystemIntakeContactRoleBUSINESSOWNER,
+			EUAUserID:      " abcd ",
+			Component:      null.StringFrom("OIT"),
+		})
+
+		s.NoError(err)
+		s.Equal(created, contact)
+		s.Equal("ABCD", contact.EUAUserID)
+		s.Equal("Adeline Aarons", contact.CommonName)
+		s.Equal("adeline.aarons@cms.hhs.gov", contact.Email)
+		s.Equal("OIT", contact.Component.ValueOrZero())
+	})
+
+	s.Run("needs a role and an EUA ID", func() {
+		created = nil
+		createContact := NewCreateSystemIntakeContact(cfg, fetchIntake, authorized, noContacts, fetchUserInfo, create)
+
+		_, err := createContact(ctx, &models.SystemIntakeContact{SystemIntakeID: intake.ID, Role: "JANITOR"})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Len(err.(*apperrors.ValidationError).Validations, 2)
+		s.Nil(created)
+	})
+
+	s.Run("doesn't add a person to the same role twice", func() {
+		created = nil
+		existing := func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+			return []models.SystemIntakeContact{{Role: models.SystemIntakeContactRoleISSO, EUAUserID: "ABCD"}}, nil
+		}
+		createContact := NewCreateSystemIntakeContact(cfg, fetchIntake, authorized, existing, fetchUserInfo, create)
+
+		_, err := createContact(ctx, &models.SystemIntakeContact{
+			SystemIntakeID: intake.ID,
+			Role:           models.SystemIntakeContactRoleISSO,
+			EUAUserID:      "ABCD",
+		})
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+		s.Nil(created)
+	})
+
+	s.Run("returns error if the person isn't in LDAP", func() {
+		created = nil
+		notFound := func(context.Context, string) (*models.UserInfo, error) {
+			return nil, &apperrors.ExternalAPIError{Err: errors.New("not found"), Source: "CEDAR LDAP"}
+		}
+		createContact := NewCreateSystemIntakeContact(cfg, fetchIntake, authorized, noContacts, notFound, create)
+
+		_, err := createContact(ctx, &models.SystemIntakeContact{
+			SystemIntakeID: intake.ID,
+			Role:           models.SystemIntakeContactRoleISSO,
+			EUAUserID:      "ABCD",
+		})
+
+		s.IsType(&apperrors.ExternalAPIError{}, err)
+		s.Nil(created)
+	})
+
+	s.Run("returns unauthorized error if authorization fails", func() {
+		unauthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		createContact := NewCreateSystemIntakeContact(cfg, fetchIntake, unauthorized, noContacts, fetchUserInfo, create)
+
+		_, err := createContact(ctx, &models.SystemIntakeContact{
+			SystemIntakeID: intake.ID,
+			Role:           models.SystemIntakeContactRoleISSO,
+			EUAUserID:      "ABCD",
+		})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestDeleteSystemIntakeContact() {
+	ctx := context.Background()
+	cfg := NewConfig(s.logger, nil)
+	intake := testhelpers.NewSystemIntake()
+	contact := models.SystemIntakeContact{ID: uuid.New(), SystemIntakeID: intake.ID}
+	fetchContact := func(context.Context, uuid.UUID) (*models.SystemIntakeContact, error) {
+		return &contact, nil
+	}
+	fetchIntake := func(context.Context, uuid.UUID) (*models.SystemIntake, error) {
+		return &intake, nil
+	}
+	authorized := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
+	deleted := uuid.Nil
+	deleteContact := func(_ context.Context, id uuid.UUID) error {
+		deleted = id
+		return nil
+	}
+
+	s.Run("removes the contact", func() {
+		deleted = uuid.Nil
+		deleteIntakeContact := NewDeleteSystemIntakeContact(cfg, fetchContact, fetchIntake, authorized, deleteContact)
+
+		err := deleteIntakeContact(ctx, intake.ID, contact.ID)
+
+		s.NoError(err)
+		s.Equal(contact.ID, deleted)
+	})
+
+	s.Run("doesn't remove a contact from another intake", func() {
+		deleted = uuid.Nil
+		deleteIntakeContact := NewDeleteSystemIntakeContact(cfg, fetchContact, fetchIntake, authorized, deleteContact)
+
+		err := deleteIntakeContact(ctx, uuid.New(), contact.ID)
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+		s.Equal(uuid.Nil, deleted)
+	})
+
+	s.Run("returns unauthorized error if authorization fails", func() {
+		deleted = uuid.Nil
+		unauthorized := func(context.Context, *models.SystemIntake) (bool, error) { return false, nil }
+		deleteIntakeContact := NewDeleteSystemIntakeContact(cfg, fetchContact, fetchIntake, unauthorized, deleteContact)
+
+		err := deleteIntakeContact(ctx, intake.ID, contact.ID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+		s.Equal(uuid.Nil, deleted)
+	})
+}
+
+func (s ServicesTestSuite) TestFetchRelatedSystemIntakes() {
+	cfg := NewConfig(s.logger, nil)
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+	fetchedFor := ""
+	fetch := func(_ context.Context, euaID string) (models.SystemIntakes, error) {
+		fetchedFor = euaID
+		return models.SystemIntakes{testhelpers.NewSystemIntake()}, nil
+	}
+
+	s.Run("fetches the intakes the principal is a contact on", func() {
+		fetchRelated := NewFetchRelatedSystemIntakes(cfg, NewAuthorizeHasEASiRole(), fetch)
+
+		intakes, err := fetchRelated(ctx)
+
+		s.NoError(err)
+		s.Len(intakes, 1)
+		s.Equal("REQ", fetchedFor)
+	})
+
+	s.Run("returns unauthorized error without an EASi user", func() {
+		fetchRelated := NewFetchRelatedSystemIntakes(cfg, NewAuthorizeHasEASiRole(), fetch)
+
+		_, err := fetchRelated(context.Background())
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestBackfillSystemIntakeContacts() {
+	ctx := context.Background()
+	cfg := NewConfig(s.logger, nil)
+
+	intake := testhelpers.NewSystemIntake()
+	intake.BusinessOwner = null.StringFrom("Adeline Aarons")
+	intake.BusinessOwnerComponent = null.StringFrom("OIT")
+	intake.ProductManager = null.StringFrom("Pat Smith")
+	intake.ISSOName = null.StringFrom("Already Added")
+	intake.TRBCollaboratorName = null.String{}
+	intake.OITSecurityCollaboratorName = null.String{}
+	intake.EACollaboratorName = null.StringFrom("Nobody Known")
+	fetchIntakes := func(context.Context) (models.SystemIntakes, error) {
+		return models.SystemIntakes{intake}, nil
+	}
+	fetchContacts := func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error) {
+		return []models.SystemIntakeContact{{Role: models.SystemIntakeContactRoleISSO, EUAUserID: "ISSO"}}, nil
+	}
+	directory := map[string][]*models.UserInfo{
+		"Adeline Aarons": {
+			{CommonName: "Adeline Aarons", Email: "adeline.aarons@cms.hhs.gov", EuaUserID: "ABCD"},
+			{CommonName: "Adeline Aaronson", Email: "adeline.aaronson@cms.hhs.gov", EuaUserID: "EFGH"},
+		},
+		"Pat Smith": {
+			{CommonName: "Pat Smith", Email: "pat.smith@cms.hhs.gov", EuaUserID: "PSM1"},
+			{CommonName: "pat smith", Email: "pat.smith2@cms.hhs.gov", EuaUserID: "PSM2"},
+		},
+	}
+	search := func(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+		return directory[commonName], nil
+	}
+	var created []*models.SystemIntakeContact
+	create := func(_ context.Context, contact *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+		created = append(created, contact)
+		return contact, nil
+	}
+
+	s.Run("adds contacts whose names match exactly one person", func() {
+		created = nil
+		backfill := NewBackfillSystemIntakeContacts(cfg, fetchIntakes, fetchContacts, search, 20, create)
+
+		added, err := backfill(ctx)
+
+		s.NoError(err)
+		s.Equal(1, added)
+		s.Len(created, 1)
+		s.Equal(models.SystemIntakeContactRoleBUSINESSOWNER, created[0].Role)
+		s.Equal("ABCD", created[0].EUAUserID)
+		s.Equal("OIT", created[0].Component.ValueOrZero())
+	})
+
+	s.Run("keeps going after a search fails", func() {
+		created = nil
+		failOwner := func(ctx context.Context, commonName string) ([]*models.UserInfo, error) {
+			if commonName == "Pat Smith" {
+				return nil, errors.New("CEDAR is down")
+			}
+			return search(ctx, commonName)
+		}
+		backfill := NewBackfillSystemIntakeContacts(cfg, fetchIntakes, fetchContacts, failOwner, 20, create)
+
+		added, err := backfill(ctx)
+
+		s.Error(err)
+		s.Equal(1, added)
+	})
+
+	s.Run("doesn't resolve a name when the search hits its limit", func() {
+		created = nil
+		backfill := NewBackfillSystemIntakeContacts(cfg, fetchIntakes, fetchContacts, search, 2, create)
+
+		added, err := backfill(ctx)
+
+		s.NoError(err)
+		s.Equal(0, added)
+		s.Empty(created)
+	})
+
+	s.Run("skips names with LDAP filter characters without searching for them", func() {
+		created = nil
+		contractor := testhelpers.NewSystemIntake()
+		contractor.BusinessOwner = null.StringFrom("Jane Doe (CTR)")
+		contractor.ProductManager = null.StringFrom("Pat Smith*")
+		contractor.ISSOName = null.String{}
+		contractor.TRBCollaboratorName = null.String{}
+		contractor.OITSecurityCollaboratorName = null.String{}
+		contractor.EACollaboratorName = null.String{}
+		fetchContractor := func(context.Context) (models.SystemIntakes, error) {
+			return models.SystemIntakes{contractor}, nil
+		}
+		var searched []string
+		recordSearch := func(ctx context.Context, commonName string) ([]*models.UserInfo, error) {
+			searched = append(searched, commonName)
+			return search(ctx, commonName)
+		}
+		backfill := NewBackfillSystemIntakeContacts(cfg, fetchContractor, noIntakeContacts, recordSearch, 20, create)
+
+		added, err := backfill(ctx)
+
+		s.NoError(err)
+		s.Equal(0, added)
+		s.Empty(searched)
+		s.Empty(created)
+	})
+}
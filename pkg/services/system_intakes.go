@@ -61,6 +61,102 @@ func NewFetchSystemIntakes(
 	}
 }
 
+// NewFetchSystemIntakesPage is a service to fetch a page of the system intakes
+// that are to be presented to the given requester
+func NewFetchSystemIntakesPage(
+	config Config,
+	fetchPage func(context.Context, string, []models.SystemIntakeStatus, models.PageRequest) (*models.SystemIntakesPage, error),
+	authorize func(context.Context) (bool, error),
+) func(context.Context, models.SystemIntakeStatusFilter, models.PageRequest) (*models.SystemIntakesPage, error) {
+	return func(ctx context.Context, statusFilter models.SystemIntakeStatusFilter, request models.PageRequest) (*models.SystemIntakesPage, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize fetch system intakes")}
+		}
+
+		euaID := ""
+		statuses := []models.SystemIntakeStatus{}
+		principal := appcontext.Principal(ctx)
+		if !principal.AllowGRT() {
+			euaID = principal.ID()
+		} else if statusFilter != "" {
+			statuses, err = models.GetStatusesByFilter(statusFilter)
+			if err != nil {
+				return nil, &apperrors.BadRequestError{Err: err}
+			}
+		}
+
+		page, err := fetchPage(ctx, euaID, statuses, request)
+		if err != nil {
+			if _, ok := err.(*apperrors.ValidationError); ok {
+				return nil, err
+			}
+			appcontext.ZLogger(ctx).Error("failed to fetch system intakes page")
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     request,
+				Operation: apperrors.QueryFetch,
+			}
+		}
+		return page, nil
+	}
+}
+
+// NewSearchSystemIntakes is a service for the GRT to search all system intakes.
+// The OPEN and CLOSED status filters can be given as statuses.
+func NewSearchSystemIntakes(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	search func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error),
+) func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+	return func(ctx context.Context, params models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize search system intakes")}
+		}
+
+		if params.SubmittedAfter != nil && params.SubmittedBefore != nil && !params.SubmittedAfter.Before(*params.SubmittedBefore) {
+			valErr := apperrors.NewValidationError(
+				errors.New("system intake search failed validation"),
+				models.SystemIntakeSearch{},
+				"",
+			)
+			valErr.WithValidation("submittedBefore", "must be after submittedAfter")
+			return nil, &valErr
+		}
+
+		statuses := []models.SystemIntakeStatus{}
+		for _, status := range params.Statuses {
+			filtered, filterErr := models.GetStatusesByFilter(models.SystemIntakeStatusFilter(status))
+			if filterErr != nil {
+				statuses = append(statuses, status)
+				continue
+			}
+			statuses = append(statuses, filtered...)
+		}
+		params.Statuses = statuses
+
+		result, err := search(ctx, params)
+		if err != nil {
+			if _, ok := err.(*apperrors.ValidationError); ok {
+				return nil, err
+			}
+			return nil, &apperrors.QueryError{
+				Err:       err,
+				Model:     params,
+				Operation: apperrors.QueryFetch,
+			}
+		}
+		return result, nil
+	}
+}
+
 // NewCreateSystemIntake is a service to create a business case
 func NewCreateSystemIntake(
 	config Config,
@@ -168,7 +264,9 @@ func NewArchiveSystemIntake(
 	update func(c context.Context, intake *models.SystemIntake) (*models.SystemIntake, error),
 	closeBusinessCase func(context.Context, uuid.UUID) error,
 	authorize func(context context.Context, intake *models.SystemIntake) (bool, error),
-	sendWithdrawEmail func(ctx context.Context, requestName string) error,
+	sendWithdrawEmail func(ctx context.Context, intakeID uuid.UUID, requestName string) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
 ) func(context.Context, uuid.UUID) error {
 	return func(ctx context.Context, id uuid.UUID) error {
 		intake, fetchErr := fetch(ctx, id)
@@ -210,10 +308,14 @@ func NewArchiveSystemIntake(
 				Operation: apperrors.QuerySave,
 			}
 		}
+		if initialStatus != intake.Status {
+			publishIntakeStatusChanged(ctx, publishStatusChanged, intake)
+		}
+		syncSystemIntakeToCedar(ctx, updateCedar, intake)
 
 		// Do note send email if intake was in a draft state (not submitted)
 		if initialStatus != models.SystemIntakeStatusINTAKEDRAFT {
-			err = sendWithdrawEmail(ctx, intake.ProjectName.String)
+			err = sendWithdrawEmail(ctx, intake.ID, intake.ProjectName.String)
 			if err != nil {
 				appcontext.ZLogger(ctx).Error("Withdraw email failed to send: ", zap.Error(err))
 			}
@@ -227,7 +329,7 @@ func NewArchiveSystemIntake(
 func NewFetchSystemIntakeByID(
 	config Config,
 	fetch func(c context.Context, id uuid.UUID) (*models.SystemIntake, error),
-	authorize func(context.Context) (bool, error),
+	authorize func(context.Context, *models.SystemIntake) (bool, error),
 ) func(c context.Context, u uuid.UUID) (*models.SystemIntake, error) {
 	return func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
 		logger := appcontext.ZLogger(ctx)
@@ -240,7 +342,7 @@ func NewFetchSystemIntakeByID(
 				Operation: apperrors.QueryFetch,
 			}
 		}
-		ok, err := authorize(ctx)
+		ok, err := authorize(ctx, intake)
 		if err != nil {
 			logger.Error("failed to authorize fetch system intake")
 			return &models.SystemIntake{}, err
@@ -261,8 +363,12 @@ func NewUpdateLifecycleFields(
 	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
 	saveAction func(context.Context, *models.Action) error,
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
-	sendIssueLCIDEmail func(context.Context, string, string, *time.Time, string, string, string) error,
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendIssueLCIDEmail func(context.Context, uuid.UUID, string, models.EmailRecipients, string, *time.Time, string, string, string) error,
 	generateLCID func(context.Context) (string, error),
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
 ) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
 	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
 		existing, err := fetch(ctx, intake.ID)
@@ -279,7 +385,10 @@ func NewUpdateLifecycleFields(
 			return nil, err
 		}
 		if !ok {
-			return nil, &apperrors.UnauthorizedError{Err: err}
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize issue lifecycle id")}
+		}
+		if err = checkGovernanceTransition(models.ActionTypeISSUELCID, existing); err != nil {
+			return nil, err
 		}
 
 		// don't allow overwriting an existing LCID
@@ -305,6 +414,14 @@ func NewUpdateLifecycleFields(
 			}
 		}
 
+		contacts, err := fetchContacts(ctx, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err = chooseRecipients(action, requesterInfo.Email, contacts); err != nil {
+			return nil, err
+		}
+
 		// we only want to bring over the fields specifically
 		// dealing with lifecycleID information
 		updatedTime := config.clock.Now()
@@ -313,43 +430,47 @@ func NewUpdateLifecycleFields(
 		existing.LifecycleExpiresAt = intake.LifecycleExpiresAt
 		existing.LifecycleScope = intake.LifecycleScope
 		existing.DecisionNextSteps = intake.DecisionNextSteps
-
-		// if a LCID wasn't passed in, we generate one
-		if existing.LifecycleID.ValueOrZero() == "" {
-			lcid, gErr := generateLCID(ctx)
-			if gErr != nil {
-				return nil, gErr
+		existing.DecidedAt = &updatedTime
+
+		var updated *models.SystemIntake
+		err = withTransaction(ctx, func(ctx context.Context) error {
+			// if a LCID wasn't passed in, we generate one
+			if existing.LifecycleID.ValueOrZero() == "" {
+				lcid, gErr := generateLCID(ctx)
+				if gErr != nil {
+					return gErr
+				}
+				existing.LifecycleID = null.StringFrom(lcid)
 			}
-			existing.LifecycleID = null.StringFrom(lcid)
-		}
 
-		action.IntakeID = &existing.ID
-		action.ActionType = models.ActionTypeISSUELCID
-		if err = saveAction(ctx, action); err != nil {
-			return nil, err
-		}
+			action.IntakeID = &existing.ID
+			action.ActionType = models.ActionTypeISSUELCID
+			if err = saveAction(ctx, action); err != nil {
+				return err
+			}
 
-		existing.Status = models.SystemIntakeStatusLCIDISSUED
-		updated, err := update(ctx, existing)
-		if err != nil {
-			return nil, &apperrors.QueryError{
-				Err:       err,
-				Model:     intake,
-				Operation: apperrors.QuerySave,
+			existing.Status = models.SystemIntakeStatusLCIDISSUED
+			updated, err = update(ctx, existing)
+			if err != nil {
+				return err
 			}
-		}
 
-		err = sendIssueLCIDEmail(
-			ctx,
-			requesterInfo.Email,
-			updated.LifecycleID.String,
-			updated.LifecycleExpiresAt,
-			updated.LifecycleScope.String,
-			updated.LifecycleNextSteps.String,
-			action.Feedback.String)
+			return sendIssueLCIDEmail(
+				ctx,
+				updated.ID,
+				requesterInfo.Email,
+				action.EmailRecipients,
+				updated.LifecycleID.String,
+				updated.LifecycleExpiresAt,
+				updated.LifecycleScope.String,
+				updated.LifecycleNextSteps.String,
+				action.Feedback.String)
+		})
 		if err != nil {
 			return nil, err
 		}
+		publishIntakeStatusChanged(ctx, publishStatusChanged, updated)
+		syncSystemIntakeToCedar(ctx, updateCedar, updated)
 
 		return updated, nil
 
@@ -365,7 +486,11 @@ func NewUpdateRejectionFields(
 	update func(context.Context, *models.SystemIntake) (*models.SystemIntake, error),
 	saveAction func(context.Context, *models.Action) error,
 	fetchUserInfo func(context.Context, string) (*models.UserInfo, error),
-	sendRejectRequestEmail func(ctx context.Context, recipient string, reason string, nextSteps string, feedback string) error,
+	fetchContacts func(context.Context, uuid.UUID) ([]models.SystemIntakeContact, error),
+	sendRejectRequestEmail func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, reason string, nextSteps string, feedback string) error,
+	withTransaction func(context.Context, func(context.Context) error) error,
+	publishStatusChanged func(context.Context, *models.SystemIntake) error,
+	updateCedar func(context.Context, *models.SystemIntake) error,
 ) func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error) {
 	return func(ctx context.Context, intake *models.SystemIntake, action *models.Action) (*models.SystemIntake, error) {
 		existing, err := fetch(ctx, intake.ID)
@@ -378,7 +503,10 @@ func NewUpdateRejectionFields(
 			return nil, err
 		}
 		if !ok {
-			return nil, &apperrors.UnauthorizedError{Err: err}
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize reject system intake")}
+		}
+		if err = checkGovernanceTransition(models.ActionTypeREJECT, existing); err != nil {
+			return nil, err
 		}
 
 		requesterInfo, err := fetchUserInfo(ctx, existing.EUAUserID.ValueOrZero())
@@ -395,34 +523,50 @@ func NewUpdateRejectionFields(
 			}
 		}
 
-		action.IntakeID = &existing.ID
-		action.ActionType = models.ActionTypeREJECT
-		if err = saveAction(ctx, action); err != nil {
+		contacts, err := fetchContacts(ctx, existing.ID)
+		if err != nil {
 			return nil, err
 		}
-
-		// we only want to bring over the fields specifically
-		// dealing with Rejection information
-		updatedTime := config.clock.Now()
-		existing.UpdatedAt = &updatedTime
-		existing.RejectionReason = intake.RejectionReason
-		existing.DecisionNextSteps = intake.DecisionNextSteps
-		existing.Status = models.SystemIntakeStatusNOTAPPROVED
-		updated, err := update(ctx, existing)
-		if err != nil {
+		if err = chooseRecipients(action, requesterInfo.Email, contacts); err != nil {
 			return nil, err
 		}
 
-		err = sendRejectRequestEmail(
-			ctx,
-			requesterInfo.Email,
-			existing.RejectionReason.String,
-			existing.DecisionNextSteps.String,
-			action.Feedback.String,
-		)
+		var updated *models.SystemIntake
+		err = withTransaction(ctx, func(ctx context.Context) error {
+			action.IntakeID = &existing.ID
+			action.ActionType = models.ActionTypeREJECT
+			if err = saveAction(ctx, action); err != nil {
+				return err
+			}
+
+			// we only want to bring over the fields specifically
+			// dealing with Rejection information
+			updatedTime := config.clock.Now()
+			existing.UpdatedAt = &updatedTime
+			existing.RejectionReason = intake.RejectionReason
+			existing.DecisionNextSteps = intake.DecisionNextSteps
+			existing.Status = models.SystemIntakeStatusNOTAPPROVED
+			existing.DecidedAt = &updatedTime
+			updated, err = update(ctx, existing)
+			if err != nil {
+				return err
+			}
+
+			return sendRejectRequestEmail(
+				ctx,
+				existing.ID,
+				requesterInfo.Email,
+				action.EmailRecipients,
+				existing.RejectionReason.String,
+				existing.DecisionNextSteps.String,
+				action.Feedback.String,
+			)
+		})
 		if err != nil {
 			return nil, err
 		}
+		publishIntakeStatusChanged(ctx, publishStatusChanged, updated)
+		syncSystemIntakeToCedar(ctx, updateCedar, updated)
 
 		return updated, nil
 	}
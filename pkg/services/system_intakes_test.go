@@ -124,6 +124,149 @@ func (s ServicesTestSuite) TestFetchSystemIntakes() {
 	}
 }
 
+func (s ServicesTestSuite) TestFetchSystemIntakesPage() {
+	requester := &authn.EUAPrincipal{EUAID: "REQ", JobCodeEASi: true}
+	reviewer := &authn.EUAPrincipal{EUAID: "GRT", JobCodeEASi: true, JobCodeGRT: true}
+	serviceConfig := NewConfig(nil, nil)
+	fnAuth := NewAuthorizeHasEASiRole()
+	request := models.PageRequest{First: 10}
+
+	var gotEUAID string
+	var gotStatuses []models.SystemIntakeStatus
+	fetchPage := func(ctx context.Context, euaID string, statuses []models.SystemIntakeStatus, _ models.PageRequest) (*models.SystemIntakesPage, error) {
+		gotEUAID = euaID
+		gotStatuses = statuses
+		return &models.SystemIntakesPage{TotalCount: 1, Results: []models.SystemIntake{{}}}, nil
+	}
+	fetchSystemIntakesPage := NewFetchSystemIntakesPage(serviceConfig, fetchPage, fnAuth)
+
+	s.Run("requesters only see their own intakes", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), requester)
+		page, err := fetchSystemIntakesPage(ctx, models.SystemIntakeStatusFilterOPEN, request)
+
+		s.NoError(err)
+		s.Equal(1, page.TotalCount)
+		s.Equal("REQ", gotEUAID)
+		s.Empty(gotStatuses)
+	})
+
+	s.Run("reviewers see every intake with the filtered statuses", func() {
+		ctx := appcontext.WithPrincipal(context.Background(), reviewer)
+		_, err := fetchSystemIntakesPage(ctx, models.SystemIntakeStatusFilterCLOSED, request)
+
+		s.NoError(err)
+		s.Equal("", gotEUAID)
+		s.Contains(gotStatuses, models.SystemIntakeStatusLCIDISSUED)
+	})
+
+	s.Run("fails authorization", func() {
+		_, err := fetchSystemIntakesPage(context.Background(), "", request)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("passes through validation errors", func() {
+		valErr := apperrors.NewValidationError(errors.New("page request failed validation"), models.PageRequest{}, "")
+		failPage := func(context.Context, string, []models.SystemIntakeStatus, models.PageRequest) (*models.SystemIntakesPage, error) {
+			return nil, &valErr
+		}
+		ctx := appcontext.WithPrincipal(context.Background(), reviewer)
+		_, err := NewFetchSystemIntakesPage(serviceConfig, failPage, fnAuth)(ctx, "", request)
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns a query error when the fetch fails", func() {
+		failPage := func(context.Context, string, []models.SystemIntakeStatus, models.PageRequest) (*models.SystemIntakesPage, error) {
+			return nil, errors.New("forced error")
+		}
+		ctx := appcontext.WithPrincipal(context.Background(), reviewer)
+		_, err := NewFetchSystemIntakesPage(serviceConfig, failPage, fnAuth)(ctx, "", request)
+
+		s.IsType(&apperrors.QueryError{}, err)
+	})
+}
+
+func (s ServicesTestSuite) TestSearchSystemIntakes() {
+	ctx := context.Background()
+	serviceConfig := NewConfig(nil, nil)
+	authorized := func(context.Context) (bool, error) { return true, nil }
+
+	s.Run("expands status filters and passes the search through", func() {
+		var got models.SystemIntakeSearch
+		search := func(_ context.Context, params models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			got = params
+			return &models.SystemIntakeSearchResult{TotalCount: 1, Results: []models.SystemIntake{{}}}, nil
+		}
+		searchSystemIntakes := NewSearchSystemIntakes(serviceConfig, authorized, search)
+
+		result, err := searchSystemIntakes(ctx, models.SystemIntakeSearch{
+			Query: "easi",
+			Statuses: []models.SystemIntakeStatus{
+				models.SystemIntakeStatus(models.SystemIntakeStatusFilterCLOSED),
+				models.SystemIntakeStatusREADYFORGRT,
+			},
+		})
+
+		s.NoError(err)
+		s.Equal(1, result.TotalCount)
+		s.Equal("easi", got.Query)
+		s.Contains(got.Statuses, models.SystemIntakeStatusLCIDISSUED)
+		s.Contains(got.Statuses, models.SystemIntakeStatusREADYFORGRT)
+		s.NotContains(got.Statuses, models.SystemIntakeStatus(models.SystemIntakeStatusFilterCLOSED))
+	})
+
+	s.Run("rejects a submitted range that ends before it starts", func() {
+		search := func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			s.FailNow("search should not be called")
+			return nil, nil
+		}
+		searchSystemIntakes := NewSearchSystemIntakes(serviceConfig, authorized, search)
+		after := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := searchSystemIntakes(ctx, models.SystemIntakeSearch{SubmittedAfter: &after, SubmittedBefore: &before})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("fails authorization", func() {
+		unauthorized := func(context.Context) (bool, error) { return false, nil }
+		search := func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			return &models.SystemIntakeSearchResult{}, nil
+		}
+		searchSystemIntakes := NewSearchSystemIntakes(serviceConfig, unauthorized, search)
+
+		_, err := searchSystemIntakes(ctx, models.SystemIntakeSearch{})
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("passes through a bad page request", func() {
+		search := func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			valErr := apperrors.NewValidationError(errors.New("bad page"), models.PageRequest{}, "")
+			valErr.WithValidation("first", "must be between 1 and 100")
+			return nil, &valErr
+		}
+		searchSystemIntakes := NewSearchSystemIntakes(serviceConfig, authorized, search)
+
+		_, err := searchSystemIntakes(ctx, models.SystemIntakeSearch{})
+
+		s.IsType(&apperrors.ValidationError{}, err)
+	})
+
+	s.Run("returns a query error when the search fails", func() {
+		search := func(context.Context, models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+			return nil, errors.New("forced error")
+		}
+		searchSystemIntakes := NewSearchSystemIntakes(serviceConfig, authorized, search)
+
+		_, err := searchSystemIntakes(ctx, models.SystemIntakeSearch{})
+
+		s.IsType(&apperrors.QueryError{}, err)
+	})
+}
+
 func (s ServicesTestSuite) TestNewCreateSystemIntake() {
 	logger := zap.NewNop()
 	fakeEuaID := "FAKE"
@@ -239,7 +382,7 @@ func (s ServicesTestSuite) TestSystemIntakeByIDFetcher() {
 	fakeID := uuid.New()
 	serviceConfig := NewConfig(logger, nil)
 	serviceConfig.clock = clock.NewMock()
-	authorize := func(context context.Context) (bool, error) { return true, nil }
+	authorize := func(context.Context, *models.SystemIntake) (bool, error) { return true, nil }
 
 	s.Run("successfully fetches System Intake by ID without an error", func() {
 		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
@@ -265,6 +408,18 @@ func (s ServicesTestSuite) TestSystemIntakeByIDFetcher() {
 		s.IsType(&apperrors.QueryError{}, err)
 		s.Equal(&models.SystemIntake{}, intake)
 	})
+
+	s.Run("returns unauthorized error for someone else's intake", func() {
+		fetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: fakeID, EUAUserID: null.StringFrom("NOPE")}, nil
+		}
+		fetchSystemIntakeByID := NewFetchSystemIntakeByID(serviceConfig, fetch, NewAuthorizeUserIsIntakeRequesterOrHasGRTJobCode())
+		ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+
+		_, err := fetchSystemIntakeByID(ctx, fakeID)
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
 }
 
 func (s ServicesTestSuite) TestSystemIntakeArchiver() {
@@ -290,21 +445,46 @@ func (s ServicesTestSuite) TestSystemIntakeArchiver() {
 	authorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 		return true, nil
 	}
-	sendWithdrawEmail := func(ctx context.Context, requestName string) error {
+	sendWithdrawEmail := func(ctx context.Context, intakeID uuid.UUID, requestName string) error {
+		return nil
+	}
+	updateCedar := func(ctx context.Context, intake *models.SystemIntake) error {
 		return nil
 	}
 
 	s.Run("golden path archive system intake", func() {
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, authorize, sendWithdrawEmail)
+		var published *models.SystemIntake
+		publish := func(ctx context.Context, intake *models.SystemIntake) error {
+			published = intake
+			return nil
+		}
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, authorize, sendWithdrawEmail, publish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusWITHDRAWN, published.Status)
+	})
+
+	s.Run("sends the withdrawal of an intake submitted to CEDAR", func() {
+		submittedFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+			return &models.SystemIntake{ID: id, AlfabetID: null.StringFrom("000-000-0")}, nil
+		}
+		var sent *models.SystemIntake
+		recordUpdateCedar := func(ctx context.Context, intake *models.SystemIntake) error {
+			sent = intake
+			return nil
+		}
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, submittedFetch, update, archiveBusinessCase, authorize, sendWithdrawEmail, noPublish, recordUpdateCedar)
+		err := archiveSystemIntake(ctx, fakeID)
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusWITHDRAWN, sent.Status)
+		s.NotNil(sent.ArchivedAt)
 	})
 
 	s.Run("returns query error when fetch fails", func() {
 		failFetch := func(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("fetch failed")
 		}
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, failFetch, update, archiveBusinessCase, authorize, sendWithdrawEmail)
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, failFetch, update, archiveBusinessCase, authorize, sendWithdrawEmail, noPublish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.IsType(&apperrors.QueryError{}, err)
 	})
@@ -314,7 +494,7 @@ func (s ServicesTestSuite) TestSystemIntakeArchiver() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, actualError
 		}
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, failAuthorize, sendWithdrawEmail)
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, failAuthorize, sendWithdrawEmail, noPublish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.Error(err)
 		s.Equal(actualError, err)
@@ -324,7 +504,7 @@ func (s ServicesTestSuite) TestSystemIntakeArchiver() {
 		failAuthorize := func(ctx context.Context, intake *models.SystemIntake) (bool, error) {
 			return false, nil
 		}
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, failAuthorize, sendWithdrawEmail)
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, archiveBusinessCase, failAuthorize, sendWithdrawEmail, noPublish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.IsType(&apperrors.UnauthorizedError{}, err)
 	})
@@ -334,7 +514,7 @@ func (s ServicesTestSuite) TestSystemIntakeArchiver() {
 		failArchiveBusinessCase := func(ctx context.Context, id uuid.UUID) error {
 			return actualError
 		}
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, failArchiveBusinessCase, authorize, sendWithdrawEmail)
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, update, failArchiveBusinessCase, authorize, sendWithdrawEmail, noPublish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.Error(err)
 		s.Equal(actualError, err)
@@ -344,7 +524,7 @@ func (s ServicesTestSuite) TestSystemIntakeArchiver() {
 		failUpdate := func(ctx context.Context, businessCase *models.SystemIntake) (*models.SystemIntake, error) {
 			return &models.SystemIntake{}, errors.New("update failed")
 		}
-		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, failUpdate, archiveBusinessCase, authorize, sendWithdrawEmail)
+		archiveSystemIntake := NewArchiveSystemIntake(serviceConfig, fetch, failUpdate, archiveBusinessCase, authorize, sendWithdrawEmail, noPublish, updateCedar)
 		err := archiveSystemIntake(ctx, fakeID)
 		s.IsType(&apperrors.QueryError{}, err)
 	})
@@ -371,7 +551,7 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 
 	fnAuthorize := func(context.Context) (bool, error) { return true, nil }
 	fnFetch := func(c context.Context, id uuid.UUID) (*models.SystemIntake, error) {
-		return &models.SystemIntake{ID: id}, nil
+		return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusREADYFORGRB, AlfabetID: null.StringFrom("000-000-0")}, nil
 	}
 	fnUpdate := func(c context.Context, i *models.SystemIntake) (*models.SystemIntake, error) {
 		if i.LifecycleID.ValueOrZero() == "" {
@@ -400,14 +580,24 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 	}
 	reviewEmailCount := 0
 	feedbackForEmailText := ""
-	fnSendLCIDEmail := func(_ context.Context, _ string, _ string, _ *time.Time, _ string, _string, emailText string) error {
+	fnSendLCIDEmail := func(_ context.Context, _ uuid.UUID, _ string, _ models.EmailRecipients, _ string, _ *time.Time, _ string, _string, emailText string) error {
 		feedbackForEmailText = emailText
 		reviewEmailCount++
 		return nil
 	}
 	fnGenerate := func(context.Context) (string, error) { return "123456", nil }
+	cedarUpdates := 0
+	fnUpdateCedar := func(context.Context, *models.SystemIntake) error {
+		cedarUpdates++
+		return nil
+	}
+	published := 0
+	fnPublish := func(context.Context, *models.SystemIntake) error {
+		published++
+		return nil
+	}
 	cfg := Config{clock: clock.NewMock()}
-	happy := NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate)
+	happy := NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, fnPublish, fnUpdateCedar)
 
 	s.Run("happy path provided lcid", func() {
 		intake, err := happy(context.Background(), input, action)
@@ -418,6 +608,9 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 		s.Equal(intake.LifecycleScope, scope)
 		s.Equal(1, reviewEmailCount)
 		s.Equal("Feedback", feedbackForEmailText)
+		s.NotNil(intake.DecidedAt)
+		s.Equal(1, cedarUpdates)
+		s.Equal(1, published)
 	})
 
 	// from here on out, we always expect the LCID to get generated
@@ -447,38 +640,44 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 	fnFetchUserInfoErr := func(_ context.Context, euaID string) (*models.UserInfo, error) {
 		return nil, errors.New("fetch user info error")
 	}
-	fnSendLCIDEmailErr := func(_ context.Context, string, _ string, _ *time.Time, _ string, _ string, _ string) error {
+	fnSendLCIDEmailErr := func(_ context.Context, _ uuid.UUID, _ string, _ models.EmailRecipients, _ string, _ *time.Time, _ string, _ string, _ string) error {
 		return errors.New("send email error")
 	}
 	fnGenerateErr := func(context.Context) (string, error) { return "", errors.New("gen error") }
+	fnFetchSubmitted := func(c context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusINTAKESUBMITTED}, nil
+	}
 
 	// build the table-driven test of error cases for unhappy path
 	testCases := map[string]struct {
 		fn func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
 	}{
 		"error path fetch": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path auth": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path auth fail": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
+		},
+		"error path not reviewed": {
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetchSubmitted, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path generate": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerateErr),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerateErr, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path save action": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path fetch user info": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path send email": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmailErr, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmailErr, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path update": {
-			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendLCIDEmail, fnGenerate),
+			fn: NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar),
 		},
 	}
 
@@ -488,6 +687,17 @@ func (s ServicesTestSuite) TestUpdateLifecycleFields() {
 			s.Error(err)
 		})
 	}
+
+	s.Run("returns conflict error if the lcid is already issued", func() {
+		fnUpdateConflict := func(c context.Context, i *models.SystemIntake) (*models.SystemIntake, error) {
+			return nil, &apperrors.ResourceConflictError{Err: errors.New("lcid taken"), Resource: i, ResourceID: i.ID.String()}
+		}
+		issue := NewUpdateLifecycleFields(cfg, fnAuthorize, fnFetch, fnUpdateConflict, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendLCIDEmail, fnGenerate, noTransaction, noPublish, fnUpdateCedar)
+
+		_, err := issue(context.Background(), input, action)
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
 }
 
 func (s ServicesTestSuite) TestUpdateRejectionFields() {
@@ -507,7 +717,7 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 
 	fnAuthorize := func(context.Context) (bool, error) { return true, nil }
 	fnFetch := func(c context.Context, id uuid.UUID) (*models.SystemIntake, error) {
-		return &models.SystemIntake{ID: id}, nil
+		return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusREADYFORGRB, AlfabetID: null.StringFrom("000-000-0")}, nil
 	}
 	fnUpdate := func(c context.Context, i *models.SystemIntake) (*models.SystemIntake, error) {
 		if !i.DecisionNextSteps.Equal(input.DecisionNextSteps) {
@@ -530,13 +740,23 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 	}
 	reviewEmailCount := 0
 	feedbackForEmailText := ""
-	fnSendRejectRequestEmail := func(ctx context.Context, recipientAddress string, reason string, nextSteps string, feedback string) error {
+	fnSendRejectRequestEmail := func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, reason string, nextSteps string, feedback string) error {
 		feedbackForEmailText = feedback
 		reviewEmailCount++
 		return nil
 	}
+	cedarUpdates := 0
+	fnUpdateCedar := func(context.Context, *models.SystemIntake) error {
+		cedarUpdates++
+		return nil
+	}
+	published := 0
+	fnPublish := func(context.Context, *models.SystemIntake) error {
+		published++
+		return nil
+	}
 	cfg := Config{clock: clock.NewMock()}
-	happy := NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail)
+	happy := NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, fnPublish, fnUpdateCedar)
 
 	s.Run("happy path", func() {
 		intake, err := happy(context.Background(), input, action)
@@ -545,6 +765,9 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 		s.Equal(intake.RejectionReason, reason)
 		s.Equal(1, reviewEmailCount)
 		s.Equal("Feedback", feedbackForEmailText)
+		s.NotNil(intake.DecidedAt)
+		s.Equal(1, cedarUpdates)
+		s.Equal(1, published)
 	})
 
 	// build the error-generating pieces
@@ -562,34 +785,40 @@ func (s ServicesTestSuite) TestUpdateRejectionFields() {
 	fnFetchUserInfoErr := func(_ context.Context, euaID string) (*models.UserInfo, error) {
 		return nil, errors.New("fetch user info error")
 	}
-	fnSendRejectRequestEmailErr := func(ctx context.Context, recipientAddress string, reason string, nextSteps string, feedback string) error {
+	fnSendRejectRequestEmailErr := func(ctx context.Context, intakeID uuid.UUID, requesterEmail string, recipients models.EmailRecipients, reason string, nextSteps string, feedback string) error {
 		return errors.New("send email error")
 	}
+	fnFetchSubmitted := func(c context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+		return &models.SystemIntake{ID: id, Status: models.SystemIntakeStatusINTAKESUBMITTED}, nil
+	}
 
 	// build the table-driven test of error cases for unhappy path
 	testCases := map[string]struct {
 		fn func(context.Context, *models.SystemIntake, *models.Action) (*models.SystemIntake, error)
 	}{
 		"error path fetch": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetchErr, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path auth": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorizeErr, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path auth fail": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorizeFail, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
+		},
+		"error path not reviewed": {
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetchSubmitted, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path update": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdateErr, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path fetch user info": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfoErr, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path save action": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, fnSendRejectRequestEmail),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveActionErr, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmail, noTransaction, noPublish, fnUpdateCedar),
 		},
 		"error path send email": {
-			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, fnSendRejectRequestEmailErr),
+			fn: NewUpdateRejectionFields(cfg, fnAuthorize, fnFetch, fnUpdate, fnSaveAction, fnFetchUserInfo, noIntakeContacts, fnSendRejectRequestEmailErr, noTransaction, noPublish, fnUpdateCedar),
 		},
 	}
 
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// userSearchMinLength is the fewest characters a person search needs, so it doesn't match most of CMS
+const userSearchMinLength = 2
+
+// userSearchFilterChars are special in the LDAP filter a person search becomes
+const userSearchFilterChars = "*()\\\x00"
+
+// NewSearchUsers is a service to find people by name, for picking contacts on an intake
+func NewSearchUsers(
+	config Config,
+	authorize func(context.Context) (bool, error),
+	search func(context.Context, string) ([]*models.UserInfo, error),
+) func(context.Context, string) ([]*models.UserInfo, error) {
+	return func(ctx context.Context, commonName string) ([]*models.UserInfo, error) {
+		ok, err := authorize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &apperrors.UnauthorizedError{Err: errors.New("failed to authorize search users")}
+		}
+
+		commonName = strings.TrimSpace(commonName)
+		valErr := apperrors.NewValidationError(
+			errors.New("user search failed validation"),
+			models.UserInfo{},
+			"",
+		)
+		// the name goes into an LDAP filter, where these would widen or break the search
+		literal := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(userSearchFilterChars, r) {
+				return -1
+			}
+			return r
+		}, commonName)
+		switch {
+		case len(strings.TrimSpace(literal)) < userSearchMinLength:
+			valErr.WithValidation("commonName", "must be at least 2 characters")
+			return nil, &valErr
+		case literal != commonName:
+			valErr.WithValidation("commonName", "must not contain *, (, ) or \\")
+			return nil, &valErr
+		}
+		return search(ctx, commonName)
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+func (s ServicesTestSuite) TestSearchUsers() {
+	ctx := context.Background()
+	cfg := NewConfig(s.logger, nil)
+	authorized := func(context.Context) (bool, error) { return true, nil }
+	searched := ""
+	search := func(_ context.Context, commonName string) ([]*models.UserInfo, error) {
+		searched = commonName
+		return []*models.UserInfo{{CommonName: "Adeline Aarons", EuaUserID: "ABCD"}}, nil
+	}
+
+	s.Run("searches for the trimmed name", func() {
+		searchUsers := NewSearchUsers(cfg, authorized, search)
+
+		people, err := searchUsers(ctx, "  Adel ")
+
+		s.NoError(err)
+		s.Len(people, 1)
+		s.Equal("Adel", searched)
+	})
+
+	s.Run("needs at least two characters", func() {
+		searched = ""
+		searchUsers := NewSearchUsers(cfg, authorized, search)
+
+		_, err := searchUsers(ctx, " A ")
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Empty(searched)
+	})
+
+	s.Run("doesn't count wildcards toward the two characters", func() {
+		searched = ""
+		searchUsers := NewSearchUsers(cfg, authorized, search)
+
+		_, err := searchUsers(ctx, "**")
+
+		s.IsType(&apperrors.ValidationError{}, err)
+		s.Empty(searched)
+	})
+
+	s.Run("rejects characters that are special in LDAP filters", func() {
+		searchUsers := NewSearchUsers(cfg, authorized, search)
+
+		for _, commonName := range []string{"Ad*el", "Adel)(uid=*", `Adel\2a`, "Adel\x00"} {
+			searched = ""
+
+			_, err := searchUsers(ctx, commonName)
+
+			s.IsType(&apperrors.ValidationError{}, err, commonName)
+			s.Empty(searched)
+		}
+	})
+
+	s.Run("returns unauthorized error if authorization fails", func() {
+		unauthorized := func(context.Context) (bool, error) { return false, nil }
+		searchUsers := NewSearchUsers(cfg, unauthorized, search)
+
+		_, err := searchUsers(ctx, "Adel")
+
+		s.IsType(&apperrors.UnauthorizedError{}, err)
+	})
+
+	s.Run("returns error if the search fails", func() {
+		failSearch := func(context.Context, string) ([]*models.UserInfo, error) {
+			return nil, errors.New("CEDAR is down")
+		}
+		searchUsers := NewSearchUsers(cfg, authorized, failSearch)
+
+		_, err := searchUsers(ctx, "Adel")
+
+		s.Error(err)
+	})
+}
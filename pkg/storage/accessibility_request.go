@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -71,16 +72,43 @@ func (s *Store) FetchAccessibilityRequestByID(ctx context.Context, id uuid.UUID)
 	return &request, nil
 }
 
-// FetchAccessibilityRequests queries the DB for an accessibility requests.
-// TODO implement cursor pagination
-func (s *Store) FetchAccessibilityRequests(ctx context.Context) ([]models.AccessibilityRequest, error) {
-	requests := []models.AccessibilityRequest{}
+// accessibilityRequestSortKeys are the ways a list of accessibility requests can be sorted
+var accessibilityRequestSortKeys = map[models.SortField]sortKey{
+	models.SortFieldSUBMITTED:   timeSortKey("created_at"),
+	models.SortFieldUPDATED:     timeSortKey("updated_at"),
+	models.SortFieldPROJECTNAME: textSortKey("name"),
+}
+
+// accessibilityRequestSortValue is the value a request is ordered by for a sort
+func accessibilityRequestSortValue(request models.AccessibilityRequest, sortBy models.SortField) string {
+	switch sortBy {
+	case models.SortFieldUPDATED:
+		return timeSortValue(request.UpdatedAt)
+	case models.SortFieldPROJECTNAME:
+		return request.Name
+	default:
+		return timeSortValue(request.CreatedAt)
+	}
+}
 
-	err := s.db.Select(&requests, `SELECT * FROM accessibility_requests`)
+// FetchAccessibilityRequestsPage queries the DB for a page of accessibility requests
+func (s *Store) FetchAccessibilityRequestsPage(ctx context.Context, request models.PageRequest) (*models.AccessibilityRequestsPage, error) {
+	page, err := newKeysetPage(accessibilityRequestSortKeys, models.SortFieldSUBMITTED, "id", request, 0)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return requests, nil
+		return nil, err
+	}
+
+	result := models.AccessibilityRequestsPage{Results: []models.AccessibilityRequest{}}
+	if err = s.conn(ctx).Get(&result.TotalCount, `SELECT count(*) FROM accessibility_requests`); err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to count accessibility requests", zap.Error(err))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Operation: apperrors.QueryFetch,
 		}
+	}
+
+	pageSQL := fmt.Sprintf(`SELECT * FROM accessibility_requests WHERE %s %s %s`, page.after, page.orderBy, page.limit)
+	if err = s.conn(ctx).Select(&result.Results, pageSQL, page.args...); err != nil {
 		appcontext.ZLogger(ctx).Error("Failed to fetch accessibility requests", zap.Error(err))
 		return nil, &apperrors.QueryError{
 			Err:       err,
@@ -88,5 +116,11 @@ func (s *Store) FetchAccessibilityRequests(ctx context.Context) ([]models.Access
 		}
 	}
 
-	return requests, nil
+	cursors := []string{}
+	for _, accessibilityRequest := range result.Results {
+		cursors = append(cursors, page.cursor(accessibilityRequestSortValue(accessibilityRequest, page.request.SortBy), accessibilityRequest.ID))
+	}
+	result.Cursors, result.PageInfo = page.pageInfo(cursors)
+	result.Results = result.Results[:len(result.Cursors)]
+	return &result, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
@@ -18,6 +19,9 @@ func (s *Store) CreateAction(ctx context.Context, action *models.Action) (*model
 	action.ID = id
 	createAt := s.clock.Now()
 	action.CreatedAt = &createAt
+	if action.ContactEmails == nil {
+		action.ContactEmails = pq.StringArray{}
+	}
 	const createActionSQL = `
 		INSERT INTO actions (
 			id,
@@ -27,7 +31,17 @@ func (s *Store) CreateAction(ctx context.Context, action *models.Action) (*model
 		    actor_eua_user_id,
 			intake_id,
 			feedback,
-			created_at
+			created_at,
+			notify_requester,
+			notify_grt,
+			notify_contact_emails,
+			lcid_previous_expires_at,
+			lcid_new_expires_at,
+			lcid_previous_scope,
+			lcid_new_scope,
+			lcid_previous_next_steps,
+			lcid_new_next_steps,
+			lcid_retirement_reason
 		)
 		VALUES (
 			:id,
@@ -37,9 +51,19 @@ func (s *Store) CreateAction(ctx context.Context, action *models.Action) (*model
 			:actor_eua_user_id,
 		    :intake_id,
 			:feedback,
-		    :created_at
+		    :created_at,
+			:notify_requester,
+			:notify_grt,
+			:notify_contact_emails,
+			:lcid_previous_expires_at,
+			:lcid_new_expires_at,
+			:lcid_previous_scope,
+			:lcid_new_scope,
+			:lcid_previous_next_steps,
+			:lcid_new_next_steps,
+			:lcid_retirement_reason
 		)`
-	_, err := s.db.NamedExec(
+	_, err := s.conn(ctx).NamedExec(
 		createActionSQL,
 		action,
 	)
@@ -63,7 +87,7 @@ func (s *Store) GetActionsByRequestID(ctx context.Context, id uuid.UUID) ([]mode
 		     actions
 		WHERE actions.intake_id=$1
 	`
-	err := s.db.Select(&actions, fetchActionsByRequestIDSQL, id)
+	err := s.conn(ctx).Select(&actions, fetchActionsByRequestIDSQL, id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			"Failed to fetch actions",
@@ -78,3 +102,29 @@ func (s *Store) GetActionsByRequestID(ctx context.Context, id uuid.UUID) ([]mode
 	}
 	return actions, nil
 }
+
+// GetActionsByRequestIDs fetches the actions for many requests at once
+func (s *Store) GetActionsByRequestIDs(ctx context.Context, ids []uuid.UUID) ([]models.Action, error) {
+	actions := []models.Action{}
+	const fetchActionsByRequestIDsSQL = `
+		SELECT
+		       *
+		FROM
+		     actions
+		WHERE actions.intake_id = ANY(CAST($1 AS UUID[]))
+	`
+	err := s.conn(ctx).Select(&actions, fetchActionsByRequestIDsSQL, uuidArray(ids))
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to fetch actions",
+			zap.Int("count", len(ids)),
+			zap.String("error", err.Error()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     []models.Action{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return actions, nil
+}
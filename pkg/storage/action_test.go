@@ -71,6 +71,32 @@ func (s StoreTestSuite) TestCreateAction() {
 		_, err = s.store.CreateAction(ctx, &action)
 		s.Equal("pq: invalid input value for enum action_type: \"fake_status\"", err.Error())
 	})
+	s.Run("records the chosen email recipients", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		action := models.Action{
+			IntakeID:       &intake.ID,
+			ActionType:     models.ActionTypeREJECT,
+			ActorName:      "name",
+			ActorEmail:     "email@site.com",
+			ActorEUAUserID: testhelpers.RandomEUAID(),
+			EmailRecipients: models.EmailRecipients{
+				NotifyGRT:     true,
+				ContactEmails: []string{"owner@site.com"},
+			},
+		}
+		_, err = s.store.CreateAction(ctx, &action)
+		s.NoError(err)
+
+		actions, err := s.store.GetActionsByRequestID(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(actions, 1)
+		s.False(actions[0].NotifyRequester)
+		s.True(actions[0].NotifyGRT)
+		s.Equal([]string{"owner@site.com"}, []string(actions[0].ContactEmails))
+	})
 }
 
 func (s StoreTestSuite) TestFetchActionsByRequestID() {
@@ -114,3 +140,38 @@ func (s StoreTestSuite) TestFetchActionsByRequestID() {
 		s.Equal(&intake.ID, fetched[0].IntakeID)
 	})
 }
+
+func (s StoreTestSuite) TestFetchActionsByRequestIDs() {
+	ctx := context.Background()
+
+	intakeIDs := []uuid.UUID{}
+	for ix := 0; ix < 2; ix++ {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		intakeIDs = append(intakeIDs, intake.ID)
+
+		action := testhelpers.NewAction()
+		action.IntakeID = &intake.ID
+		_, err = s.store.CreateAction(ctx, &action)
+		s.NoError(err)
+	}
+
+	s.Run("fetches the actions of every request in one query", func() {
+		fetched, err := s.store.GetActionsByRequestIDs(ctx, intakeIDs)
+		s.NoError(err)
+		s.Len(fetched, 2)
+
+		fetchedIntakeIDs := []uuid.UUID{}
+		for _, action := range fetched {
+			fetchedIntakeIDs = append(fetchedIntakeIDs, *action.IntakeID)
+		}
+		s.ElementsMatch(intakeIDs, fetchedIntakeIDs)
+	})
+
+	s.Run("fetches nothing for no requests", func() {
+		fetched, err := s.store.GetActionsByRequestIDs(ctx, []uuid.UUID{})
+		s.NoError(err)
+		s.Empty(fetched)
+	})
+}
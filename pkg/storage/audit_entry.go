@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CreateAuditEntry records an update to a system intake or business case.
+// Audit entries can't be changed once they're created.
+func (s *Store) CreateAuditEntry(ctx context.Context, entry *models.AuditEntry) (*models.AuditEntry, error) {
+	const createAuditEntrySQL = `
+		INSERT INTO audit_entries (
+			id,
+			entity,
+			entity_id,
+			eua_user_id,
+			trace_id,
+			created_at,
+			changes
+		)
+		VALUES (
+			:id,
+			:entity,
+			:entity_id,
+			:eua_user_id,
+			:trace_id,
+			:created_at,
+			:changes
+		)`
+	entry.ID = uuid.New()
+	createdAt := s.clock.Now()
+	entry.CreatedAt = &createdAt
+	_, err := s.conn(ctx).NamedExec(createAuditEntrySQL, entry)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to create audit entry with error %s", err),
+			zap.String("entity", string(entry.Entity)),
+			zap.String("entityID", entry.EntityID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     entry,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return entry, nil
+}
+
+// FetchAuditEntries returns the history of a system intake or business case, oldest first
+func (s *Store) FetchAuditEntries(ctx context.Context, entity models.AuditEntity, entityID uuid.UUID) ([]models.AuditEntry, error) {
+	const fetchAuditEntriesSQL = `
+		SELECT *
+		FROM audit_entries
+		WHERE entity = $1 AND entity_id = $2
+		ORDER BY created_at, id`
+	entries := []models.AuditEntry{}
+	err := s.conn(ctx).Select(&entries, fetchAuditEntriesSQL, entity, entityID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch audit entries with error %s", err),
+			zap.String("entity", string(entity)),
+			zap.String("entityID", entityID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     entity,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return entries, nil
+}
+
+// auditIgnoredFields change on every update, so they aren't worth recording
+var auditIgnoredFields = map[string]bool{
+	"updatedAt": true,
+	"version":   true,
+}
+
+// auditChanges returns the fields worth recording that differ between two versions of a record
+func auditChanges(before interface{}, after interface{}) (models.AuditChanges, error) {
+	changes, err := models.NewAuditChanges(before, after)
+	if err != nil {
+		return nil, err
+	}
+	for name := range auditIgnoredFields {
+		delete(changes, name)
+	}
+	return changes, nil
+}
+
+// auditableBusinessCase returns a copy of a business case whose cost lines compare by their values.
+// The lines are recreated on every update, so their IDs and order would otherwise always change.
+func auditableBusinessCase(businessCase models.BusinessCase) models.BusinessCase {
+	lines := models.EstimatedLifecycleCosts{}
+	for _, line := range businessCase.LifecycleCostLines {
+		// a business case without cost lines fetches as a single empty line
+		if line.ID == uuid.Nil {
+			continue
+		}
+		line.ID = uuid.Nil
+		lines = append(lines, line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return costLineKey(lines[i]) < costLineKey(lines[j])
+	})
+	businessCase.LifecycleCostLines = lines
+	return businessCase
+}
+
+func costLineKey(line models.EstimatedLifecycleCost) string {
+	phase := ""
+	if line.Phase != nil {
+		phase = string(*line.Phase)
+	}
+	return string(line.Solution) + "|" + phase + "|" + string(line.Year)
+}
+
+// recordChanges creates an audit entry for the fields an update changed,
+// attributed to the principal and trace on the context.
+// An update that changed nothing isn't recorded.
+func (s *Store) recordChanges(ctx context.Context, entity models.AuditEntity, entityID uuid.UUID, before interface{}, after interface{}) error {
+	changes, err := auditChanges(before, after)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	entry := &models.AuditEntry{
+		Entity:    entity,
+		EntityID:  entityID,
+		EUAUserID: appcontext.Principal(ctx).ID(),
+		Changes:   changes,
+	}
+	if traceID, ok := appcontext.Trace(ctx); ok {
+		entry.TraceID = &traceID
+	}
+	_, err = s.CreateAuditEntry(ctx, entry)
+	return err
+}
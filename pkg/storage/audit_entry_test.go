@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestAuditChanges() {
+	s.Run("records only the fields that changed", func() {
+		before := models.SystemIntake{ProjectName: null.StringFrom("Old name"), Requester: "Same"}
+		after := models.SystemIntake{ProjectName: null.StringFrom("New name"), Requester: "Same"}
+
+		changes, err := auditChanges(before, after)
+		s.NoError(err)
+
+		s.Equal(models.AuditChanges{
+			"projectName": {Old: json.RawMessage(`"Old name"`), New: json.RawMessage(`"New name"`)},
+		}, changes)
+	})
+
+	s.Run("ignores the update time", func() {
+		now := s.store.clock.Now()
+		after := models.SystemIntake{UpdatedAt: &now}
+
+		changes, err := auditChanges(models.SystemIntake{}, after)
+		s.NoError(err)
+		s.Empty(changes)
+	})
+
+	s.Run("compares business case cost lines by their values", func() {
+		phase := models.LifecycleCostPhaseDEVELOPMENT
+		cost := 100
+		lineA := models.EstimatedLifecycleCost{ID: uuid.New(), Solution: models.LifecycleCostSolutionA, Phase: &phase, Year: models.LifecycleCostYear1, Cost: &cost}
+		lineB := models.EstimatedLifecycleCost{ID: uuid.New(), Solution: models.LifecycleCostSolutionB, Phase: &phase, Year: models.LifecycleCostYear1, Cost: &cost}
+		before := models.BusinessCase{LifecycleCostLines: models.EstimatedLifecycleCosts{lineA, lineB}}
+
+		// the lines are recreated, with new IDs and in another order
+		lineA.ID, lineB.ID = uuid.New(), uuid.New()
+		after := models.BusinessCase{LifecycleCostLines: models.EstimatedLifecycleCosts{lineB, lineA}}
+
+		changes, err := auditChanges(auditableBusinessCase(before), auditableBusinessCase(after))
+		s.NoError(err)
+		s.Empty(changes)
+	})
+}
+
+func (s StoreTestSuite) TestAuditEntries() {
+	ctx := appcontext.WithPrincipal(context.Background(), testhelpers.NewReviewerPrincipal())
+	ctx, traceID := appcontext.WithTrace(ctx)
+
+	intake := testhelpers.NewSystemIntake()
+	created, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+
+	s.Run("records who changed an intake's fields", func() {
+		created.ProjectName = null.StringFrom("Audited name")
+		_, err := s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+
+		entries, err := s.store.FetchAuditEntries(ctx, models.AuditEntitySystemIntake, created.ID)
+		s.NoError(err)
+		s.Len(entries, 1)
+		s.Equal("REV", entries[0].EUAUserID)
+		s.Equal(&traceID, entries[0].TraceID)
+		s.Contains(entries[0].Changes, "projectName")
+		s.Equal(json.RawMessage(`"Audited name"`), entries[0].Changes["projectName"].New)
+	})
+
+	s.Run("doesn't record an update that changes nothing", func() {
+		unchanged, err := s.store.FetchSystemIntakeByID(ctx, created.ID)
+		s.NoError(err)
+		_, err = s.store.UpdateSystemIntake(ctx, unchanged)
+		s.NoError(err)
+
+		entries, err := s.store.FetchAuditEntries(ctx, models.AuditEntitySystemIntake, created.ID)
+		s.NoError(err)
+		s.Len(entries, 1)
+	})
+
+	s.Run("records changes to a business case but not its recreated cost lines", func() {
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.EUAUserID = created.EUAUserID.ValueOrZero()
+		businessCase.SystemIntakeID = created.ID
+		createdBusinessCase, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+
+		createdBusinessCase.BusinessNeed = null.StringFrom("An audited need")
+		_, err = s.store.UpdateBusinessCase(ctx, createdBusinessCase)
+		s.NoError(err)
+
+		entries, err := s.store.FetchAuditEntries(ctx, models.AuditEntityBusinessCase, createdBusinessCase.ID)
+		s.NoError(err)
+		s.Len(entries, 1)
+		s.Contains(entries[0].Changes, "businessNeed")
+		s.NotContains(entries[0].Changes, "lifecycleCostLines")
+	})
+
+	s.Run("audit entries can't be changed", func() {
+		_, err := s.db.Exec("UPDATE audit_entries SET eua_user_id = 'ABCD' WHERE entity_id = $1", created.ID)
+		s.Error(err)
+
+		_, err = s.db.Exec("DELETE FROM audit_entries WHERE entity_id = $1", created.ID)
+		s.Error(err)
+	})
+}
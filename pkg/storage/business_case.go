@@ -7,7 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
-	"github.com/jmoiron/sqlx"
+	"github.com/guregu/null"
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
@@ -43,7 +43,7 @@ func (s *Store) FetchBusinessCaseByID(ctx context.Context, id uuid.UUID) (*model
 			business_cases.id = $1
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id, system_intakes.id`
 
-	err := s.db.Get(&businessCase, fetchBusinessCaseSQL, id)
+	err := s.conn(ctx).Get(&businessCase, fetchBusinessCaseSQL, id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch business case %s", err),
@@ -57,6 +57,57 @@ func (s *Store) FetchBusinessCaseByID(ctx context.Context, id uuid.UUID) (*model
 	return &businessCase, nil
 }
 
+// LockBusinessCase fetches a business case and locks it until the transaction on the context ends,
+// so only one caller at a time acts on it
+func (s *Store) LockBusinessCase(ctx context.Context, id uuid.UUID) (*models.BusinessCase, error) {
+	// the fetch aggregates the cost lines, which can't be locked, so the row is locked on its own first
+	const lockBusinessCaseSQL = `SELECT id FROM business_cases WHERE id = $1 FOR UPDATE`
+	var lockedID uuid.UUID
+	err := s.conn(ctx).Get(&lockedID, lockBusinessCaseSQL, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to lock business case %s", err),
+			zap.String("id", id.String()),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.BusinessCase{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return s.FetchBusinessCaseByID(ctx, id)
+}
+
+// FetchBusinessCasesByIDs queries the DB for the business cases matching any of the given IDs
+func (s *Store) FetchBusinessCasesByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.BusinessCase, error) {
+	businessCases := []*models.BusinessCase{}
+	const fetchBusinessCasesSQL = `
+		SELECT
+			business_cases.*,
+			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines,
+			system_intakes.status as system_intake_status
+		FROM
+			business_cases
+			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
+			JOIN system_intakes ON business_cases.system_intake = system_intakes.id
+		WHERE
+			business_cases.id = ANY(CAST($1 AS UUID[]))
+		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id, system_intakes.id`
+
+	err := s.conn(ctx).Select(&businessCases, fetchBusinessCasesSQL, uuidArray(ids))
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch business cases %s", err),
+			zap.Int("count", len(ids)),
+		)
+		return nil, err
+	}
+	return businessCases, nil
+}
+
 // FetchOpenBusinessCaseByIntakeID queries the DB for an open business case matching the given intake ID
 func (s *Store) FetchOpenBusinessCaseByIntakeID(ctx context.Context, intakeID uuid.UUID) (*models.BusinessCase, error) {
 	businessCase := models.BusinessCase{}
@@ -70,7 +121,7 @@ func (s *Store) FetchOpenBusinessCaseByIntakeID(ctx context.Context, intakeID uu
 		WHERE
 			business_cases.system_intake = $1 AND business_cases.status = 'OPEN'
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id`
-	err := s.db.Get(&businessCase, fetchBusinessCaseSQL, intakeID)
+	err := s.conn(ctx).Get(&businessCase, fetchBusinessCaseSQL, intakeID)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch business case %s", err),
@@ -98,7 +149,7 @@ func (s *Store) FetchBusinessCasesByEuaID(ctx context.Context, euaID string) (mo
 			business_cases.eua_user_id = $1
 		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id`
 
-	err := s.db.Select(&businessCases, fetchBusinessCaseSQL, euaID)
+	err := s.conn(ctx).Select(&businessCases, fetchBusinessCaseSQL, euaID)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch business cases %s", err),
@@ -109,7 +160,70 @@ func (s *Store) FetchBusinessCasesByEuaID(ctx context.Context, euaID string) (mo
 	return businessCases, nil
 }
 
-func createEstimatedLifecycleCosts(ctx context.Context, tx *sqlx.Tx, businessCase *models.BusinessCase) error {
+// businessCaseSortKeys are the ways a list of business cases can be sorted
+var businessCaseSortKeys = map[models.SortField]sortKey{
+	models.SortFieldSUBMITTED:   timeSortKey("business_cases.submitted_at"),
+	models.SortFieldUPDATED:     timeSortKey("business_cases.updated_at"),
+	models.SortFieldPROJECTNAME: textSortKey("business_cases.project_name"),
+	models.SortFieldSTATUS:      textSortKey("business_cases.status"),
+}
+
+// businessCaseSortValue is the value a business case is ordered by for a sort
+func businessCaseSortValue(businessCase models.BusinessCase, sortBy models.SortField) string {
+	switch sortBy {
+	case models.SortFieldUPDATED:
+		return timeSortValue(businessCase.UpdatedAt)
+	case models.SortFieldPROJECTNAME:
+		return businessCase.ProjectName.String
+	case models.SortFieldSTATUS:
+		return string(businessCase.Status)
+	default:
+		return timeSortValue(businessCase.SubmittedAt)
+	}
+}
+
+// FetchBusinessCasesPageByEuaID queries the DB for a page of the business cases matching the given EUA ID
+func (s *Store) FetchBusinessCasesPageByEuaID(ctx context.Context, euaID string, request models.PageRequest) (*models.BusinessCasesPage, error) {
+	page, err := newKeysetPage(businessCaseSortKeys, models.SortFieldSUBMITTED, "business_cases.id", request, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.BusinessCasesPage{Results: []models.BusinessCase{}}
+	const countSQL = `SELECT count(*) FROM business_cases WHERE eua_user_id = $1`
+	if err = s.conn(ctx).Get(&result.TotalCount, countSQL, euaID); err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to count business cases %s", err), zap.String("euaID", euaID))
+		return nil, err
+	}
+
+	pageSQL := fmt.Sprintf(`
+		SELECT
+			business_cases.*,
+			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines
+		FROM
+			business_cases
+			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
+		WHERE
+			business_cases.eua_user_id = $1 AND %s
+		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id
+		%s
+		%s`, page.after, page.orderBy, page.limit)
+	err = s.conn(ctx).Select(&result.Results, pageSQL, append([]interface{}{euaID}, page.args...)...)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch business cases page %s", err), zap.String("euaID", euaID))
+		return nil, err
+	}
+
+	cursors := []string{}
+	for _, businessCase := range result.Results {
+		cursors = append(cursors, page.cursor(businessCaseSortValue(businessCase, page.request.SortBy), businessCase.ID))
+	}
+	result.Cursors, result.PageInfo = page.pageInfo(cursors)
+	result.Results = result.Results[:len(result.Cursors)]
+	return &result, nil
+}
+
+func createEstimatedLifecycleCosts(ctx context.Context, tx executor, businessCase *models.BusinessCase) error {
 	const createEstimatedLifecycleCostSQL = `
 		INSERT INTO estimated_lifecycle_costs (
 			id,
@@ -269,16 +383,28 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 		    :updated_at
 		)`
 	logger := appcontext.ZLogger(ctx)
-	tx := s.db.MustBegin()
-	//Rollback only happens if transaction isn't committed
-	defer tx.Rollback()
-	_, err := tx.NamedExec(createBusinessCaseSQL, &businessCase)
+	err := s.WithTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.conn(ctx).NamedExec(createBusinessCaseSQL, &businessCase)
+		if err != nil {
+			logger.Error(
+				fmt.Sprintf("Failed to create business case with error %s", err),
+				zap.String("EUAUserID", businessCase.EUAUserID),
+				zap.String("SystemIntakeID", businessCase.SystemIntakeID.String()),
+			)
+			return err
+		}
+		err = createEstimatedLifecycleCosts(ctx, s.conn(ctx), businessCase)
+		if err != nil {
+			logger.Error(
+				fmt.Sprintf("Failed to create business case with lifecycle costs with error %s", err),
+				zap.String("EUAUserID", businessCase.EUAUserID),
+				zap.String("BusinessCaseID", businessCase.ID.String()),
+			)
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to create business case with error %s", err),
-			zap.String("EUAUserID", businessCase.EUAUserID),
-			zap.String("SystemIntakeID", businessCase.SystemIntakeID.String()),
-		)
 		if err.Error() == "pq: duplicate key value violates unique constraint \"unique_intake_per_biz_case\"" {
 			return nil,
 				&apperrors.ResourceConflictError{
@@ -293,37 +419,15 @@ func (s *Store) CreateBusinessCase(ctx context.Context, businessCase *models.Bus
 			Operation: apperrors.QueryPost,
 		}
 	}
-	err = createEstimatedLifecycleCosts(ctx, tx, businessCase)
-	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to create business case with lifecycle costs with error %s", err),
-			zap.String("EUAUserID", businessCase.EUAUserID),
-			zap.String("BusinessCaseID", businessCase.ID.String()),
-		)
-		return nil, &apperrors.QueryError{
-			Err:       err,
-			Model:     businessCase,
-			Operation: apperrors.QueryPost,
-		}
-	}
-	err = tx.Commit()
-	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to create business case %s", err),
-			zap.String("EUAUserID", businessCase.EUAUserID),
-			zap.String("SystemIntakeID", businessCase.SystemIntakeID.String()),
-		)
-		return nil, &apperrors.QueryError{
-			Err:       err,
-			Model:     businessCase,
-			Operation: apperrors.QueryPost,
-		}
-	}
 
+	// the row starts at the version column's default, which the client sends back when it updates
+	businessCase.Version = 1
 	return businessCase, nil
 }
 
-// UpdateBusinessCase creates a business case
+// UpdateBusinessCase updates a business case and recreates its cost lines.
+// A business case with a version is only updated if it's still at that version.
+// Its CEDAR ID is kept unless the update sets one.
 func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.BusinessCase) (*models.BusinessCase, error) {
 	// We are explicitly not updating ID, EUAUserID and SystemIntakeID
 	const updateBusinessCaseSQL = `
@@ -382,8 +486,10 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 		  archived_at = :archived_at,
 		  status = :status,
 			initial_submitted_at = :initial_submitted_at,
-		  last_submitted_at = :last_submitted_at
-		WHERE business_cases.id = :id
+		  last_submitted_at = :last_submitted_at,
+			cedar_id = COALESCE(:cedar_id, cedar_id),
+			version = version + 1
+		WHERE business_cases.id = :id AND (:version = 0 OR version = :version)
 	`
 	const deleteLifecycleCostsSQL = `
 		DELETE FROM estimated_lifecycle_costs
@@ -391,49 +497,111 @@ func (s *Store) UpdateBusinessCase(ctx context.Context, businessCase *models.Bus
 	`
 
 	logger := appcontext.ZLogger(ctx)
-	tx := s.db.MustBegin()
-	//Rollback only happens if transaction isn't committed
-	defer tx.Rollback()
-	result, err := tx.NamedExec(updateBusinessCaseSQL, &businessCase)
-	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to update business case %s", err),
-			zap.String("id", businessCase.ID.String()),
-		)
-		return businessCase, err
-	}
-	affectedRows, rowsAffectedErr := result.RowsAffected()
-	if affectedRows == 0 || rowsAffectedErr != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to update business case %s", err),
-			zap.String("id", businessCase.ID.String()),
-		)
-		return businessCase, errors.New("business case not found")
-	}
+	err := s.WithTransaction(ctx, func(ctx context.Context) error {
+		// an overlapping update waits here, so each update's changes are diffed against the one before it
+		before, err := s.LockBusinessCase(ctx, businessCase.ID)
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.NamedExec(deleteLifecycleCostsSQL, &businessCase)
-	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to update pre-existing business case costs %s", err),
-			zap.String("id", businessCase.ID.String()),
+		result, err := s.conn(ctx).NamedExec(updateBusinessCaseSQL, &businessCase)
+		if err != nil {
+			logger.Error(
+				fmt.Sprintf("Failed to update business case %s", err),
+				zap.String("id", businessCase.ID.String()),
+			)
+			return err
+		}
+		affectedRows, rowsAffectedErr := result.RowsAffected()
+		if affectedRows == 0 || rowsAffectedErr != nil {
+			logger.Error(
+				fmt.Sprintf("Failed to update business case %s", err),
+				zap.String("id", businessCase.ID.String()),
+			)
+			return &apperrors.ResourceConflictError{
+				Err:        fmt.Errorf("business case is no longer at version %d", businessCase.Version),
+				Resource:   businessCase,
+				ResourceID: businessCase.ID.String(),
+			}
+		}
+
+		_, err = s.conn(ctx).NamedExec(deleteLifecycleCostsSQL, &businessCase)
+		if err != nil {
+			logger.Error(
+				fmt.Sprintf("Failed to update pre-existing business case costs %s", err),
+				zap.String("id", businessCase.ID.String()),
+			)
+			return err
+		}
+
+		if err = createEstimatedLifecycleCosts(ctx, s.conn(ctx), businessCase); err != nil {
+			return err
+		}
+
+		after, err := s.FetchBusinessCaseByID(ctx, businessCase.ID)
+		if err != nil {
+			return err
+		}
+		businessCase.Version = after.Version
+		return s.recordChanges(
+			ctx,
+			models.AuditEntityBusinessCase,
+			businessCase.ID,
+			auditableBusinessCase(*before),
+			auditableBusinessCase(*after),
 		)
+	})
+	if err != nil {
 		return businessCase, err
 	}
 
-	err = createEstimatedLifecycleCosts(ctx, tx, businessCase)
+	// createEstimatedLifecycleCostSQL
+	return businessCase, nil
+}
+
+// FetchBusinessCasesAwaitingCedar queries the DB for the business cases submitted in EASi
+// that don't have a CEDAR ID yet, leaving out those whose intake is archived
+func (s *Store) FetchBusinessCasesAwaitingCedar(ctx context.Context) (models.BusinessCases, error) {
+	businessCases := []models.BusinessCase{}
+	const fetchBusinessCasesSQL = `
+		SELECT
+			business_cases.*,
+			json_agg(estimated_lifecycle_costs) as lifecycle_cost_lines,
+			system_intakes.status as system_intake_status
+		FROM
+			business_cases
+			LEFT JOIN estimated_lifecycle_costs ON business_cases.id = estimated_lifecycle_costs.business_case
+			JOIN system_intakes ON business_cases.system_intake = system_intakes.id
+		WHERE
+			business_cases.initial_submitted_at IS NOT NULL
+			AND (business_cases.cedar_id IS NULL OR business_cases.cedar_id = '')
+			AND system_intakes.archived_at IS NULL
+		GROUP BY estimated_lifecycle_costs.business_case, business_cases.id, system_intakes.id
+		ORDER BY business_cases.initial_submitted_at, business_cases.id`
+
+	err := s.conn(ctx).Select(&businessCases, fetchBusinessCasesSQL)
 	if err != nil {
-		return businessCase, err
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch business cases awaiting CEDAR %s", err))
+		return models.BusinessCases{}, err
 	}
+	return businessCases, nil
+}
 
-	err = tx.Commit()
+// UpdateBusinessCaseCedarID saves the ID CEDAR gave a business case onto its latest version,
+// so it isn't lost to a conflict with changes made since the business case was submitted
+func (s *Store) UpdateBusinessCaseCedarID(ctx context.Context, id uuid.UUID, cedarID string) (*models.BusinessCase, error) {
+	var updated *models.BusinessCase
+	err := s.WithTransaction(ctx, func(ctx context.Context) error {
+		businessCase, err := s.LockBusinessCase(ctx, id)
+		if err != nil {
+			return err
+		}
+		businessCase.CedarID = null.StringFrom(cedarID)
+		updated, err = s.UpdateBusinessCase(ctx, businessCase)
+		return err
+	})
 	if err != nil {
-		logger.Error(
-			fmt.Sprintf("Failed to update business case %s", err),
-			zap.String("id", businessCase.ID.String()),
-		)
-		return businessCase, err
+		return nil, err
 	}
-
-	// createEstimatedLifecycleCostSQL
-	return businessCase, nil
+	return updated, nil
 }
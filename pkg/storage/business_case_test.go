@@ -2,10 +2,13 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 
+	"github.com/cmsgov/easi-app/pkg/appcontext"
 	"github.com/cmsgov/easi-app/pkg/apperrors"
 	"github.com/cmsgov/easi-app/pkg/models"
 	"github.com/cmsgov/easi-app/pkg/testhelpers"
@@ -58,6 +61,35 @@ func (s StoreTestSuite) TestFetchBusinessCaseByID() {
 	})
 }
 
+func (s StoreTestSuite) TestFetchBusinessCasesByIDs() {
+	ctx := context.Background()
+
+	ids := []uuid.UUID{}
+	for ix := 0; ix < 2; ix++ {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		ids = append(ids, created.ID)
+	}
+
+	s.Run("fetches the business cases with their lifecycle costs", func() {
+		fetched, err := s.store.FetchBusinessCasesByIDs(ctx, append(ids, uuid.New()))
+		s.NoError(err)
+		s.Len(fetched, 2)
+
+		fetchedIDs := []uuid.UUID{}
+		for _, businessCase := range fetched {
+			fetchedIDs = append(fetchedIDs, businessCase.ID)
+			s.Len(businessCase.LifecycleCostLines, 2)
+		}
+		s.ElementsMatch(ids, fetchedIDs)
+	})
+}
+
 func (s StoreTestSuite) TestFetchBusinessCasesByEuaID() {
 	ctx := context.Background()
 
@@ -124,6 +156,7 @@ func (s StoreTestSuite) TestCreateBusinessCase() {
 		s.NoError(err, "failed to create a business case")
 		s.NotNil(created.ID)
 		s.Equal(businessCase.EUAUserID, created.EUAUserID)
+		s.Equal(1, created.Version)
 		s.Len(created.LifecycleCostLines, 1)
 	})
 
@@ -233,6 +266,28 @@ func (s StoreTestSuite) TestUpdateBusinessCase() {
 		s.Equal(3, len(updated.LifecycleCostLines))
 	})
 
+	s.Run("keeps the CEDAR ID unless an update sets one", func() {
+		submitted := models.BusinessCase{
+			ID:      id,
+			Status:  models.BusinessCaseStatusOPEN,
+			CedarID: null.StringFrom("CEDAR-1"),
+		}
+		_, err := s.store.UpdateBusinessCase(ctx, &submitted)
+		s.NoError(err)
+
+		edited := models.BusinessCase{
+			ID:          id,
+			Status:      models.BusinessCaseStatusOPEN,
+			ProjectName: null.StringFrom("Edited after submission"),
+		}
+		_, err = s.store.UpdateBusinessCase(ctx, &edited)
+		s.NoError(err)
+
+		updated, err := s.store.FetchBusinessCaseByID(ctx, id)
+		s.NoError(err)
+		s.Equal(null.StringFrom("CEDAR-1"), updated.CedarID)
+	})
+
 	s.Run("lifecycle costs are recreated", func() {
 		businessCaseToUpdate := models.BusinessCase{
 			ID:     id,
@@ -287,6 +342,21 @@ func (s StoreTestSuite) TestUpdateBusinessCase() {
 		s.Equal(euaID, updated.EUAUserID)
 	})
 
+	s.Run("refuses to update a business case that's changed since it was fetched", func() {
+		fetched, err := s.store.FetchBusinessCaseByID(ctx, id)
+		s.NoError(err)
+		stale := *fetched
+
+		fetched.ProjectName = null.StringFrom("First name")
+		updated, err := s.store.UpdateBusinessCase(ctx, fetched)
+		s.NoError(err)
+		s.Equal(stale.Version+1, updated.Version)
+
+		stale.ProjectName = null.StringFrom("Second name")
+		_, err = s.store.UpdateBusinessCase(ctx, &stale)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
 	s.Run("fails if the business case ID doesn't exist", func() {
 		badUUID := uuid.New()
 		businessCaseToUpdate := models.BusinessCase{
@@ -295,7 +365,130 @@ func (s StoreTestSuite) TestUpdateBusinessCase() {
 			LifecycleCostLines: models.EstimatedLifecycleCosts{},
 		}
 		_, err := s.store.UpdateBusinessCase(ctx, &businessCaseToUpdate)
-		s.Error(err)
-		s.Equal("business case not found", err.Error())
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
+
+func (s StoreTestSuite) TestUpdateBusinessCaseConcurrently() {
+	ctx := context.Background()
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.EUAUserID = intake.EUAUserID.ValueOrZero()
+	businessCase.SystemIntakeID = intake.ID
+	created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+
+	// neither update says which version it read, like a PUT without If-Match
+	edit := func() *models.BusinessCase {
+		fetched, fetchErr := s.store.FetchBusinessCaseByID(ctx, created.ID)
+		s.NoError(fetchErr)
+		fetched.Version = 0
+		return fetched
+	}
+	first, second := edit(), edit()
+	first.ProjectName = null.StringFrom("First update")
+	second.RequesterPhoneNumber = null.StringFrom("5555555555")
+
+	secondDone := make(chan error)
+	firstCtx := appcontext.WithPrincipal(ctx, testhelpers.NewReviewerPrincipal())
+	err = s.store.WithTransaction(firstCtx, func(ctx context.Context) error {
+		_, updateErr := s.store.UpdateBusinessCase(ctx, first)
+		go func() {
+			secondCtx := appcontext.WithPrincipal(context.Background(), testhelpers.NewRequesterPrincipal())
+			_, err := s.store.UpdateBusinessCase(secondCtx, second)
+			secondDone <- err
+		}()
+		// let the second update reach the business case while the first still holds it
+		time.Sleep(100 * time.Millisecond)
+		return updateErr
+	})
+	s.NoError(err)
+	s.NoError(<-secondDone)
+
+	entries, err := s.store.FetchAuditEntries(ctx, models.AuditEntityBusinessCase, created.ID)
+	s.NoError(err)
+	var secondEntry *models.AuditEntry
+	for ix := range entries {
+		if entries[ix].EUAUserID == "REQ" {
+			secondEntry = &entries[ix]
+		}
+	}
+	s.Require().NotNil(secondEntry)
+	// the second update wrote back the name it read, undoing the first update's, and its entry says so
+	s.Contains(secondEntry.Changes, "requesterPhoneNumber")
+	s.Contains(secondEntry.Changes, "projectName")
+	s.Equal(json.RawMessage(`"First update"`), secondEntry.Changes["projectName"].Old)
+}
+
+func (s StoreTestSuite) TestFetchBusinessCasesAwaitingCedar() {
+	s.Run("fetches only submitted business cases without a CEDAR ID", func() {
+		ctx := context.Background()
+		submittedAt := time.Now()
+
+		create := func(submitted bool, cedarID null.String) uuid.UUID {
+			intake := testhelpers.NewSystemIntake()
+			_, err := s.store.CreateSystemIntake(ctx, &intake)
+			s.NoError(err)
+			businessCase := testhelpers.NewBusinessCase()
+			businessCase.SystemIntakeID = intake.ID
+			created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+			s.NoError(err)
+			if submitted {
+				created.InitialSubmittedAt = &submittedAt
+			}
+			created.CedarID = cedarID
+			_, err = s.store.UpdateBusinessCase(ctx, created)
+			s.NoError(err)
+			return created.ID
+		}
+		awaiting := create(true, null.String{})
+		sent := create(true, null.StringFrom("000-000-0"))
+		draft := create(false, null.String{})
+
+		businessCases, err := s.store.FetchBusinessCasesAwaitingCedar(ctx)
+		s.NoError(err)
+
+		ids := map[uuid.UUID]bool{}
+		for _, businessCase := range businessCases {
+			ids[businessCase.ID] = true
+		}
+		s.True(ids[awaiting])
+		s.False(ids[sent])
+		s.False(ids[draft])
+	})
+}
+
+func (s StoreTestSuite) TestUpdateBusinessCaseCedarID() {
+	s.Run("saves the CEDAR ID onto the latest version of the business case", func() {
+		ctx := context.Background()
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		businessCase := testhelpers.NewBusinessCase()
+		businessCase.SystemIntakeID = intake.ID
+		created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+		s.NoError(err)
+		submitted := *created
+
+		// someone else changes the business case after it was submitted
+		created.ProjectName = null.StringFrom("Renamed")
+		_, err = s.store.UpdateBusinessCase(ctx, created)
+		s.NoError(err)
+
+		updated, err := s.store.UpdateBusinessCaseCedarID(ctx, submitted.ID, "000-000-1")
+
+		s.NoError(err)
+		s.Equal("000-000-1", updated.CedarID.String)
+		s.Equal("Renamed", updated.ProjectName.String)
+	})
+
+	s.Run("returns not found for a missing business case", func() {
+		ctx := context.Background()
+
+		_, err := s.store.UpdateBusinessCaseCedarID(ctx, uuid.New(), "000-000-1")
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
 	})
 }
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CreateBusinessCaseVersion stores a submitted business case as its next version.
+// Versions can't be changed once they're created.
+func (s *Store) CreateBusinessCaseVersion(ctx context.Context, version *models.BusinessCaseVersion) (*models.BusinessCaseVersion, error) {
+	const nextNumberSQL = `
+		SELECT COALESCE(MAX(number), 0) + 1
+		FROM business_case_versions
+		WHERE business_case_id = $1`
+	const createBusinessCaseVersionSQL = `
+		INSERT INTO business_case_versions (
+			id,
+			business_case_id,
+			number,
+			intake_status,
+			eua_user_id,
+			created_at,
+			business_case
+		)
+		VALUES (
+			:id,
+			:business_case_id,
+			:number,
+			:intake_status,
+			:eua_user_id,
+			:created_at,
+			:business_case
+		)`
+	version.ID = uuid.New()
+	createdAt := s.clock.Now()
+	version.CreatedAt = &createdAt
+
+	// a business case without cost lines fetches as a single empty line
+	lines := models.EstimatedLifecycleCosts{}
+	for _, line := range version.BusinessCase.LifecycleCostLines {
+		if line.ID != uuid.Nil {
+			lines = append(lines, line)
+		}
+	}
+	version.BusinessCase.LifecycleCostLines = lines
+
+	err := s.conn(ctx).Get(&version.Number, nextNumberSQL, version.BusinessCaseID)
+	if err == nil {
+		_, err = s.conn(ctx).NamedExec(createBusinessCaseVersionSQL, version)
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to create business case version with error %s", err),
+			zap.String("businessCaseID", version.BusinessCaseID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     version,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return version, nil
+}
+
+// FetchBusinessCaseVersions returns every submitted version of a business case, oldest first
+func (s *Store) FetchBusinessCaseVersions(ctx context.Context, businessCaseID uuid.UUID) ([]models.BusinessCaseVersion, error) {
+	const fetchBusinessCaseVersionsSQL = `
+		SELECT *
+		FROM business_case_versions
+		WHERE business_case_id = $1
+		ORDER BY number`
+	versions := []models.BusinessCaseVersion{}
+	err := s.conn(ctx).Select(&versions, fetchBusinessCaseVersionsSQL, businessCaseID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch business case versions with error %s", err),
+			zap.String("businessCaseID", businessCaseID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.BusinessCaseVersion{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return versions, nil
+}
+
+// FetchBusinessCaseVersion returns one submitted version of a business case
+func (s *Store) FetchBusinessCaseVersion(ctx context.Context, businessCaseID uuid.UUID, number int) (*models.BusinessCaseVersion, error) {
+	const fetchBusinessCaseVersionSQL = `
+		SELECT *
+		FROM business_case_versions
+		WHERE business_case_id = $1 AND number = $2`
+	version := models.BusinessCaseVersion{}
+	err := s.conn(ctx).Get(&version, fetchBusinessCaseVersionSQL, businessCaseID, number)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch business case version with error %s", err),
+			zap.String("businessCaseID", businessCaseID.String()),
+			zap.Int("number", number),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.BusinessCaseVersion{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.BusinessCaseVersion{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &version, nil
+}
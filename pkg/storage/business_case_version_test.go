@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestBusinessCaseVersions() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	businessCase := testhelpers.NewBusinessCase()
+	businessCase.EUAUserID = intake.EUAUserID.ValueOrZero()
+	businessCase.SystemIntakeID = intake.ID
+	created, err := s.store.CreateBusinessCase(ctx, &businessCase)
+	s.NoError(err)
+
+	s.Run("numbers each submitted version of a business case", func() {
+		for _, projectName := range []string{"Draft", "Final"} {
+			fetched, err := s.store.FetchBusinessCaseByID(ctx, created.ID)
+			s.NoError(err)
+			fetched.ProjectName = null.StringFrom(projectName)
+			_, err = s.store.CreateBusinessCaseVersion(ctx, &models.BusinessCaseVersion{
+				BusinessCaseID: created.ID,
+				IntakeStatus:   models.SystemIntakeStatusBIZCASEDRAFTSUBMITTED,
+				EUAUserID:      created.EUAUserID,
+				BusinessCase:   models.BusinessCaseSnapshot(*fetched),
+			})
+			s.NoError(err)
+		}
+
+		versions, err := s.store.FetchBusinessCaseVersions(ctx, created.ID)
+		s.NoError(err)
+		s.Len(versions, 2)
+		s.Equal(1, versions[0].Number)
+		s.Equal("Draft", versions[0].BusinessCase.ProjectName.ValueOrZero())
+		s.Equal(2, versions[1].Number)
+		s.Equal("Final", versions[1].BusinessCase.ProjectName.ValueOrZero())
+		s.Equal(len(created.LifecycleCostLines), len(versions[1].BusinessCase.LifecycleCostLines))
+
+		version, err := s.store.FetchBusinessCaseVersion(ctx, created.ID, 2)
+		s.NoError(err)
+		s.Equal(versions[1].ID, version.ID)
+	})
+
+	s.Run("returns a not found error for a version that doesn't exist", func() {
+		_, err := s.store.FetchBusinessCaseVersion(ctx, uuid.New(), 1)
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+
+	s.Run("versions can't be changed", func() {
+		_, err := s.db.Exec("UPDATE business_case_versions SET number = 5 WHERE business_case_id = $1", created.ID)
+		s.Error(err)
+
+		_, err = s.db.Exec("DELETE FROM business_case_versions WHERE business_case_id = $1", created.ID)
+		s.Error(err)
+	})
+}
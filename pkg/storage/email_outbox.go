@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CreateOutboxEmail queues an email for delivery
+func (s *Store) CreateOutboxEmail(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+	email.ID = uuid.New()
+	now := s.clock.Now()
+	email.CreatedAt = &now
+	email.UpdatedAt = &now
+	if email.NextAttemptAt == nil {
+		email.NextAttemptAt = &now
+	}
+	if email.CCAddresses == nil {
+		email.CCAddresses = pq.StringArray{}
+	}
+	if email.BCCAddresses == nil {
+		email.BCCAddresses = pq.StringArray{}
+	}
+	const createOutboxEmailSQL = `
+		INSERT INTO email_outbox (
+			id,
+			to_addresses,
+			cc_addresses,
+			bcc_addresses,
+			reply_to,
+			subject,
+			body,
+			text_body,
+			template_name,
+			intake_id,
+			attempts,
+			last_error,
+			next_attempt_at,
+			sent_at,
+			created_at,
+			updated_at
+		)
+		VALUES (
+			:id,
+			:to_addresses,
+			:cc_addresses,
+			:bcc_addresses,
+			:reply_to,
+			:subject,
+			:body,
+			:text_body,
+			:template_name,
+			:intake_id,
+			:attempts,
+			:last_error,
+			:next_attempt_at,
+			:sent_at,
+			:created_at,
+			:updated_at
+		)`
+	_, err := s.conn(ctx).NamedExec(createOutboxEmailSQL, email)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to create outbox email",
+			zap.String("templateName", email.TemplateName),
+			zap.Error(err),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     email,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return email, nil
+}
+
+// UpdateOutboxEmail updates the delivery state of an outbox email
+func (s *Store) UpdateOutboxEmail(ctx context.Context, email *models.OutboxEmail) (*models.OutboxEmail, error) {
+	now := s.clock.Now()
+	email.UpdatedAt = &now
+	const updateOutboxEmailSQL = `
+		UPDATE email_outbox
+		SET
+			attempts = :attempts,
+			last_error = :last_error,
+			next_attempt_at = :next_attempt_at,
+			sent_at = :sent_at,
+			updated_at = :updated_at
+		WHERE email_outbox.id = :id
+	`
+	_, err := s.conn(ctx).NamedExec(updateOutboxEmailSQL, email)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to update outbox email",
+			zap.String("id", email.ID.String()),
+			zap.Error(err),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     email,
+			Operation: apperrors.QuerySave,
+		}
+	}
+	return email, nil
+}
+
+// FetchOutboxEmailByID fetches a single outbox email
+func (s *Store) FetchOutboxEmailByID(ctx context.Context, id uuid.UUID) (*models.OutboxEmail, error) {
+	email := models.OutboxEmail{}
+	err := s.conn(ctx).Get(&email, "SELECT * FROM email_outbox WHERE id=$1", id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			"Failed to fetch outbox email",
+			zap.String("id", id.String()),
+			zap.Error(err),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.OutboxEmail{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &email, nil
+}
+
+// FetchFailedOutboxEmails fetches unsent emails that have failed at least once
+func (s *Store) FetchFailedOutboxEmails(ctx context.Context) (models.OutboxEmails, error) {
+	emails := models.OutboxEmails{}
+	const fetchFailedOutboxEmailsSQL = `
+		SELECT *
+		FROM email_outbox
+		WHERE sent_at IS NULL AND last_error IS NOT NULL
+		ORDER BY created_at
+	`
+	err := s.conn(ctx).Select(&emails, fetchFailedOutboxEmailsSQL)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch failed outbox emails", zap.Error(err))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.OutboxEmails{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return emails, nil
+}
+
+// ClaimOutboxEmails fetches up to limit emails that are due for delivery,
+// pushing their next attempt back by lease so no other worker picks them up
+// while they are being sent
+func (s *Store) ClaimOutboxEmails(ctx context.Context, limit int, maxAttempts int, lease time.Duration) (models.OutboxEmails, error) {
+	emails := models.OutboxEmails{}
+	now := s.clock.Now()
+	const claimOutboxEmailsSQL = `
+		UPDATE email_outbox
+		SET next_attempt_at = $1, updated_at = $2
+		WHERE id IN (
+			SELECT id
+			FROM email_outbox
+			WHERE sent_at IS NULL AND attempts < $3 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+	err := s.conn(ctx).Select(&emails, claimOutboxEmailsSQL, now.Add(lease), now, maxAttempts, limit)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to claim outbox emails", zap.Error(err))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.OutboxEmails{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return emails, nil
+}
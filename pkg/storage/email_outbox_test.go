@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestOutboxEmailRoundtrip() {
+	ctx := context.Background()
+
+	s.Run("claims, updates and lists failed emails", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		created, err := s.store.CreateOutboxEmail(ctx, &models.OutboxEmail{
+			ToAddresses:  pq.StringArray{"requester@example.com"},
+			Subject:      "subject",
+			Body:         "body",
+			TemplateName: "issue_lcid.gohtml",
+			IntakeID:     &intake.ID,
+		})
+		s.NoError(err)
+
+		claimed, err := s.store.ClaimOutboxEmails(ctx, 1000, 3, time.Hour)
+		s.NoError(err)
+		var found *models.OutboxEmail
+		for i := range claimed {
+			if claimed[i].ID == created.ID {
+				found = &claimed[i]
+			}
+		}
+		s.NotNil(found)
+
+		// a claimed email is leased to the worker that claimed it
+		claimedAgain, err := s.store.ClaimOutboxEmails(ctx, 1000, 3, time.Hour)
+		s.NoError(err)
+		for _, email := range claimedAgain {
+			s.NotEqual(created.ID, email.ID)
+		}
+
+		found.Attempts = 1
+		found.LastError = null.StringFrom("throttled")
+		_, err = s.store.UpdateOutboxEmail(ctx, found)
+		s.NoError(err)
+
+		failed, err := s.store.FetchFailedOutboxEmails(ctx)
+		s.NoError(err)
+		ids := []string{}
+		for _, email := range failed {
+			ids = append(ids, email.ID.String())
+		}
+		s.Contains(ids, created.ID.String())
+
+		fetched, err := s.store.FetchOutboxEmailByID(ctx, created.ID)
+		s.NoError(err)
+		s.Equal(1, fetched.Attempts)
+		s.Equal("throttled", fetched.LastError.String)
+		s.Equal(&intake.ID, fetched.IntakeID)
+	})
+}
@@ -89,3 +89,19 @@ func (s *Store) FetchFilesByAccessibilityRequestID(ctx context.Context, id uuid.
 
 	return &results, nil
 }
+
+// FetchFilesByAccessibilityRequestIDs retrieves the info for the files of many accessibility requests at once
+func (s *Store) FetchFilesByAccessibilityRequestIDs(ctx context.Context, ids []uuid.UUID) ([]models.UploadedFile, error) {
+	results := []models.UploadedFile{}
+	err := s.conn(ctx).Select(
+		&results,
+		"SELECT * FROM accessibility_request_files WHERE request_id = ANY(CAST($1 AS UUID[])) ORDER BY created_at, id",
+		uuidArray(ids),
+	)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch uploaded files", zap.Error(err), zap.Int("count", len(ids)))
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -38,7 +38,7 @@ func (s *Store) CreateNote(ctx context.Context, note *models.Note) (*models.Note
 		    :author_name,    
 		    :content
 		)`
-	_, err := s.db.NamedExec(
+	_, err := s.conn(ctx).NamedExec(
 		createNoteSQL,
 		note,
 	)
@@ -60,7 +60,7 @@ func (s *Store) CreateNote(ctx context.Context, note *models.Note) (*models.Note
 // FetchNoteByID retrieves a single Note by its primary key identifier
 func (s *Store) FetchNoteByID(ctx context.Context, id uuid.UUID) (*models.Note, error) {
 	note := models.Note{}
-	err := s.db.Get(&note, "SELECT * FROM public.notes WHERE id=$1", id)
+	err := s.conn(ctx).Get(&note, "SELECT * FROM public.notes WHERE id=$1", id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch note %s", err),
@@ -77,7 +77,7 @@ func (s *Store) FetchNoteByID(ctx context.Context, id uuid.UUID) (*models.Note,
 // FetchNotesBySystemIntakeID retrieves all Notes associated with a specific SystemIntake
 func (s *Store) FetchNotesBySystemIntakeID(ctx context.Context, id uuid.UUID) ([]*models.Note, error) {
 	notes := []*models.Note{}
-	err := s.db.Select(&notes, "SELECT * FROM notes WHERE system_intake=$1", id)
+	err := s.conn(ctx).Select(&notes, "SELECT * FROM notes WHERE system_intake=$1", id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch notes %s", err),
@@ -87,3 +87,17 @@ func (s *Store) FetchNotesBySystemIntakeID(ctx context.Context, id uuid.UUID) ([
 	}
 	return notes, nil
 }
+
+// FetchNotesBySystemIntakeIDs retrieves all Notes associated with any of the given SystemIntakes
+func (s *Store) FetchNotesBySystemIntakeIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Note, error) {
+	notes := []*models.Note{}
+	err := s.conn(ctx).Select(&notes, "SELECT * FROM notes WHERE system_intake = ANY(CAST($1 AS UUID[]))", uuidArray(ids))
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch notes %s", err),
+			zap.Int("count", len(ids)),
+		)
+		return nil, err
+	}
+	return notes, nil
+}
@@ -0,0 +1,218 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// sortKey is an expression a list can be ordered by for keyset pagination.
+// The expression must never be NULL; cursor values are cast to sqlType.
+type sortKey struct {
+	expr    string
+	sqlType string
+}
+
+// timeSortKey orders by a timestamp column, with NULLs sorted as the earliest time
+func timeSortKey(column string) sortKey {
+	return sortKey{expr: fmt.Sprintf("coalesce(%s, '-infinity')", column), sqlType: "TIMESTAMP WITH TIME ZONE"}
+}
+
+// textSortKey orders by a text column, with NULLs sorted as empty text
+func textSortKey(column string) sortKey {
+	return sortKey{expr: fmt.Sprintf("coalesce(CAST(%s AS TEXT), '')", column), sqlType: "TEXT"}
+}
+
+// timeSortValue is the cursor value of a time ordered by a timeSortKey
+func timeSortValue(t *time.Time) string {
+	if t == nil {
+		return "-infinity"
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// pageCursor is what an opaque cursor holds: the sort the list was in,
+// and the sort value and ID of the item the cursor points to
+type pageCursor struct {
+	SortBy    models.SortField     `json:"s"`
+	Direction models.SortDirection `json:"d"`
+	Value     string               `json:"v"`
+	ID        uuid.UUID            `json:"i"`
+}
+
+func encodeCursor(cursor pageCursor) string {
+	// marshaling a struct of strings can't fail
+	js, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(js)
+}
+
+func decodeCursor(encoded string) (pageCursor, error) {
+	cursor := pageCursor{}
+	js, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(js, &cursor)
+	return cursor, err
+}
+
+func pageValidationError(key string, message string) error {
+	valErr := apperrors.NewValidationError(
+		errors.New("page request failed validation"),
+		models.PageRequest{},
+		"",
+	)
+	valErr.WithValidation(key, message)
+	return &valErr
+}
+
+// keysetPage holds the SQL that selects one page of a list
+type keysetPage struct {
+	request models.PageRequest
+	// after limits the list to the items after the cursor; it is "TRUE" on the first page
+	after   string
+	orderBy string
+	limit   string
+	args    []interface{}
+}
+
+// newKeysetPage checks a page request against the ways a list can be sorted,
+// filling in the default sort, and builds the SQL for it.
+// Placeholders in the SQL are numbered after the argCount arguments the list's own query uses.
+func newKeysetPage(
+	keys map[models.SortField]sortKey,
+	defaultSort models.SortField,
+	idExpr string,
+	request models.PageRequest,
+	argCount int,
+) (*keysetPage, error) {
+	if request.First < 1 || request.First > models.MaxPageSize {
+		return nil, pageValidationError("first", fmt.Sprintf("must be between 1 and %d", models.MaxPageSize))
+	}
+	if request.SortBy == "" {
+		request.SortBy = defaultSort
+	}
+	key, ok := keys[request.SortBy]
+	if !ok {
+		return nil, pageValidationError("sortBy", fmt.Sprintf("cannot sort by %s", request.SortBy))
+	}
+	switch request.Direction {
+	case "":
+		request.Direction = models.SortDirectionASC
+		if key.sqlType != "TEXT" {
+			// times are most useful newest first
+			request.Direction = models.SortDirectionDESC
+		}
+	case models.SortDirectionASC, models.SortDirectionDESC:
+	default:
+		return nil, pageValidationError("direction", "must be ASC or DESC")
+	}
+
+	comparison := ">"
+	if request.Direction == models.SortDirectionDESC {
+		comparison = "<"
+	}
+	page := keysetPage{
+		request: request,
+		after:   "TRUE",
+		orderBy: fmt.Sprintf("ORDER BY %[1]s %[3]s, %[2]s %[3]s", key.expr, idExpr, request.Direction),
+		// one more than asked for, to tell if there is a next page
+		limit: fmt.Sprintf("LIMIT %d", request.First+1),
+	}
+
+	if request.After != "" {
+		cursor, err := decodeCursor(request.After)
+		if err != nil {
+			return nil, pageValidationError("after", "is not a valid cursor")
+		}
+		if cursor.SortBy != request.SortBy || cursor.Direction != request.Direction {
+			return nil, pageValidationError("after", "is a cursor for a different sort")
+		}
+		page.after = fmt.Sprintf(
+			"(%s, %s) %s (CAST($%d AS %s), CAST($%d AS UUID))",
+			key.expr,
+			idExpr,
+			comparison,
+			argCount+1,
+			key.sqlType,
+			argCount+2,
+		)
+		page.args = []interface{}{cursor.Value, cursor.ID}
+	}
+	return &page, nil
+}
+
+// cursor returns the cursor for an item with the given sort value and ID
+func (p *keysetPage) cursor(value string, id uuid.UUID) string {
+	return encodeCursor(pageCursor{
+		SortBy:    p.request.SortBy,
+		Direction: p.request.Direction,
+		Value:     value,
+		ID:        id,
+	})
+}
+
+// pageInfo describes a page given the cursors of the items fetched for it,
+// which include the extra item past the end of the page if there is one
+func (p *keysetPage) pageInfo(cursors []string) ([]string, models.PageInfo) {
+	info := models.PageInfo{}
+	if len(cursors) > p.request.First {
+		info.HasNextPage = true
+		cursors = cursors[:p.request.First]
+	}
+	if len(cursors) > 0 {
+		info.EndCursor = &cursors[len(cursors)-1]
+	}
+	return cursors, info
+}
+
+// offsetPage holds the SQL that selects one page of a list ordered by search rank.
+// The rank isn't stored, so the list can't be paged by keyset; its cursors hold offsets instead.
+type offsetPage struct {
+	first  int
+	offset int
+	limit  string
+}
+
+// newOffsetPage checks the size of a page and the cursor it comes after
+func newOffsetPage(first int, after string) (*offsetPage, error) {
+	if first < 1 || first > models.MaxPageSize {
+		return nil, pageValidationError("first", fmt.Sprintf("must be between 1 and %d", models.MaxPageSize))
+	}
+	page := offsetPage{first: first}
+	if after != "" {
+		cursor, err := decodeCursor(after)
+		if err != nil || cursor.SortBy != "" {
+			return nil, pageValidationError("after", "is not a valid cursor")
+		}
+		if page.offset, err = strconv.Atoi(cursor.Value); err != nil || page.offset < 0 {
+			return nil, pageValidationError("after", "is not a valid cursor")
+		}
+	}
+	// one more than asked for, to tell if there is a next page
+	page.limit = fmt.Sprintf("LIMIT %d OFFSET %d", first+1, page.offset)
+	return &page, nil
+}
+
+// pageInfo describes a page given how many items were fetched for it,
+// which includes the extra item past the end of the page if there is one
+func (p *offsetPage) pageInfo(fetched int) (int, models.PageInfo) {
+	info := models.PageInfo{}
+	if fetched > p.first {
+		info.HasNextPage = true
+		fetched = p.first
+	}
+	if fetched > 0 {
+		cursor := encodeCursor(pageCursor{Value: strconv.Itoa(p.offset + fetched)})
+		info.EndCursor = &cursor
+	}
+	return fetched, info
+}
@@ -0,0 +1,266 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestPageCursor() {
+	s.Run("round trips a cursor", func() {
+		cursor := pageCursor{
+			SortBy:    models.SortFieldPROJECTNAME,
+			Direction: models.SortDirectionDESC,
+			Value:     "Project & Name",
+			ID:        uuid.New(),
+		}
+		decoded, err := decodeCursor(encodeCursor(cursor))
+		s.NoError(err)
+		s.Equal(cursor, decoded)
+	})
+
+	s.Run("rejects a cursor that isn't base64", func() {
+		_, err := decodeCursor("not a cursor!")
+		s.Error(err)
+	})
+}
+
+func (s StoreTestSuite) TestNewKeysetPage() {
+	s.Run("fills in the default sort", func() {
+		page, err := newKeysetPage(systemIntakeSortKeys, models.SortFieldSUBMITTED, "id", models.PageRequest{First: 5}, 0)
+		s.NoError(err)
+		s.Equal(models.SortFieldSUBMITTED, page.request.SortBy)
+		s.Equal(models.SortDirectionDESC, page.request.Direction)
+		s.Equal("TRUE", page.after)
+		s.Equal("LIMIT 6", page.limit)
+	})
+
+	s.Run("sorts text ascending by default", func() {
+		request := models.PageRequest{First: 5, SortBy: models.SortFieldPROJECTNAME}
+		page, err := newKeysetPage(systemIntakeSortKeys, models.SortFieldSUBMITTED, "id", request, 0)
+		s.NoError(err)
+		s.Equal(models.SortDirectionASC, page.request.Direction)
+	})
+
+	s.Run("numbers cursor placeholders after the list's own", func() {
+		request := models.PageRequest{First: 5, SortBy: models.SortFieldPROJECTNAME, Direction: models.SortDirectionASC}
+		first, err := newKeysetPage(systemIntakeSortKeys, models.SortFieldSUBMITTED, "id", request, 0)
+		s.NoError(err)
+		request.After = first.cursor("Name", uuid.New())
+
+		page, err := newKeysetPage(systemIntakeSortKeys, models.SortFieldSUBMITTED, "id", request, 2)
+		s.NoError(err)
+		s.Contains(page.after, "> (CAST($3 AS TEXT), CAST($4 AS UUID))")
+		s.Len(page.args, 2)
+	})
+
+	invalid := map[string]struct {
+		keys    map[models.SortField]sortKey
+		request models.PageRequest
+		key     string
+	}{
+		"empty page": {
+			keys:    systemIntakeSortKeys,
+			request: models.PageRequest{First: 0},
+			key:     "first",
+		},
+		"page too big": {
+			keys:    systemIntakeSortKeys,
+			request: models.PageRequest{First: models.MaxPageSize + 1},
+			key:     "first",
+		},
+		"unknown sort": {
+			keys:    systemIntakeSortKeys,
+			request: models.PageRequest{First: 1, SortBy: "COLOR"},
+			key:     "sortBy",
+		},
+		"sort the list doesn't have": {
+			keys:    accessibilityRequestSortKeys,
+			request: models.PageRequest{First: 1, SortBy: models.SortFieldSTATUS},
+			key:     "sortBy",
+		},
+		"unknown direction": {
+			keys:    systemIntakeSortKeys,
+			request: models.PageRequest{First: 1, Direction: "SIDEWAYS"},
+			key:     "direction",
+		},
+		"malformed cursor": {
+			keys:    systemIntakeSortKeys,
+			request: models.PageRequest{First: 1, After: "%%%"},
+			key:     "after",
+		},
+		"cursor for a different sort": {
+			keys: systemIntakeSortKeys,
+			request: models.PageRequest{
+				First:     1,
+				SortBy:    models.SortFieldUPDATED,
+				Direction: models.SortDirectionDESC,
+				After: encodeCursor(pageCursor{
+					SortBy:    models.SortFieldSUBMITTED,
+					Direction: models.SortDirectionDESC,
+					Value:     "-infinity",
+					ID:        uuid.New(),
+				}),
+			},
+			key: "after",
+		},
+	}
+	for name, tc := range invalid {
+		s.Run(name, func() {
+			_, err := newKeysetPage(tc.keys, models.SortFieldSUBMITTED, "id", tc.request, 0)
+			s.IsType(&apperrors.ValidationError{}, err)
+			s.Contains(err.(*apperrors.ValidationError).Validations, tc.key)
+		})
+	}
+}
+
+func (s StoreTestSuite) TestFakeSystemsPage() {
+	for _, direction := range []models.SortDirection{models.SortDirectionASC, models.SortDirectionDESC} {
+		s.Run("pages through every fake system "+string(direction), func() {
+			request := models.PageRequest{First: 2, Direction: direction}
+			seen := []uuid.UUID{}
+			names := []string{}
+			for pages := 0; pages <= len(fakeSystems); pages++ {
+				page, err := newKeysetPage(systemSortKeys, models.SortFieldPROJECTNAME, "id", request, 0)
+				s.NoError(err)
+				cursors := []string{}
+				systems := fakeSystemsPage(page)
+				for _, system := range systems {
+					cursors = append(cursors, page.cursor(system.Name, system.ID))
+				}
+				cursors, info := page.pageInfo(cursors)
+				for _, system := range systems[:len(cursors)] {
+					seen = append(seen, system.ID)
+					names = append(names, system.Name)
+				}
+				if !info.HasNextPage {
+					break
+				}
+				request.After = *info.EndCursor
+			}
+
+			s.Len(seen, len(fakeSystems))
+			unique := map[uuid.UUID]bool{}
+			for _, id := range seen {
+				unique[id] = true
+			}
+			s.Len(unique, len(fakeSystems))
+			if direction == models.SortDirectionASC {
+				s.True(sort.StringsAreSorted(names))
+			} else {
+				s.True(sort.SliceIsSorted(names, func(i, j int) bool { return names[i] > names[j] }))
+			}
+		})
+	}
+}
+
+func (s StoreTestSuite) TestFetchSystemIntakesPage() {
+	ctx := context.Background()
+
+	// the intakes all belong to one requester, so the pages only hold the intakes made here
+	euaID := testhelpers.RandomEUAID()
+	create := func(projectName string, submittedAt *time.Time) models.SystemIntake {
+		intake := testhelpers.NewSystemIntake()
+		intake.EUAUserID = null.StringFrom(euaID)
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		created.ProjectName = null.StringFrom(projectName)
+		created.SubmittedAt = submittedAt
+		_, err = s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+		return *created
+	}
+	earlier := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := earlier.Add(24 * time.Hour)
+
+	alpha := create("Alpha", &later)
+	tied := []models.SystemIntake{create("Beta", &earlier), create("Beta", nil)}
+	// intakes with the same sort value are ordered by ID
+	sort.Slice(tied, func(i, j int) bool { return tied[i].ID.String() < tied[j].ID.String() })
+
+	ids := func(page *models.SystemIntakesPage) []uuid.UUID {
+		found := []uuid.UUID{}
+		for _, intake := range page.Results {
+			found = append(found, intake.ID)
+		}
+		return found
+	}
+
+	s.Run("pages through intakes with tied sort values", func() {
+		request := models.PageRequest{First: 2, SortBy: models.SortFieldPROJECTNAME}
+		first, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, request)
+		s.NoError(err)
+
+		s.Equal(3, first.TotalCount)
+		s.Equal([]uuid.UUID{alpha.ID, tied[0].ID}, ids(first))
+		s.Len(first.Cursors, 2)
+		s.True(first.PageInfo.HasNextPage)
+		s.Equal(first.Cursors[1], *first.PageInfo.EndCursor)
+
+		request.After = *first.PageInfo.EndCursor
+		last, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, request)
+		s.NoError(err)
+
+		s.Equal(3, last.TotalCount)
+		s.Equal([]uuid.UUID{tied[1].ID}, ids(last))
+		s.False(last.PageInfo.HasNextPage)
+	})
+
+	s.Run("has no next page when the list exactly fills the page", func() {
+		page, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, models.PageRequest{First: 3})
+		s.NoError(err)
+
+		s.Len(page.Results, 3)
+		s.False(page.PageInfo.HasNextPage)
+	})
+
+	s.Run("sorts intakes that were never submitted last when newest first", func() {
+		page, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, models.PageRequest{First: 1})
+		s.NoError(err)
+		s.Equal([]uuid.UUID{alpha.ID}, ids(page))
+
+		request := models.PageRequest{First: 2, After: *page.PageInfo.EndCursor}
+		rest, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, request)
+		s.NoError(err)
+		s.Len(rest.Results, 2)
+		s.NotNil(rest.Results[0].SubmittedAt)
+		s.Nil(rest.Results[1].SubmittedAt)
+		s.False(rest.PageInfo.HasNextPage)
+
+		// the cursor of an unsubmitted intake still pages on from it
+		request.After = rest.Cursors[1]
+		empty, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, request)
+		s.NoError(err)
+		s.Empty(empty.Results)
+		s.Nil(empty.PageInfo.EndCursor)
+	})
+
+	s.Run("filters by status", func() {
+		statuses := []models.SystemIntakeStatus{models.SystemIntakeStatusINTAKESUBMITTED}
+		page, err := s.store.FetchSystemIntakesPage(ctx, euaID, statuses, models.PageRequest{First: 10})
+		s.NoError(err)
+
+		s.Equal(0, page.TotalCount)
+		s.Empty(page.Results)
+	})
+
+	s.Run("leaves out withdrawn intakes", func() {
+		withdrawn := create("Withdrawn", &later)
+		withdrawn.Status = models.SystemIntakeStatusWITHDRAWN
+		_, err := s.store.UpdateSystemIntake(ctx, &withdrawn)
+		s.NoError(err)
+
+		page, err := s.store.FetchSystemIntakesPage(ctx, euaID, nil, models.PageRequest{First: 10})
+		s.NoError(err)
+
+		s.Equal(3, page.TotalCount)
+		s.NotContains(ids(page), withdrawn.ID)
+	})
+}
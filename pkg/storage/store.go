@@ -1,11 +1,14 @@
 package storage
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/facebookgo/clock"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 	ld "gopkg.in/launchdarkly/go-server-sdk.v5"
 )
@@ -62,3 +65,19 @@ func NewStore(
 		ldClient:  ldClient,
 	}, nil
 }
+
+// uuidArray converts IDs for a query that looks them up with ANY(CAST($1 AS UUID[]))
+func uuidArray(ids []uuid.UUID) pq.StringArray {
+	values := pq.StringArray{}
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return values
+}
+
+// isUniqueViolation returns whether the database rejected a write because it broke
+// the named unique constraint or index
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" && pqErr.Constraint == constraint
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/guregu/null"
@@ -95,6 +97,103 @@ func (s *Store) listSystems(ctx context.Context) ([]*models.System, error) {
 	return results, nil
 }
 
+// systemSortKeys are the ways a list of systems can be sorted
+var systemSortKeys = map[models.SortField]sortKey{
+	models.SortFieldPROJECTNAME: textSortKey("project_name"),
+}
+
+// ListSystemsPage retrieves a page of Systems, sorted by name
+func (s *Store) ListSystemsPage(ctx context.Context, request models.PageRequest) (*models.SystemsPage, error) {
+	page, err := newKeysetPage(systemSortKeys, models.SortFieldPROJECTNAME, "id", request, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var systems []*models.System
+	result := models.SystemsPage{}
+	if s.useFakeSystems(ctx) {
+		result.TotalCount = len(fakeSystems)
+		systems = fakeSystemsPage(page)
+	} else {
+		const systemsWhere = `
+			WHERE
+				status='LCID_ISSUED' AND
+				request_type='NEW' AND
+				lcid IS NOT NULL`
+		if err = s.conn(ctx).Get(&result.TotalCount, `SELECT count(*) FROM system_intakes`+systemsWhere); err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to count systems", zap.Error(err))
+			return nil, err
+		}
+		pageSQL := fmt.Sprintf(`
+			SELECT
+				id,
+				lcid,
+				project_name AS name,
+				business_owner AS business_owner_name,
+				business_owner_component
+			FROM system_intakes
+			%s AND %s
+			%s
+			%s`, systemsWhere, page.after, page.orderBy, page.limit)
+		systems = []*models.System{}
+		if err = s.conn(ctx).Select(&systems, pageSQL, page.args...); err != nil {
+			appcontext.ZLogger(ctx).Error("Failed to fetch systems", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	cursors := []string{}
+	for _, system := range systems {
+		cursors = append(cursors, page.cursor(system.Name, system.ID))
+	}
+	result.Cursors, result.PageInfo = page.pageInfo(cursors)
+	result.Results = systems[:len(result.Cursors)]
+	return &result, nil
+}
+
+// fakeSystemsPage pages through the fake systems the way the database would
+func fakeSystemsPage(page *keysetPage) []*models.System {
+	sorted := make([]*models.System, len(fakeSystems))
+	copy(sorted, fakeSystems)
+	less := func(a *models.System, name string, id uuid.UUID) bool {
+		if a.Name != name {
+			return a.Name < name
+		}
+		return a.ID.String() < id.String()
+	}
+	descending := page.request.Direction == models.SortDirectionDESC
+	sort.Slice(sorted, func(i, j int) bool {
+		if descending {
+			return less(sorted[j], sorted[i].Name, sorted[i].ID)
+		}
+		return less(sorted[i], sorted[j].Name, sorted[j].ID)
+	})
+
+	results := []*models.System{}
+	var after *pageCursor
+	if page.request.After != "" {
+		// newKeysetPage has already checked the cursor
+		cursor, _ := decodeCursor(page.request.After)
+		after = &cursor
+	}
+	for _, system := range sorted {
+		if after != nil {
+			isAfter := less(&models.System{Name: after.Value, ID: after.ID}, system.Name, system.ID)
+			if descending {
+				isAfter = less(system, after.Value, after.ID)
+			}
+			if !isAfter {
+				continue
+			}
+		}
+		if len(results) > page.request.First {
+			break
+		}
+		results = append(results, system)
+	}
+	return results
+}
+
 const sqlFetchSystemByIntakeID = `
 	SELECT
 		id,
@@ -129,3 +228,36 @@ func (s *Store) FetchSystemByIntakeID(ctx context.Context, intakeID uuid.UUID) (
 
 	return &system, nil
 }
+
+const sqlFetchSystemsByIntakeIDs = `
+	SELECT
+		id,
+		project_name AS name,
+		business_owner AS business_owner_name,
+		business_owner_component,
+		lcid
+	FROM system_intakes
+	WHERE
+		status='LCID_ISSUED' AND
+		request_type='NEW' AND
+		lcid IS NOT NULL AND
+		id = ANY(CAST($1 AS UUID[]));
+`
+
+// FetchSystemsByIntakeIDs queries the DB for the systems of many intakes at once.
+// Intakes that aren't systems are left out.
+func (s *Store) FetchSystemsByIntakeIDs(ctx context.Context, intakeIDs []uuid.UUID) ([]*models.System, error) {
+	systems := []*models.System{}
+
+	err := s.conn(ctx).Select(&systems, sqlFetchSystemsByIntakeIDs, uuidArray(intakeIDs))
+	if err != nil {
+		appcontext.ZLogger(ctx).Error("Failed to fetch systems", zap.Error(err), zap.Int("count", len(intakeIDs)))
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.System{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+
+	return systems, nil
+}
@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 
 	"github.com/cmsgov/easi-app/pkg/appcontext"
@@ -113,7 +116,7 @@ func (s *Store) CreateSystemIntake(ctx context.Context, intake *models.SystemInt
 		    :created_at,
 			:updated_at
 		)`
-	_, err := s.db.NamedExec(
+	_, err := s.conn(ctx).NamedExec(
 		createIntakeSQL,
 		intake,
 	)
@@ -127,7 +130,8 @@ func (s *Store) CreateSystemIntake(ctx context.Context, intake *models.SystemInt
 	return s.FetchSystemIntakeByID(ctx, intake.ID)
 }
 
-// UpdateSystemIntake does an upsert for a system intake
+// UpdateSystemIntake does an upsert for a system intake.
+// An intake with a version is only updated if it's still at that version.
 func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemIntake) (*models.SystemIntake, error) {
 	// We are explicitly not updating ID, EUAUserID and SystemIntakeID
 	const updateSystemIntakeSQL = `
@@ -179,29 +183,66 @@ func (s *Store) UpdateSystemIntake(ctx context.Context, intake *models.SystemInt
 			lcid = :lcid,
 			lcid_expires_at = :lcid_expires_at,
 			lcid_scope = :lcid_scope,
+			lcid_reminder_days = :lcid_reminder_days,
+			lcid_retired_at = :lcid_retired_at,
+			lcid_retirement_reason = :lcid_retirement_reason,
 			decision_next_steps = :decision_next_steps,
-			rejection_reason = :rejection_reason
-		WHERE system_intakes.id = :id
+			rejection_reason = :rejection_reason,
+			version = version + 1
+		WHERE system_intakes.id = :id AND (:version = 0 OR version = :version)
 	`
-	_, err := s.db.NamedExec(
-		updateSystemIntakeSQL,
-		intake,
-	)
-	if err != nil {
-		appcontext.ZLogger(ctx).Error(
-			fmt.Sprintf("Failed to update system intake %s", err),
-			zap.String("id", intake.ID.String()),
-			zap.String("user", intake.EUAUserID.ValueOrZero()),
+	var updated *models.SystemIntake
+	err := s.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.LockSystemIntake(ctx, intake.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err := s.conn(ctx).NamedExec(
+			updateSystemIntakeSQL,
+			intake,
 		)
-		return nil, &apperrors.QueryError{
-			Err:       err,
-			Model:     intake,
-			Operation: apperrors.QueryUpdate,
+		if isUniqueViolation(err, "lcid_unique_idx") {
+			return &apperrors.ResourceConflictError{
+				Err:        fmt.Errorf("lifecycle id %s is already issued to another intake", intake.LifecycleID.ValueOrZero()),
+				Resource:   intake,
+				ResourceID: intake.ID.String(),
+			}
+		}
+		if err != nil {
+			appcontext.ZLogger(ctx).Error(
+				fmt.Sprintf("Failed to update system intake %s", err),
+				zap.String("id", intake.ID.String()),
+				zap.String("user", intake.EUAUserID.ValueOrZero()),
+			)
+			return &apperrors.QueryError{
+				Err:       err,
+				Model:     intake,
+				Operation: apperrors.QueryUpdate,
+			}
+		}
+		if affectedRows, _ := result.RowsAffected(); affectedRows == 0 {
+			return &apperrors.ResourceConflictError{
+				Err:        fmt.Errorf("system intake is no longer at version %d", intake.Version),
+				Resource:   intake,
+				ResourceID: intake.ID.String(),
+			}
+		}
+
+		// the SystemIntake may have been updated to Archived, so we want to use
+		// the un-filtered fetch to return the saved object
+		updated, err = s.FetchSystemIntakeByID(ctx, intake.ID)
+		if err != nil {
+			return err
 		}
+		return s.recordChanges(ctx, models.AuditEntitySystemIntake, intake.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
 	}
-	// the SystemIntake may have been updated to Archived, so we want to use
-	// the un-filtered fetch to return the saved object
-	return s.FetchSystemIntakeByID(ctx, intake.ID)
+	// callers that update the same intake again carry on from the new version
+	intake.Version = updated.Version
+	return updated, nil
 }
 
 const fetchSystemIntakeSQL = `
@@ -219,7 +260,7 @@ func (s *Store) FetchSystemIntakeByID(ctx context.Context, id uuid.UUID) (*model
 	const idMatchClause = `
 		WHERE system_intakes.id=$1
 `
-	err := s.db.Get(&intake, fetchSystemIntakeSQL+idMatchClause, id)
+	err := s.conn(ctx).Get(&intake, fetchSystemIntakeSQL+idMatchClause, id)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch system intake %s", err),
@@ -244,7 +285,7 @@ func (s *Store) FetchSystemIntakesByEuaID(ctx context.Context, euaID string) (mo
 	const byEuaIDClause = `
 		WHERE system_intakes.eua_user_id=$1 AND system_intakes.status != 'WITHDRAWN'
 	`
-	err := s.db.Select(&intakes, fetchSystemIntakeSQL+byEuaIDClause, euaID)
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL+byEuaIDClause, euaID)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(
 			fmt.Sprintf("Failed to fetch system intakes %s", err),
@@ -258,7 +299,7 @@ func (s *Store) FetchSystemIntakesByEuaID(ctx context.Context, euaID string) (mo
 // FetchSystemIntakes queries the DB for all system intakes
 func (s *Store) FetchSystemIntakes(ctx context.Context) (models.SystemIntakes, error) {
 	intakes := []models.SystemIntake{}
-	err := s.db.Select(&intakes, fetchSystemIntakeSQL)
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes %s", err))
 		return models.SystemIntakes{}, err
@@ -277,8 +318,8 @@ func (s *Store) FetchSystemIntakesByStatuses(ctx context.Context, allowedStatuse
 		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes %s", err))
 		return models.SystemIntakes{}, err
 	}
-	query = s.db.Rebind(query)
-	err = s.db.Select(&intakes, query, args...)
+	query = s.conn(ctx).Rebind(query)
+	err = s.conn(ctx).Select(&intakes, query, args...)
 	if err != nil {
 		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes %s", err))
 		return models.SystemIntakes{}, err
@@ -286,28 +327,239 @@ func (s *Store) FetchSystemIntakesByStatuses(ctx context.Context, allowedStatuse
 	return intakes, nil
 }
 
+// systemIntakeSortKeys are the ways a list of system intakes can be sorted
+var systemIntakeSortKeys = map[models.SortField]sortKey{
+	models.SortFieldSUBMITTED:   timeSortKey("system_intakes.submitted_at"),
+	models.SortFieldUPDATED:     timeSortKey("system_intakes.updated_at"),
+	models.SortFieldPROJECTNAME: textSortKey("system_intakes.project_name"),
+	models.SortFieldSTATUS:      textSortKey("system_intakes.status"),
+}
+
+// systemIntakeSortValue is the value an intake is ordered by for a sort
+func systemIntakeSortValue(intake models.SystemIntake, sortBy models.SortField) string {
+	switch sortBy {
+	case models.SortFieldUPDATED:
+		return timeSortValue(intake.UpdatedAt)
+	case models.SortFieldPROJECTNAME:
+		return intake.ProjectName.String
+	case models.SortFieldSTATUS:
+		return string(intake.Status)
+	default:
+		return timeSortValue(intake.SubmittedAt)
+	}
+}
+
+// FetchSystemIntakesPage queries the DB for a page of system intakes.
+// The intakes can be limited to one requester's and to some statuses.
+func (s *Store) FetchSystemIntakesPage(
+	ctx context.Context,
+	euaID string,
+	statuses []models.SystemIntakeStatus,
+	request models.PageRequest,
+) (*models.SystemIntakesPage, error) {
+	clauses := []string{"TRUE"}
+	args := []interface{}{}
+	if euaID != "" {
+		// requesters don't see the intakes they withdrew, as with FetchSystemIntakesByEuaID
+		args = append(args, euaID)
+		clauses = append(clauses, fmt.Sprintf("system_intakes.eua_user_id = $%d", len(args)), "system_intakes.status != 'WITHDRAWN'")
+	}
+	if len(statuses) > 0 {
+		statusValues := pq.StringArray{}
+		for _, status := range statuses {
+			statusValues = append(statusValues, string(status))
+		}
+		args = append(args, statusValues)
+		clauses = append(clauses, fmt.Sprintf("CAST(system_intakes.status AS TEXT) = ANY($%d)", len(args)))
+	}
+	where := "WHERE " + strings.Join(clauses, " AND ")
+
+	page, err := newKeysetPage(systemIntakeSortKeys, models.SortFieldSUBMITTED, "system_intakes.id", request, len(args))
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.SystemIntakesPage{Results: []models.SystemIntake{}}
+	if err = s.conn(ctx).Get(&result.TotalCount, "SELECT count(*) FROM system_intakes "+where, args...); err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to count system intakes %s", err))
+		return nil, err
+	}
+
+	pageSQL := fmt.Sprintf("%s %s AND %s %s %s", fetchSystemIntakeSQL, where, page.after, page.orderBy, page.limit)
+	err = s.conn(ctx).Select(&result.Results, pageSQL, append(args, page.args...)...)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes page %s", err))
+		return nil, err
+	}
+
+	cursors := []string{}
+	for _, intake := range result.Results {
+		cursors = append(cursors, page.cursor(systemIntakeSortValue(intake, page.request.SortBy), intake.ID))
+	}
+	result.Cursors, result.PageInfo = page.pageInfo(cursors)
+	result.Results = result.Results[:len(result.Cursors)]
+	return &result, nil
+}
+
+// FetchSystemIntakesWithLCIDExpiringBy queries the DB for issued LCIDs that expire on or before the given time
+func (s *Store) FetchSystemIntakesWithLCIDExpiringBy(ctx context.Context, expiresBy time.Time) (models.SystemIntakes, error) {
+	intakes := []models.SystemIntake{}
+	const expiringByClause = `
+		WHERE system_intakes.status = 'LCID_ISSUED' AND system_intakes.lcid_expires_at <= $1
+		ORDER BY system_intakes.lcid_expires_at
+	`
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL+expiringByClause, expiresBy)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes with expiring LCIDs %s", err))
+		return models.SystemIntakes{}, err
+	}
+	return intakes, nil
+}
+
+// FetchSystemIntakesSubmittedToCedar queries the DB for intakes CEDAR has an ID for, oldest submission first
+func (s *Store) FetchSystemIntakesSubmittedToCedar(ctx context.Context) (models.SystemIntakes, error) {
+	intakes := []models.SystemIntake{}
+	const submittedClause = `
+		WHERE system_intakes.alfabet_id IS NOT NULL AND system_intakes.alfabet_id <> ''
+		ORDER BY system_intakes.submitted_at, system_intakes.id
+	`
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL+submittedClause)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes submitted to CEDAR %s", err))
+		return models.SystemIntakes{}, err
+	}
+	return intakes, nil
+}
+
+// FetchSystemIntakesAwaitingCedar queries the DB for submitted intakes CEDAR hasn't given an ID yet,
+// oldest submission first
+func (s *Store) FetchSystemIntakesAwaitingCedar(ctx context.Context) (models.SystemIntakes, error) {
+	intakes := []models.SystemIntake{}
+	const awaitingClause = `
+		WHERE system_intakes.submitted_at IS NOT NULL
+			AND system_intakes.archived_at IS NULL
+			AND (system_intakes.alfabet_id IS NULL OR system_intakes.alfabet_id = '')
+		ORDER BY system_intakes.submitted_at, system_intakes.id
+	`
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL+awaitingClause)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(fmt.Sprintf("Failed to fetch system intakes awaiting CEDAR %s", err))
+		return models.SystemIntakes{}, err
+	}
+	return intakes, nil
+}
+
+// UpdateSystemIntakeAlfabetID saves the ID CEDAR gave an intake onto its latest version,
+// so it isn't lost to a conflict with changes made since the intake was submitted
+func (s *Store) UpdateSystemIntakeAlfabetID(ctx context.Context, id uuid.UUID, alfabetID string) (*models.SystemIntake, error) {
+	var updated *models.SystemIntake
+	err := s.WithTransaction(ctx, func(ctx context.Context) error {
+		intake, err := s.LockSystemIntake(ctx, id)
+		if err != nil {
+			return err
+		}
+		intake.AlfabetID = null.StringFrom(alfabetID)
+		updated, err = s.UpdateSystemIntake(ctx, intake)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// LockSystemIntake fetches a system intake and locks it until the transaction on the context ends,
+// so only one caller at a time acts on it
+func (s *Store) LockSystemIntake(ctx context.Context, id uuid.UUID) (*models.SystemIntake, error) {
+	intake := models.SystemIntake{}
+	const lockClause = `
+		WHERE system_intakes.id=$1
+		FOR UPDATE OF system_intakes
+	`
+	err := s.conn(ctx).Get(&intake, fetchSystemIntakeSQL+lockClause, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to lock system intake %s", err),
+			zap.String("id", id.String()),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntake{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     id,
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &intake, nil
+}
+
 func generateLifecyclePrefix(t time.Time, loc *time.Location) string {
 	return t.In(loc).Format("06002")
 }
 
-// GenerateLifecycleID returns what the next LCID is expected to be for the current date
-// 		The expected format is a 6-digit number in the form of "YYdddP" where
-// 			YY - the 2-digit YEAR
-// 			ddd - the 3-digit ORDINAL DATE, e.g. the number of days elapsed in the given year
-// 			P - the 1-digit count of how many LCIDs already generated for the given day
-// 		This routine assumes the LCIDs are being generated in Eastern Time Zone
-// 		(FYI - the "YYddd" construct is referred to as the "Julian Day" in mainframe
-// 		programmer circles, though this term seems to be a misappropriation of what
-// 		astronomers use to mean a count of days since 24 Nov in the year 4714 BC.)
+// lifecycleIDsPerDay is how many LCIDs can be generated for one day:
+// ten without a letter, then ten for each of the letters A through Z
+const lifecycleIDsPerDay = 10 * 27
+
+// formatLifecycleID builds the LCID for the given day's prefix and sequence number
+func formatLifecycleID(prefix string, sequence int) (string, error) {
+	if sequence < 0 || sequence >= lifecycleIDsPerDay {
+		return "", fmt.Errorf("no lifecycle ids left for %s", prefix)
+	}
+	lcid := fmt.Sprintf("%s%d", prefix, sequence%10)
+	if sequence >= 10 {
+		lcid = string(rune('A'+sequence/10-1)) + lcid
+	}
+	return lcid, nil
+}
+
+// GenerateLifecycleID returns the next LCID for the current date
+//
+//	The expected format is a 6-digit number in the form of "YYdddP" where
+//		YY - the 2-digit YEAR
+//		ddd - the 3-digit ORDINAL DATE, e.g. the number of days elapsed in the given year
+//		P - the 1-digit count of how many LCIDs already generated for the given day
+//	Once a day has used all ten digits, a letter is added to the front, so the
+//	11th LCID of a day is "AYYddd0", the 21st is "BYYddd0", and so on through "Z".
+//	This routine assumes the LCIDs are being generated in Eastern Time Zone
+//	(FYI - the "YYddd" construct is referred to as the "Julian Day" in mainframe
+//	programmer circles, though this term seems to be a misappropriation of what
+//	astronomers use to mean a count of days since 24 Nov in the year 4714 BC.)
+//
+//	The day's counter row stays locked until the surrounding transaction ends,
+//	so concurrent issuers each get their own LCID. LCIDs that were entered by hand
+//	are skipped.
 func (s *Store) GenerateLifecycleID(ctx context.Context) (string, error) {
 	prefix := generateLifecyclePrefix(s.clock.Now(), s.easternTZ)
 
-	countSQL := `SELECT COUNT(*) FROM system_intakes WHERE lcid ~ $1;`
-	var count int
-	if err := s.db.Get(&count, countSQL, "^"+prefix); err != nil {
-		return "", err
+	const nextSequenceSQL = `
+		INSERT INTO lcid_sequences (prefix, last_sequence)
+		VALUES ($1, 0)
+		ON CONFLICT (prefix) DO UPDATE
+		SET last_sequence = lcid_sequences.last_sequence + 1
+		RETURNING last_sequence;
+	`
+	const existsSQL = `SELECT EXISTS (SELECT 1 FROM system_intakes WHERE lcid = $1);`
+
+	for {
+		var sequence int
+		if err := s.conn(ctx).Get(&sequence, nextSequenceSQL, prefix); err != nil {
+			return "", err
+		}
+		lcid, err := formatLifecycleID(prefix, sequence)
+		if err != nil {
+			return "", err
+		}
+
+		var exists bool
+		if err := s.conn(ctx).Get(&exists, existsSQL, lcid); err != nil {
+			return "", err
+		}
+		if !exists {
+			return lcid, nil
+		}
 	}
-	return fmt.Sprintf("%s%d", prefix, count), nil
 }
 
 // FetchSystemIntakeMetrics gets a metrics digest for system intake
@@ -352,7 +604,7 @@ func (s *Store) FetchSystemIntakeMetrics(ctx context.Context, startTime time.Tim
 	metrics := models.SystemIntakeMetrics{}
 
 	var startedResponse startedQueryResponse
-	err := s.db.Get(
+	err := s.conn(ctx).Get(
 		&startedResponse,
 		startedCountSQL,
 		&startTime,
@@ -365,7 +617,7 @@ func (s *Store) FetchSystemIntakeMetrics(ctx context.Context, startTime time.Tim
 	metrics.CompletedOfStarted = startedResponse.CompletedCount
 
 	var fundedResponse fundedQueryResponse
-	err = s.db.Get(
+	err = s.conn(ctx).Get(
 		&fundedResponse,
 		fundedCountSQL,
 		&startTime,
@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// CreateSystemIntakeContact adds a contact to an intake
+func (s *Store) CreateSystemIntakeContact(ctx context.Context, contact *models.SystemIntakeContact) (*models.SystemIntakeContact, error) {
+	const createSystemIntakeContactSQL = `
+		INSERT INTO system_intake_contacts (
+			id,
+			system_intake_id,
+			role,
+			eua_user_id,
+			common_name,
+			email,
+			component,
+			created_at
+		)
+		VALUES (
+			:id,
+			:system_intake_id,
+			:role,
+			:eua_user_id,
+			:common_name,
+			:email,
+			:component,
+			:created_at
+		)`
+	contact.ID = uuid.New()
+	createdAt := s.clock.Now()
+	contact.CreatedAt = &createdAt
+	_, err := s.conn(ctx).NamedExec(createSystemIntakeContactSQL, contact)
+	if isUniqueViolation(err, "system_intake_contacts_role_unique") {
+		return nil, &apperrors.ResourceConflictError{
+			Err:        fmt.Errorf("%s already holds the %s role on the intake", contact.EUAUserID, contact.Role),
+			Resource:   contact,
+			ResourceID: contact.SystemIntakeID.String(),
+		}
+	}
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to create system intake contact with error %s", err),
+			zap.String("intakeID", contact.SystemIntakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     contact,
+			Operation: apperrors.QueryPost,
+		}
+	}
+	return contact, nil
+}
+
+// FetchSystemIntakeContactByID returns one contact of an intake
+func (s *Store) FetchSystemIntakeContactByID(ctx context.Context, id uuid.UUID) (*models.SystemIntakeContact, error) {
+	contact := models.SystemIntakeContact{}
+	err := s.conn(ctx).Get(&contact, `SELECT * FROM system_intake_contacts WHERE id = $1`, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intake contact with error %s", err),
+			zap.String("id", id.String()),
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.ResourceNotFoundError{Err: err, Resource: models.SystemIntakeContact{}}
+		}
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeContact{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return &contact, nil
+}
+
+// FetchSystemIntakeContacts returns the contacts of an intake, in the order they were added
+func (s *Store) FetchSystemIntakeContacts(ctx context.Context, intakeID uuid.UUID) ([]models.SystemIntakeContact, error) {
+	const fetchSystemIntakeContactsSQL = `
+		SELECT *
+		FROM system_intake_contacts
+		WHERE system_intake_id = $1
+		ORDER BY created_at, id`
+	contacts := []models.SystemIntakeContact{}
+	err := s.conn(ctx).Select(&contacts, fetchSystemIntakeContactsSQL, intakeID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intake contacts with error %s", err),
+			zap.String("intakeID", intakeID.String()),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeContact{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return contacts, nil
+}
+
+// DeleteSystemIntakeContact removes a contact from an intake
+func (s *Store) DeleteSystemIntakeContact(ctx context.Context, id uuid.UUID) error {
+	_, err := s.conn(ctx).Exec(`DELETE FROM system_intake_contacts WHERE id = $1`, id)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to delete system intake contact with error %s", err),
+			zap.String("id", id.String()),
+		)
+		return &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntakeContact{},
+			Operation: apperrors.QueryDelete,
+		}
+	}
+	return nil
+}
+
+// FetchSystemIntakesByContactEUAID returns the intakes where a person holds any contact role,
+// most recently updated first. Withdrawn intakes are left out, as they are for requesters.
+func (s *Store) FetchSystemIntakesByContactEUAID(ctx context.Context, euaID string) (models.SystemIntakes, error) {
+	const byContactClause = `
+		WHERE system_intakes.status != 'WITHDRAWN' AND system_intakes.id IN (
+			SELECT system_intake_id
+			FROM system_intake_contacts
+			WHERE eua_user_id = $1
+		)
+		ORDER BY system_intakes.updated_at DESC, system_intakes.id`
+	intakes := models.SystemIntakes{}
+	err := s.conn(ctx).Select(&intakes, fetchSystemIntakeSQL+byContactClause, euaID)
+	if err != nil {
+		appcontext.ZLogger(ctx).Error(
+			fmt.Sprintf("Failed to fetch system intakes by contact with error %s", err),
+			zap.String("euaID", euaID),
+		)
+		return nil, &apperrors.QueryError{
+			Err:       err,
+			Model:     models.SystemIntake{},
+			Operation: apperrors.QueryFetch,
+		}
+	}
+	return intakes, nil
+}
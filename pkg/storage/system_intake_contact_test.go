@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSystemIntakeContacts() {
+	ctx := context.Background()
+
+	intake := testhelpers.NewSystemIntake()
+	_, err := s.store.CreateSystemIntake(ctx, &intake)
+	s.NoError(err)
+	withdrawn := testhelpers.NewSystemIntake()
+	withdrawn.Status = models.SystemIntakeStatusWITHDRAWN
+	_, err = s.store.CreateSystemIntake(ctx, &withdrawn)
+	s.NoError(err)
+
+	euaID := testhelpers.RandomEUAID()
+	newContact := func(intakeID uuid.UUID, role models.SystemIntakeContactRole) *models.SystemIntakeContact {
+		return &models.SystemIntakeContact{
+			SystemIntakeID: intakeID,
+			Role:           role,
+			EUAUserID:      euaID,
+			CommonName:     "Adeline Aarons",
+			Email:          "adeline.aarons@cms.hhs.gov",
+			Component:      null.StringFrom("OIT"),
+		}
+	}
+
+	s.Run("adds and removes contacts", func() {
+		owner, err := s.store.CreateSystemIntakeContact(ctx, newContact(intake.ID, models.SystemIntakeContactRoleBUSINESSOWNER))
+		s.NoError(err)
+		_, err = s.store.CreateSystemIntakeContact(ctx, newContact(intake.ID, models.SystemIntakeContactRoleISSO))
+		s.NoError(err)
+
+		contacts, err := s.store.FetchSystemIntakeContacts(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(contacts, 2)
+		s.Equal(models.SystemIntakeContactRoleBUSINESSOWNER, contacts[0].Role)
+		s.Equal("OIT", contacts[0].Component.ValueOrZero())
+
+		fetched, err := s.store.FetchSystemIntakeContactByID(ctx, owner.ID)
+		s.NoError(err)
+		s.Equal(euaID, fetched.EUAUserID)
+
+		s.NoError(s.store.DeleteSystemIntakeContact(ctx, owner.ID))
+		contacts, err = s.store.FetchSystemIntakeContacts(ctx, intake.ID)
+		s.NoError(err)
+		s.Len(contacts, 1)
+		s.Equal(models.SystemIntakeContactRoleISSO, contacts[0].Role)
+	})
+
+	s.Run("a person holds a role on an intake once", func() {
+		_, err := s.store.CreateSystemIntakeContact(ctx, newContact(intake.ID, models.SystemIntakeContactRoleISSO))
+
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+	})
+
+	s.Run("fetches the intakes a person is a contact on", func() {
+		_, err := s.store.CreateSystemIntakeContact(ctx, newContact(withdrawn.ID, models.SystemIntakeContactRoleEACOLLABORATOR))
+		s.NoError(err)
+
+		intakes, err := s.store.FetchSystemIntakesByContactEUAID(ctx, euaID)
+
+		s.NoError(err)
+		s.Len(intakes, 1)
+		s.Equal(intake.ID, intakes[0].ID)
+	})
+
+	s.Run("returns a not found error for a contact that doesn't exist", func() {
+		_, err := s.store.FetchSystemIntakeContactByID(ctx, uuid.New())
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+)
+
+// systemIntakeSearchDocumentSQL matches the expression system_intakes_search_idx is built on
+const systemIntakeSearchDocumentSQL = `system_intake_search_document(
+	system_intakes.project_name,
+	system_intakes.project_acronym,
+	system_intakes.business_need,
+	system_intakes.solution,
+	system_intakes.requester,
+	system_intakes.business_owner
+)`
+
+// systemIntakeSearchFacetSQL is the value each facet counts results by.
+// Casts are written with CAST since BindNamed reads "::" as an escaped colon.
+var systemIntakeSearchFacetSQL = map[models.SystemIntakeSearchFacet]string{
+	models.SystemIntakeSearchFacetSTATUS:         `CAST(system_intakes.status AS TEXT)`,
+	models.SystemIntakeSearchFacetREQUESTTYPE:    `CAST(system_intakes.request_type AS TEXT)`,
+	models.SystemIntakeSearchFacetCOMPONENT:      `system_intakes.component`,
+	models.SystemIntakeSearchFacetSUBMITTEDMONTH: `to_char(system_intakes.submitted_at AT TIME ZONE 'UTC', 'YYYY-MM')`,
+	models.SystemIntakeSearchFacetHASLCID:        `CAST(coalesce(system_intakes.lcid, '') <> '' AS TEXT)`,
+}
+
+// systemIntakeSearchWhere builds the WHERE clause for a search and its named arguments.
+// The filter on the skipped facet is left out, so it can be counted by every value.
+func systemIntakeSearchWhere(search models.SystemIntakeSearch, skip models.SystemIntakeSearchFacet) (string, map[string]interface{}) {
+	clauses := []string{"TRUE"}
+	args := map[string]interface{}{}
+
+	if search.Query != "" {
+		clauses = append(clauses, systemIntakeSearchDocumentSQL+` @@ websearch_to_tsquery('english', :query)`)
+		args["query"] = search.Query
+	}
+	if len(search.Statuses) > 0 && skip != models.SystemIntakeSearchFacetSTATUS {
+		statuses := pq.StringArray{}
+		for _, status := range search.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		clauses = append(clauses, `CAST(system_intakes.status AS TEXT) = ANY(:statuses)`)
+		args["statuses"] = statuses
+	}
+	if len(search.RequestTypes) > 0 && skip != models.SystemIntakeSearchFacetREQUESTTYPE {
+		requestTypes := pq.StringArray{}
+		for _, requestType := range search.RequestTypes {
+			requestTypes = append(requestTypes, string(requestType))
+		}
+		clauses = append(clauses, `CAST(system_intakes.request_type AS TEXT) = ANY(:request_types)`)
+		args["request_types"] = requestTypes
+	}
+	if len(search.Components) > 0 && skip != models.SystemIntakeSearchFacetCOMPONENT {
+		clauses = append(clauses, `system_intakes.component = ANY(:components)`)
+		args["components"] = pq.StringArray(search.Components)
+	}
+	if skip != models.SystemIntakeSearchFacetSUBMITTEDMONTH {
+		if search.SubmittedAfter != nil {
+			clauses = append(clauses, `system_intakes.submitted_at >= :submitted_after`)
+			args["submitted_after"] = search.SubmittedAfter
+		}
+		if search.SubmittedBefore != nil {
+			clauses = append(clauses, `system_intakes.submitted_at < :submitted_before`)
+			args["submitted_before"] = search.SubmittedBefore
+		}
+	}
+	if search.HasLifecycleID != nil && skip != models.SystemIntakeSearchFacetHASLCID {
+		clauses = append(clauses, `(coalesce(system_intakes.lcid, '') <> '') = :has_lcid`)
+		args["has_lcid"] = *search.HasLifecycleID
+	}
+
+	return "WHERE " + strings.Join(clauses, " AND "), args
+}
+
+// SearchSystemIntakes queries the DB for a page of the system intakes matching a search, best matches first,
+// along with how many matches there are for each facet value
+func (s *Store) SearchSystemIntakes(ctx context.Context, search models.SystemIntakeSearch) (*models.SystemIntakeSearchResult, error) {
+	logger := appcontext.ZLogger(ctx)
+	result := models.SystemIntakeSearchResult{Results: []models.SystemIntake{}}
+
+	page, err := newOffsetPage(search.First, search.After)
+	if err != nil {
+		return nil, err
+	}
+
+	where, args := systemIntakeSearchWhere(search, "")
+	countQuery, countArgs, err := s.conn(ctx).BindNamed("SELECT count(*) FROM system_intakes "+where, args)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.conn(ctx).Get(&result.TotalCount, countQuery, countArgs...); err != nil {
+		logger.Error(fmt.Sprintf("Failed to count system intake search results %s", err))
+		return nil, err
+	}
+
+	order := `
+		ORDER BY system_intakes.submitted_at DESC NULLS LAST, system_intakes.created_at DESC, system_intakes.id
+	`
+	if search.Query != "" {
+		order = `
+		ORDER BY ts_rank(` + systemIntakeSearchDocumentSQL + `, websearch_to_tsquery('english', :query)) DESC,
+			system_intakes.submitted_at DESC NULLS LAST,
+			system_intakes.created_at DESC,
+			system_intakes.id
+		`
+	}
+	query, queryArgs, err := s.conn(ctx).BindNamed(fetchSystemIntakeSQL+where+order+page.limit, args)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.conn(ctx).Select(&result.Results, query, queryArgs...); err != nil {
+		logger.Error(fmt.Sprintf("Failed to search system intakes %s", err))
+		return nil, err
+	}
+	var pageLength int
+	pageLength, result.PageInfo = page.pageInfo(len(result.Results))
+	result.Results = result.Results[:pageLength]
+
+	for _, facet := range models.SystemIntakeSearchFacets {
+		valueSQL := systemIntakeSearchFacetSQL[facet]
+		where, args := systemIntakeSearchWhere(search, facet)
+		facetSQL := fmt.Sprintf(`
+			SELECT %[1]s AS value, count(*) AS count
+			FROM system_intakes
+			%[2]s AND %[1]s IS NOT NULL AND %[1]s <> ''
+			GROUP BY value
+			ORDER BY count DESC, value
+		`, valueSQL, where)
+		query, queryArgs, err := s.conn(ctx).BindNamed(facetSQL, args)
+		if err != nil {
+			return nil, err
+		}
+		counts := []models.SystemIntakeSearchFacetCount{}
+		if err = s.conn(ctx).Select(&counts, query, queryArgs...); err != nil {
+			logger.Error(fmt.Sprintf("Failed to count system intake search facet %s %s", facet, err))
+			return nil, err
+		}
+		result.Facets = append(result.Facets, models.SystemIntakeSearchFacetCounts{Facet: facet, Counts: counts})
+	}
+
+	return &result, nil
+}
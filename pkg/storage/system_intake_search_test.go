@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+
+	"github.com/cmsgov/easi-app/pkg/apperrors"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestSearchSystemIntakes() {
+	ctx := context.Background()
+
+	// a random word, so the search only finds the intakes made here
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	word := make([]byte, 10)
+	for ix := range word {
+		word[ix] = byte('a' + rnd.Intn(26))
+	}
+	sig := string(word)
+
+	create := func(
+		projectName string,
+		businessNeed string,
+		status models.SystemIntakeStatus,
+		requestType models.SystemIntakeRequestType,
+		component string,
+		submittedAt time.Time,
+		lcid string,
+	) uuid.UUID {
+		intake := testhelpers.NewSystemIntake()
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		created.ProjectName = null.StringFrom(projectName)
+		created.BusinessNeed = null.StringFrom(businessNeed)
+		created.Status = status
+		created.RequestType = requestType
+		created.Component = null.StringFrom(component)
+		created.SubmittedAt = &submittedAt
+		created.LifecycleID = null.NewString(lcid, lcid != "")
+		_, err = s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+		return created.ID
+	}
+
+	lcid, err := s.store.GenerateLifecycleID(ctx)
+	s.NoError(err)
+	named := create(
+		fmt.Sprintf("%s Tracker", sig),
+		"track things",
+		models.SystemIntakeStatusINTAKESUBMITTED,
+		models.SystemIntakeRequestTypeNEW,
+		"Office of Information Technology",
+		time.Date(2021, 1, 15, 12, 0, 0, 0, time.UTC),
+		"",
+	)
+	described := create(
+		"Data Lake",
+		fmt.Sprintf("replace the %s spreadsheet", sig),
+		models.SystemIntakeStatusLCIDISSUED,
+		models.SystemIntakeRequestTypeRECOMPETE,
+		"Center for Medicaid and CHIP Services",
+		time.Date(2021, 2, 10, 12, 0, 0, 0, time.UTC),
+		lcid,
+	)
+	create(
+		"Unrelated",
+		"something else",
+		models.SystemIntakeStatusINTAKESUBMITTED,
+		models.SystemIntakeRequestTypeNEW,
+		"Office of Information Technology",
+		time.Date(2021, 1, 20, 12, 0, 0, 0, time.UTC),
+		"",
+	)
+
+	ids := func(result *models.SystemIntakeSearchResult) []uuid.UUID {
+		found := []uuid.UUID{}
+		for _, intake := range result.Results {
+			found = append(found, intake.ID)
+		}
+		return found
+	}
+	facetCounts := func(result *models.SystemIntakeSearchResult, facet models.SystemIntakeSearchFacet) map[string]int {
+		counts := map[string]int{}
+		for _, facetCounts := range result.Facets {
+			if facetCounts.Facet != facet {
+				continue
+			}
+			for _, count := range facetCounts.Counts {
+				counts[count.Value] = count.Count
+			}
+		}
+		return counts
+	}
+
+	s.Run("ranks project name matches before other matches", func() {
+		result, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{Query: sig, First: 10})
+		s.NoError(err)
+
+		s.Equal(2, result.TotalCount)
+		s.Equal([]uuid.UUID{named, described}, ids(result))
+		s.Len(result.Facets, len(models.SystemIntakeSearchFacets))
+		s.Equal(
+			map[string]int{"INTAKE_SUBMITTED": 1, "LCID_ISSUED": 1},
+			facetCounts(result, models.SystemIntakeSearchFacetSTATUS),
+		)
+		s.Equal(
+			map[string]int{"2021-01": 1, "2021-02": 1},
+			facetCounts(result, models.SystemIntakeSearchFacetSUBMITTEDMONTH),
+		)
+		s.Equal(
+			map[string]int{"true": 1, "false": 1},
+			facetCounts(result, models.SystemIntakeSearchFacetHASLCID),
+		)
+	})
+
+	s.Run("pages through the results", func() {
+		first, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{Query: sig, First: 1})
+		s.NoError(err)
+		s.Equal(2, first.TotalCount)
+		s.Equal([]uuid.UUID{named}, ids(first))
+		s.True(first.PageInfo.HasNextPage)
+
+		second, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{
+			Query: sig,
+			First: 1,
+			After: *first.PageInfo.EndCursor,
+		})
+		s.NoError(err)
+		s.Equal(2, second.TotalCount)
+		s.Equal([]uuid.UUID{described}, ids(second))
+		s.False(second.PageInfo.HasNextPage)
+	})
+
+	s.Run("needs a page size within the limit", func() {
+		for _, first := range []int{0, models.MaxPageSize + 1} {
+			_, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{Query: sig, First: first})
+			s.IsType(&apperrors.ValidationError{}, err)
+		}
+	})
+
+	s.Run("facet counts ignore their own filter", func() {
+		result, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{
+			Query:    sig,
+			Statuses: []models.SystemIntakeStatus{models.SystemIntakeStatusLCIDISSUED},
+			First:    10,
+		})
+		s.NoError(err)
+
+		s.Equal([]uuid.UUID{described}, ids(result))
+		s.Equal(
+			map[string]int{"INTAKE_SUBMITTED": 1, "LCID_ISSUED": 1},
+			facetCounts(result, models.SystemIntakeSearchFacetSTATUS),
+		)
+		s.Equal(
+			map[string]int{"RECOMPETE": 1},
+			facetCounts(result, models.SystemIntakeSearchFacetREQUESTTYPE),
+		)
+	})
+
+	s.Run("filters by every facet", func() {
+		hasLCID := false
+		after := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+		result, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{
+			Query:           sig,
+			Statuses:        []models.SystemIntakeStatus{models.SystemIntakeStatusINTAKESUBMITTED},
+			RequestTypes:    []models.SystemIntakeRequestType{models.SystemIntakeRequestTypeNEW},
+			Components:      []string{"Office of Information Technology"},
+			SubmittedAfter:  &after,
+			SubmittedBefore: &before,
+			HasLifecycleID:  &hasLCID,
+			First:           10,
+		})
+		s.NoError(err)
+
+		s.Equal([]uuid.UUID{named}, ids(result))
+	})
+
+	s.Run("finds nothing for an unknown status", func() {
+		result, err := s.store.SearchSystemIntakes(ctx, models.SystemIntakeSearch{
+			Query:    sig,
+			Statuses: []models.SystemIntakeStatus{"NOT_A_STATUS"},
+			First:    10,
+		})
+		s.NoError(err)
+
+		s.Equal(0, result.TotalCount)
+		s.Empty(result.Results)
+	})
+}
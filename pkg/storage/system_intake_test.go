@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/facebookgo/clock"
@@ -99,6 +100,28 @@ func (s StoreTestSuite) TestUpdateSystemIntake() {
 		s.Equal(intake.ISSO, updated.ISSO)
 	})
 
+	s.Run("refuses to update an intake that's changed since it was fetched", func() {
+		intake := testhelpers.NewSystemIntake()
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		s.Equal(1, created.Version)
+		stale := *created
+
+		created.ISSO = null.StringFrom("first isso")
+		updated, err := s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+		s.Equal(2, updated.Version)
+		s.Equal(2, created.Version)
+
+		stale.ISSO = null.StringFrom("second isso")
+		_, err = s.store.UpdateSystemIntake(ctx, &stale)
+		s.IsType(&apperrors.ResourceConflictError{}, err)
+
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, created.ID)
+		s.NoError(err)
+		s.Equal("first isso", fetched.ISSO.ValueOrZero())
+	})
+
 	s.Run("EUA ID will not update", func() {
 		originalIntake := models.SystemIntake{
 			EUAUserID:   testhelpers.RandomEUAIDNull(),
@@ -324,8 +347,7 @@ func (s StoreTestSuite) TestUpdateSystemIntake() {
 			s.NoError(err)
 		}
 
-		// the 11th attempt should generate an LCID that looks like "2136510" (~"YYddd10"),
-		// and this should violate the db constraint of a 6-digit LCID
+		// the 11th attempt should generate an LCID with a letter in front, e.g. "A213650"
 		original := models.SystemIntake{
 			EUAUserID:   testhelpers.RandomEUAIDNull(),
 			Status:      models.SystemIntakeStatusINTAKEDRAFT,
@@ -341,10 +363,26 @@ func (s StoreTestSuite) TestUpdateSystemIntake() {
 
 		lcid, err := s.store.GenerateLifecycleID(ctx)
 		s.NoError(err)
+		s.Regexp("^[A-Z][0-9]{6}$", lcid)
 
 		partial.LifecycleID = null.StringFrom(lcid)
 		_, err = s.store.UpdateSystemIntake(ctx, partial)
-		s.Error(err)
+		s.NoError(err)
+	})
+
+	s.Run("LifecycleID must be unique", func() {
+		create := func() *models.SystemIntake {
+			intake := testhelpers.NewSystemIntake()
+			created, err := s.store.CreateSystemIntake(ctx, &intake)
+			s.NoError(err)
+			created.LifecycleID = null.StringFrom("U200011")
+			return created
+		}
+
+		_, err := s.store.UpdateSystemIntake(ctx, create())
+		s.NoError(err)
+		_, err = s.store.UpdateSystemIntake(ctx, create())
+		s.IsType(&apperrors.ResourceConflictError{}, err)
 	})
 
 	s.Run("new backfill fields", func() {
@@ -426,6 +464,78 @@ func (s StoreTestSuite) TestLifecyclePrefixBoundaries() {
 	}
 }
 
+func (s StoreTestSuite) TestFormatLifecycleID() {
+	testCases := map[string]struct {
+		sequence int
+		expected string
+	}{
+		"first of the day":  {sequence: 0, expected: "213650"},
+		"last digit":        {sequence: 9, expected: "213659"},
+		"first with letter": {sequence: 10, expected: "A213650"},
+		"second letter":     {sequence: 25, expected: "B213655"},
+		"last of the day":   {sequence: 269, expected: "Z213659"},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			lcid, err := formatLifecycleID("21365", tc.sequence)
+			s.NoError(err)
+			s.Equal(tc.expected, lcid)
+		})
+	}
+
+	s.Run("runs out of LCIDs for the day", func() {
+		_, err := formatLifecycleID("21365", lifecycleIDsPerDay)
+		s.Error(err)
+	})
+}
+
+func (s StoreTestSuite) TestGenerateLifecycleIDConcurrently() {
+	ctx := context.Background()
+	const count = 25
+
+	intakes := make([]*models.SystemIntake, count)
+	for ix := range intakes {
+		intake := testhelpers.NewSystemIntake()
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		intakes[ix] = created
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for _, intake := range intakes {
+		wg.Add(1)
+		go func(intake *models.SystemIntake) {
+			defer wg.Done()
+			errs <- s.store.WithTransaction(ctx, func(ctx context.Context) error {
+				lcid, err := s.store.GenerateLifecycleID(ctx)
+				if err != nil {
+					return err
+				}
+				intake.LifecycleID = null.StringFrom(lcid)
+				_, err = s.store.UpdateSystemIntake(ctx, intake)
+				return err
+			})
+		}(intake)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.NoError(err)
+	}
+
+	seen := map[string]bool{}
+	for _, intake := range intakes {
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.NotEmpty(fetched.LifecycleID.String)
+		s.False(seen[fetched.LifecycleID.String], "duplicate lcid %s", fetched.LifecycleID.String)
+		seen[fetched.LifecycleID.String] = true
+	}
+}
+
 func (s StoreTestSuite) TestFetchSystemIntakeByID() {
 	ctx := context.Background()
 
@@ -644,6 +754,129 @@ func (s StoreTestSuite) TestFetchSystemIntakesByFilter() {
 	})
 }
 
+func (s StoreTestSuite) TestFetchSystemIntakesWithLCIDExpiringBy() {
+	s.Run("fetches only issued LCIDs expiring by the given time", func() {
+		ctx := context.Background()
+		expiresBy := time.Now().AddDate(0, 0, 90)
+
+		create := func(status models.SystemIntakeStatus, expiresAt time.Time) uuid.UUID {
+			intake := testhelpers.NewSystemIntake()
+			created, err := s.store.CreateSystemIntake(ctx, &intake)
+			s.NoError(err)
+			lcid, err := s.store.GenerateLifecycleID(ctx)
+			s.NoError(err)
+			created.Status = status
+			created.LifecycleID = null.StringFrom(lcid)
+			created.LifecycleExpiresAt = &expiresAt
+			_, err = s.store.UpdateSystemIntake(ctx, created)
+			s.NoError(err)
+			return created.ID
+		}
+		expiring := create(models.SystemIntakeStatusLCIDISSUED, expiresBy.AddDate(0, 0, -1))
+		later := create(models.SystemIntakeStatusLCIDISSUED, expiresBy.AddDate(0, 0, 1))
+		expired := create(models.SystemIntakeStatusLCIDEXPIRED, expiresBy.AddDate(0, 0, -1))
+
+		intakes, err := s.store.FetchSystemIntakesWithLCIDExpiringBy(ctx, expiresBy)
+		s.NoError(err)
+
+		ids := map[uuid.UUID]bool{}
+		for _, intake := range intakes {
+			ids[intake.ID] = true
+		}
+		s.True(ids[expiring])
+		s.False(ids[later])
+		s.False(ids[expired])
+	})
+}
+
+func (s StoreTestSuite) TestFetchSystemIntakesAwaitingCedar() {
+	s.Run("fetches only submitted intakes without an Alfabet ID", func() {
+		ctx := context.Background()
+		submittedAt := time.Now()
+
+		create := func(submitted bool, alfabetID null.String) uuid.UUID {
+			intake := testhelpers.NewSystemIntake()
+			if submitted {
+				intake.SubmittedAt = &submittedAt
+			}
+			intake.AlfabetID = alfabetID
+			created, err := s.store.CreateSystemIntake(ctx, &intake)
+			s.NoError(err)
+			_, err = s.store.UpdateSystemIntake(ctx, created)
+			s.NoError(err)
+			return created.ID
+		}
+		awaiting := create(true, null.String{})
+		sent := create(true, null.StringFrom("000-000-0"))
+		draft := create(false, null.String{})
+
+		intakes, err := s.store.FetchSystemIntakesAwaitingCedar(ctx)
+		s.NoError(err)
+
+		ids := map[uuid.UUID]bool{}
+		for _, intake := range intakes {
+			ids[intake.ID] = true
+		}
+		s.True(ids[awaiting])
+		s.False(ids[sent])
+		s.False(ids[draft])
+	})
+}
+
+func (s StoreTestSuite) TestUpdateSystemIntakeAlfabetID() {
+	s.Run("saves the Alfabet ID onto the latest version of the intake", func() {
+		ctx := context.Background()
+		intake := testhelpers.NewSystemIntake()
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		submitted := *created
+
+		// someone else changes the intake after it was submitted
+		created.ProjectName = null.StringFrom("Renamed")
+		_, err = s.store.UpdateSystemIntake(ctx, created)
+		s.NoError(err)
+
+		updated, err := s.store.UpdateSystemIntakeAlfabetID(ctx, submitted.ID, "000-000-1")
+
+		s.NoError(err)
+		s.Equal("000-000-1", updated.AlfabetID.String)
+		s.Equal("Renamed", updated.ProjectName.String)
+	})
+
+	s.Run("returns not found for a missing intake", func() {
+		ctx := context.Background()
+
+		_, err := s.store.UpdateSystemIntakeAlfabetID(ctx, uuid.New(), "000-000-1")
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
+
+func (s StoreTestSuite) TestLockSystemIntake() {
+	s.Run("fetches the intake inside a transaction", func() {
+		ctx := context.Background()
+		intake := testhelpers.NewSystemIntake()
+		created, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+
+		err = s.store.WithTransaction(ctx, func(ctx context.Context) error {
+			locked, lockErr := s.store.LockSystemIntake(ctx, created.ID)
+			s.NoError(lockErr)
+			s.Equal(created.ID, locked.ID)
+			return nil
+		})
+		s.NoError(err)
+	})
+
+	s.Run("cannot lock a missing intake", func() {
+		ctx := context.Background()
+
+		_, err := s.store.LockSystemIntake(ctx, uuid.New())
+
+		s.IsType(&apperrors.ResourceNotFoundError{}, err)
+	})
+}
+
 func (s StoreTestSuite) TestFetchSystemIntakeMetrics() {
 	ctx := context.Background()
 
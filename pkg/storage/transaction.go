@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+)
+
+type transactionKey struct{}
+
+// executor is the set of query methods shared by *sqlx.DB and *sqlx.Tx
+type executor interface {
+	sqlx.Ext
+	NamedExec(query string, arg interface{}) (sql.Result, error)
+	Get(dest interface{}, query string, args ...interface{}) error
+	Select(dest interface{}, query string, args ...interface{}) error
+}
+
+// conn returns the transaction on the context if there is one,
+// otherwise the database
+func (s *Store) conn(ctx context.Context) executor {
+	if tx, ok := ctx.Value(transactionKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return s.db
+}
+
+// WithTransaction runs f as a single unit of work.
+// Store methods called with the context passed to f join the transaction,
+// which is committed if f succeeds and rolled back otherwise.
+// Work deferred with appcontext.AfterCommit only runs once the commit succeeds.
+// Nested calls join the outermost transaction.
+func (s *Store) WithTransaction(ctx context.Context, f func(context.Context) error) error {
+	if _, ok := ctx.Value(transactionKey{}).(*sqlx.Tx); ok {
+		return f(ctx)
+	}
+
+	logger := appcontext.ZLogger(ctx)
+	tx, err := s.db.Beginx()
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	//Rollback only happens if transaction isn't committed
+	defer tx.Rollback()
+
+	txCtx, hooks := appcontext.WithCommitHooks(context.WithValue(ctx, transactionKey{}, tx))
+	if err = f(txCtx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return err
+	}
+	hooks.Run(ctx)
+	return nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmsgov/easi-app/pkg/appcontext"
+	"github.com/cmsgov/easi-app/pkg/models"
+	"github.com/cmsgov/easi-app/pkg/testhelpers"
+)
+
+func (s StoreTestSuite) TestWithTransaction() {
+	ctx := context.Background()
+
+	s.Run("commits all the work and runs commit hooks", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		committed := false
+
+		err = s.store.WithTransaction(ctx, func(ctx context.Context) error {
+			intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+			if _, err := s.store.UpdateSystemIntake(ctx, &intake); err != nil {
+				return err
+			}
+			appcontext.AfterCommit(ctx, func(ctx context.Context) {
+				committed = true
+			})
+			return nil
+		})
+		s.NoError(err)
+		s.True(committed)
+
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusINTAKESUBMITTED, fetched.Status)
+	})
+
+	s.Run("rolls back all the work and skips commit hooks on error", func() {
+		intake := testhelpers.NewSystemIntake()
+		_, err := s.store.CreateSystemIntake(ctx, &intake)
+		s.NoError(err)
+		committed := false
+		expectedErr := errors.New("failed")
+
+		err = s.store.WithTransaction(ctx, func(ctx context.Context) error {
+			intake.Status = models.SystemIntakeStatusINTAKESUBMITTED
+			if _, err := s.store.UpdateSystemIntake(ctx, &intake); err != nil {
+				return err
+			}
+			appcontext.AfterCommit(ctx, func(ctx context.Context) {
+				committed = true
+			})
+			// an action for a missing intake fails the foreign key
+			missingID := uuid.New()
+			_, err := s.store.CreateAction(ctx, &models.Action{
+				ID:             uuid.New(),
+				IntakeID:       &missingID,
+				ActionType:     models.ActionTypeSUBMITINTAKE,
+				ActorName:      "name",
+				ActorEmail:     "email@site.com",
+				ActorEUAUserID: testhelpers.RandomEUAID(),
+			})
+			if err != nil {
+				return expectedErr
+			}
+			return nil
+		})
+		s.Equal(expectedErr, err)
+		s.False(committed)
+
+		fetched, err := s.store.FetchSystemIntakeByID(ctx, intake.ID)
+		s.NoError(err)
+		s.Equal(models.SystemIntakeStatusINTAKEDRAFT, fetched.Status)
+	})
+}
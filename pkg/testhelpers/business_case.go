@@ -247,6 +247,7 @@ func NewBusinessCase() models.BusinessCase {
 		PreferredTitle:                  null.StringFrom("Test Preferred Title"),
 		PreferredSummary:                null.StringFrom("Test Preferred Summary"),
 		PreferredAcquisitionApproach:    null.StringFrom("Test Preferred Acquisition Approach"),
+		PreferredSecurityIsApproved:     null.BoolFrom(true),
 		PreferredHostingType:            null.StringFrom("none"),
 		PreferredHasUI:                  null.StringFrom("YES"),
 		PreferredPros:                   null.StringFrom("Test Preferred Pros"),
@@ -255,6 +256,7 @@ func NewBusinessCase() models.BusinessCase {
 		AlternativeATitle:               null.StringFrom("Test Alternative A Title"),
 		AlternativeASummary:             null.StringFrom("Test Alternative A Summary"),
 		AlternativeAAcquisitionApproach: null.StringFrom("Test Alternative A Acquisition Approach"),
+		AlternativeASecurityIsApproved:  null.BoolFrom(true),
 		AlternativeAHostingType:         null.StringFrom("none"),
 		AlternativeAHasUI:               null.StringFrom("YES"),
 		AlternativeAPros:                null.StringFrom("Test Alternative A Pros"),
@@ -263,6 +265,7 @@ func NewBusinessCase() models.BusinessCase {
 		AlternativeBTitle:               null.StringFrom("Test Alternative B Title"),
 		AlternativeBSummary:             null.StringFrom("Test Alternative B Summary"),
 		AlternativeBAcquisitionApproach: null.StringFrom("Test Alternative B Acquisition Approach"),
+		AlternativeBSecurityIsApproved:  null.BoolFrom(true),
 		AlternativeBHostingType:         null.StringFrom("none"),
 		AlternativeBHasUI:               null.StringFrom("YES"),
 		AlternativeBPros:                null.StringFrom("Test Alternative B Pros"),
@@ -67,6 +67,13 @@ func FundingNumberInvalid(fundingNumber string) bool {
 	return true
 }
 
+// PhoneNumberInvalid checks if it has at least ten digits,
+// allowing spaces and dashes between them
+func PhoneNumberInvalid(phoneNumber string) bool {
+	re := regexp.MustCompile(`( *-*[0-9] *?){10,}`)
+	return !re.MatchString(phoneNumber)
+}
+
 // RequireCostPhase checks if it's not nil
 func RequireCostPhase(p *models.LifecycleCostPhase) bool {
 	if p == nil {
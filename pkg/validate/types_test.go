@@ -80,6 +80,21 @@ func (s ValidateTestSuite) TestFundingNumberInvalid() {
 	})
 }
 
+func (s ValidateTestSuite) TestPhoneNumberInvalid() {
+	s.Run("phone number has fewer than 10 digits", func() {
+		s.True(PhoneNumberInvalid("555-1234"))
+	})
+	s.Run("phone number has letters", func() {
+		s.True(PhoneNumberInvalid("call me maybe"))
+	})
+	s.Run("phone number is valid", func() {
+		s.False(PhoneNumberInvalid("1234567890"))
+	})
+	s.Run("phone number with dashes is valid", func() {
+		s.False(PhoneNumberInvalid("123-456-7890"))
+	})
+}
+
 func (s ValidateTestSuite) TestRequireCostPhase() {
 	s.Run("cost phase pointer is nil", func() {
 		var p *models.LifecycleCostPhase
@@ -165,6 +165,7 @@ export const prepareBusinessCaseForApp = (
 
   return {
     id: businessCase.id,
+    version: businessCase.version,
     euaUserId: businessCase.euaUserId,
     status: businessCase.status,
     systemIntakeId: businessCase.systemIntakeId,
@@ -323,6 +324,9 @@ export const prepareBusinessCaseForApi = (
     ...(businessCase.euaUserId && {
       euaUserId: businessCase.euaUserId
     }),
+    ...(businessCase.version && {
+      version: businessCase.version
+    }),
     status: businessCase.status,
     systemIntakeId: businessCase.systemIntakeId,
     projectName: businessCase.requestName,
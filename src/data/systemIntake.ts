@@ -10,6 +10,7 @@ import {
 // has it as "projectName". This was an update from design.
 export const initialSystemIntakeForm: SystemIntakeForm = {
   id: '',
+  version: 0,
   euaUserID: '',
   requestName: '',
   status: 'INTAKE_DRAFT',
@@ -90,6 +91,7 @@ export const prepareSystemIntakeForApi = (systemIntake: SystemIntakeForm) => {
     ...(systemIntake.id && {
       id: systemIntake.id
     }),
+    version: systemIntake.version,
     status: systemIntake.status,
     requestType: systemIntake.requestType,
     requester: systemIntake.requester.name,
@@ -146,6 +148,7 @@ export const prepareSystemIntakeForApp = (
 
   return {
     id: systemIntake.id || '',
+    version: systemIntake.version || 0,
     euaUserID: systemIntake.euaUserID || '',
     requestName: systemIntake.projectName || '',
     status: systemIntake.status || 'INTAKE_DRAFT',
@@ -131,7 +131,13 @@ const governanceReviewTeam = {
       REJECT: 'Rejected the request',
       SEND_EMAIL: 'Email sent to requester',
       NOT_RESPONDING_CLOSE: 'Requester was not responding. Closed the request.',
-      GUIDE_RECEIVED_CLOSE: 'Guide received. Closed the request.'
+      GUIDE_RECEIVED_CLOSE: 'Guide received. Closed the request.',
+      LCID_EXPIRATION_REMINDER:
+        'Reminded the requester of the Lifecycle ID expiration',
+      EXPIRE_LCID: 'Lifecycle ID expired',
+      EXTEND_LCID: 'Extended the Lifecycle ID',
+      AMEND_LCID: 'Amended the Lifecycle ID',
+      RETIRE_LCID: 'Retired the Lifecycle ID'
     },
     showEmail: 'Show Email',
     hideEmail: 'Hide Email'
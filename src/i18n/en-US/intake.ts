@@ -32,6 +32,8 @@ const intake = {
     READY_FOR_GRT: 'Ready for GRT meeting',
     READY_FOR_GRB: 'Ready for GRB meeting',
     LCID_ISSUED: 'LCID: ',
+    LCID_EXPIRED: 'LCID expired',
+    LCID_RETIRED: 'LCID retired',
     WITHDRAWN: 'Withdrawn',
     NOT_IT_REQUEST: 'Closed',
     NOT_APPROVED: 'Business case not approved',
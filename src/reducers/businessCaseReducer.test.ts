@@ -260,6 +260,39 @@ describe('The business case reducer', () => {
         error: null
       });
     });
+
+    it('handles putBusinessCase.SUCCESS', () => {
+      const initialState = {
+        form: {
+          ...businessCaseInitialData,
+          id: '5e579c80-31d0-4839-b1f8-d74bfa7d5e24',
+          version: 1
+        },
+        isLoading: null,
+        isSaving: true,
+        isSubmitting: false,
+        error: null
+      };
+      const mockSuccessAction = {
+        type: putBusinessCase.SUCCESS,
+        payload: {
+          id: '5e579c80-31d0-4839-b1f8-d74bfa7d5e24',
+          version: 2
+        }
+      };
+
+      expect(businessCaseReducer(initialState, mockSuccessAction)).toEqual({
+        form: {
+          ...businessCaseInitialData,
+          id: '5e579c80-31d0-4839-b1f8-d74bfa7d5e24',
+          version: 2
+        },
+        isLoading: null,
+        isSaving: true,
+        isSubmitting: false,
+        error: null
+      });
+    });
   });
 
   describe('storeBusinessCase', () => {
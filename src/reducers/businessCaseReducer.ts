@@ -78,7 +78,13 @@ function businessCaseReducer(
         isSaving: true
       };
     case putBusinessCase.SUCCESS:
-      return state;
+      return {
+        ...state,
+        form: {
+          ...state.form,
+          version: action.payload.version
+        }
+      };
     case putBusinessCase.FAILURE:
       return {
         ...state,
@@ -18,6 +18,11 @@ export type ActionType =
   | 'GUIDE_RECEIVED_CLOSE'
   | 'NOT_RESPONDING_CLOSE'
   | 'ISSUE_LCID'
+  | 'LCID_EXPIRATION_REMINDER'
+  | 'EXPIRE_LCID'
+  | 'EXTEND_LCID'
+  | 'AMEND_LCID'
+  | 'RETIRE_LCID'
   | 'REJECT';
 
 /**
@@ -81,6 +81,7 @@ export type BusinessCaseModel = GeneralRequestInfoForm &
   AlternativeASolutionForm &
   AlternativeBSolutionForm & {
     id?: string;
+    version?: number;
     euaUserId?: string;
     status: BusinessCaseStatus;
     systemIntakeId: string;
@@ -21,6 +21,8 @@ export const openIntakeStatuses = [
 
 export const closedIntakeStatuses = [
   'LCID_ISSUED',
+  'LCID_EXPIRED',
+  'LCID_RETIRED',
   'WITHDRAWN',
   'NOT_IT_REQUEST',
   'NOT_APPROVED',
@@ -44,6 +46,7 @@ export type RequestType = 'NEW' | 'MAJOR_CHANGES' | 'RECOMPETE' | 'SHUTDOWN';
  */
 export type SystemIntakeForm = {
   id: string;
+  version: number;
   euaUserID: string;
   requestName: string;
   status: SystemIntakeStatus;